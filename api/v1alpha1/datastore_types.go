@@ -5,10 +5,11 @@ package v1alpha1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-//+kubebuilder:validation:Enum=etcd;MySQL;PostgreSQL;NATS
+//+kubebuilder:validation:Enum=etcd;MySQL;PostgreSQL;NATS;SQLite
 //+kubebuilder:validation:XValidation:rule="self == oldSelf",message="Datastore driver is immutable"
 
 type Driver string
@@ -18,6 +19,9 @@ var (
 	KineMySQLDriver      Driver = "MySQL"
 	KinePostgreSQLDriver Driver = "PostgreSQL"
 	KineNatsDriver       Driver = "NATS"
+	// KineSQLiteDriver runs Kine in the Tenant Control Plane Pod, storing the data in a SQLite database:
+	// the database file is kept on a PersistentVolumeClaim, and the Tenant Control Plane is forced to a single replica.
+	KineSQLiteDriver Driver = "SQLite"
 )
 
 //+kubebuilder:validation:MinItems=1
@@ -25,25 +29,89 @@ var (
 type Endpoints []string
 
 // DataStoreSpec defines the desired state of DataStore.
-// +kubebuilder:validation:XValidation:rule="(self.driver == \"etcd\") ? (self.tlsConfig != null && (has(self.tlsConfig.certificateAuthority.privateKey.secretReference) || has(self.tlsConfig.certificateAuthority.privateKey.content))) : true", message="certificateAuthority privateKey must have secretReference or content when driver is etcd"
-// +kubebuilder:validation:XValidation:rule="(self.driver == \"etcd\") ? (self.tlsConfig != null && (has(self.tlsConfig.clientCertificate.certificate.secretReference) || has(self.tlsConfig.clientCertificate.certificate.content))) : true", message="clientCertificate must have secretReference or content when driver is etcd"
-// +kubebuilder:validation:XValidation:rule="(self.driver == \"etcd\") ? (self.tlsConfig != null && (has(self.tlsConfig.clientCertificate.privateKey.secretReference) || has(self.tlsConfig.clientCertificate.privateKey.content))) : true", message="clientCertificate privateKey must have secretReference or content when driver is etcd"
+// +kubebuilder:validation:XValidation:rule="(self.driver == \"etcd\" && !has(self.managed)) ? (self.tlsConfig != null && (has(self.tlsConfig.certificateAuthority.privateKey.secretReference) || has(self.tlsConfig.certificateAuthority.privateKey.content))) : true", message="certificateAuthority privateKey must have secretReference or content when driver is etcd"
+// +kubebuilder:validation:XValidation:rule="(self.driver == \"etcd\" && !has(self.managed)) ? (self.tlsConfig != null && (has(self.tlsConfig.clientCertificate.certificate.secretReference) || has(self.tlsConfig.clientCertificate.certificate.content))) : true", message="clientCertificate must have secretReference or content when driver is etcd"
+// +kubebuilder:validation:XValidation:rule="(self.driver == \"etcd\" && !has(self.managed)) ? (self.tlsConfig != null && (has(self.tlsConfig.clientCertificate.privateKey.secretReference) || has(self.tlsConfig.clientCertificate.privateKey.content))) : true", message="clientCertificate privateKey must have secretReference or content when driver is etcd"
 // +kubebuilder:validation:XValidation:rule="(self.driver != \"etcd\" && has(self.tlsConfig) && has(self.tlsConfig.clientCertificate)) ? (((has(self.tlsConfig.clientCertificate.certificate.secretReference) || has(self.tlsConfig.clientCertificate.certificate.content)))) : true", message="When driver is not etcd and tlsConfig exists, clientCertificate must be null or contain valid content"
 // +kubebuilder:validation:XValidation:rule="(self.driver != \"etcd\" && has(self.basicAuth)) ? ((has(self.basicAuth.username.secretReference) || has(self.basicAuth.username.content))) : true", message="When driver is not etcd and basicAuth exists, username must have secretReference or content"
 // +kubebuilder:validation:XValidation:rule="(self.driver != \"etcd\" && has(self.basicAuth)) ? ((has(self.basicAuth.password.secretReference) || has(self.basicAuth.password.content))) : true", message="When driver is not etcd and basicAuth exists, password must have secretReference or content"
-// +kubebuilder:validation:XValidation:rule="(self.driver != \"etcd\") ? (has(self.tlsConfig) || has(self.basicAuth)) : true", message="When driver is not etcd, either tlsConfig or basicAuth must be provided"
+// +kubebuilder:validation:XValidation:rule="(self.driver != \"etcd\" && self.driver != \"SQLite\") ? (has(self.tlsConfig) || has(self.basicAuth)) : true", message="When driver is not etcd or SQLite, either tlsConfig or basicAuth must be provided"
+// +kubebuilder:validation:XValidation:rule="(self.driver != \"SQLite\" && !has(self.managed)) ? has(self.endpoints) : true", message="endpoints must be provided unless the driver is SQLite, or the DataStore is managed"
+// +kubebuilder:validation:XValidation:rule="has(self.sqlite) ? self.driver == \"SQLite\" : true", message="sqlite can be set only when driver is SQLite"
+// +kubebuilder:validation:XValidation:rule="has(self.managed) ? self.driver == \"etcd\" : true", message="managed can be set only when driver is etcd"
+// +kubebuilder:validation:XValidation:rule="has(self.managed) == has(oldSelf.managed)", message="managed cannot be added, or removed, after the creation"
 type DataStoreSpec struct {
 	// The driver to use to connect to the shared datastore.
 	Driver Driver `json:"driver"`
 	// List of the endpoints to connect to the shared datastore.
 	// No need for protocol, just bare IP/FQDN and port.
-	Endpoints Endpoints `json:"endpoints"`
+	// Required unless the driver is SQLite, since the database is embedded in the Tenant Control Plane.
+	Endpoints Endpoints `json:"endpoints,omitempty"`
 	// In case of authentication enabled for the given data store, specifies the username and password pair.
 	// This value is optional.
 	BasicAuth *BasicAuth `json:"basicAuth,omitempty"`
 	// Defines the TLS/SSL configuration required to connect to the data store in a secure way.
 	// This value is optional.
 	TLSConfig *TLSConfig `json:"tlsConfig,omitempty"`
+	// Defines the PersistentVolumeClaim storing the database of the Tenant Control Planes using the SQLite driver.
+	// This value is optional.
+	SQLite *SQLiteConfig `json:"sqlite,omitempty"`
+	// Managed lets Steward deploy, and operate, the etcd cluster backing the DataStore in the management cluster:
+	// the endpoints, and the TLS configuration, are filled in by Steward, and must not be provided.
+	// This value is optional, and it can be set only upon creation.
+	Managed *ManagedEtcdSpec `json:"managed,omitempty"`
+}
+
+// ManagedEtcdSpec defines the etcd cluster deployed by Steward as a StatefulSet in the management cluster.
+type ManagedEtcdSpec struct {
+	// Namespace where the etcd cluster is deployed, the Steward one is used if not specified.
+	//+kubebuilder:validation:XValidation:rule="self == oldSelf",message="namespace is immutable"
+	Namespace       string `json:"namespace,omitempty"`
+	EtcdClusterSpec `json:",inline"`
+}
+
+// EtcdClusterSpec defines an etcd cluster deployed, and operated, by Steward as a StatefulSet.
+type EtcdClusterSpec struct {
+	// Replicas is the number of etcd members: an odd number is recommended to tolerate failures.
+	// Members are added, or removed, one at a time.
+	//+kubebuilder:default=3
+	//+kubebuilder:validation:Minimum=1
+	//+kubebuilder:validation:Maximum=7
+	Replicas int32 `json:"replicas,omitempty"`
+	// Image is the container image of the etcd members.
+	//+kubebuilder:default="registry.k8s.io/etcd:3.5.21-0"
+	Image string `json:"image,omitempty"`
+	// Storage defines the PersistentVolumeClaim of each etcd member.
+	//+kubebuilder:default={}
+	Storage EtcdStorageSpec `json:"storage,omitempty"`
+	// Resources of the etcd container.
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+	// DefragmentationInterval is the interval between two defragmentations of the etcd members,
+	// reclaiming the space left by the compacted revisions.
+	//+kubebuilder:default="24h"
+	DefragmentationInterval metav1.Duration `json:"defragmentationInterval,omitempty"`
+}
+
+// EtcdStorageSpec defines the PersistentVolumeClaim template of the etcd members.
+//
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="storage is immutable"
+type EtcdStorageSpec struct {
+	// StorageClassName is the StorageClass of the PersistentVolumeClaims,
+	// the cluster default one is used if not specified.
+	StorageClassName *string `json:"storageClassName,omitempty"`
+	// Size is the requested capacity of each PersistentVolumeClaim.
+	//+kubebuilder:default="8Gi"
+	Size resource.Quantity `json:"size,omitempty"`
+}
+
+// SQLiteConfig defines the PersistentVolumeClaim created for each Tenant Control Plane using the SQLite driver.
+type SQLiteConfig struct {
+	// StorageClassName is the StorageClass of the PersistentVolumeClaim,
+	// the cluster default one is used if not specified.
+	StorageClassName *string `json:"storageClassName,omitempty"`
+	// Size is the requested capacity of the PersistentVolumeClaim.
+	//+kubebuilder:default="1Gi"
+	Size resource.Quantity `json:"size,omitempty"`
 }
 
 // TLSConfig contains the information used to connect to the data store using a secured connection.
@@ -92,12 +160,58 @@ type SecretReference struct {
 type DataStoreStatus struct {
 	// List of the Tenant Control Planes, namespaced named, using this data store.
 	UsedBy []string `json:"usedBy,omitempty"`
+	// ObservedGeneration is the generation of the DataStore observed by the last reconciliation.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// LastProbeTime is when the data store has been probed last.
+	LastProbeTime *metav1.Time `json:"lastProbeTime,omitempty"`
+	// LastProbeLatency is the duration of the last connectivity check.
+	LastProbeLatency *metav1.Duration `json:"lastProbeLatency,omitempty"`
+	// CertificatesExpiration is the earliest expiration of the configured CA and client certificates.
+	CertificatesExpiration *metav1.Time `json:"certificatesExpiration,omitempty"`
+	// Conditions report the health of the data store, as observed by the periodic probe:
+	// Ready when it's reachable with valid certificates, and Degraded upon high latency, or expiring certificates.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// Managed reports the state of the etcd cluster deployed by Steward, if managed.
+	Managed *EtcdClusterStatus `json:"managed,omitempty"`
+}
+
+// EtcdClusterStatus defines the observed state of an etcd cluster deployed by Steward.
+type EtcdClusterStatus struct {
+	// Members is the number of etcd members part of the cluster.
+	Members int32 `json:"members"`
+	// ReadyMembers is the number of etcd members ready to serve requests.
+	ReadyMembers int32 `json:"readyMembers"`
+	// Bootstrapped reports whether the cluster has been bootstrapped, and the authentication enabled:
+	// new members are then joining the existing cluster.
+	Bootstrapped bool `json:"bootstrapped,omitempty"`
+	// LastDefragmentation is when the etcd members have been defragmented last.
+	LastDefragmentation *metav1.Time `json:"lastDefragmentation,omitempty"`
+	// Failure is the error of the last operation of the cluster, it's cleared once the cluster is operated successfully.
+	Failure string `json:"failure,omitempty"`
 }
 
+// DataStoreDegradedCondition reports the data store is reachable, although slow, or with certificates close to expiration:
+// the Ready condition reports whether it's reachable, with valid certificates.
+const DataStoreDegradedCondition = "Degraded"
+
+const (
+	DataStoreHealthyReason             = "Healthy"
+	DataStoreConnectionFailedReason    = "ConnectionFailed"
+	DataStoreCertificateExpiredReason  = "CertificateExpired"
+	DataStoreCertificateExpiringReason = "CertificateExpiring"
+	DataStoreHighLatencyReason         = "HighLatency"
+	DataStoreProvisioningReason        = "Provisioning"
+)
+
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 //+kubebuilder:resource:scope=Cluster
 //+kubebuilder:printcolumn:name="Driver",type="string",JSONPath=".spec.driver",description="Steward data store driver"
+//+kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Data store reachability"
+//+kubebuilder:printcolumn:name="Latency",type="string",JSONPath=".status.lastProbeLatency",description="Latency of the last probe",priority=1
 //+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Age"
 //+kubebuilder:metadata:annotations={"cert-manager.io/inject-ca-from=steward-system/steward-serving-cert"}
 
@@ -0,0 +1,75 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+//+kubebuilder:validation:Enum=Spread;Pack
+
+type DataStorePlacementStrategy string
+
+var (
+	// DataStorePlacementStrategySpread assigns the DataStore serving the fewest Tenant Control Planes.
+	DataStorePlacementStrategySpread DataStorePlacementStrategy = "Spread"
+	// DataStorePlacementStrategyPack assigns the DataStore serving the most Tenant Control Planes, within its capacity.
+	DataStorePlacementStrategyPack DataStorePlacementStrategy = "Pack"
+)
+
+// DataStorePlacementPolicySpec defines the desired state of DataStorePlacementPolicy.
+type DataStorePlacementPolicySpec struct {
+	// TenantControlPlaneSelector selects the Tenant Control Planes the policy applies to, by their labels:
+	// when empty, the policy applies to all the Tenant Control Planes.
+	TenantControlPlaneSelector metav1.LabelSelector `json:"tenantControlPlaneSelector,omitempty"`
+	// DataStoreSelector selects the pool of DataStores the Tenant Control Planes can be assigned to, by their labels:
+	// when empty, all the DataStores are part of the pool.
+	DataStoreSelector metav1.LabelSelector `json:"dataStoreSelector,omitempty"`
+	// MaxTenantControlPlanes is the amount of Tenant Control Planes a DataStore of the pool can serve,
+	// unlimited when zero. It's a soft limit, enforced upon the placement only: the Tenant Control Planes
+	// created at the same time are counted against the same capacity, and could exceed it.
+	//+kubebuilder:validation:Minimum=0
+	MaxTenantControlPlanes int32 `json:"maxTenantControlPlanes,omitempty"`
+	// Strategy is used to pick the DataStore among the eligible ones:
+	// Spread balances the Tenant Control Planes across the pool, Pack fills a DataStore before using the next one.
+	//+kubebuilder:default="Spread"
+	Strategy DataStorePlacementStrategy `json:"strategy,omitempty"`
+	// Drivers is the ordered list of the preferred DataStore drivers:
+	// the DataStores backed by a driver not listed are excluded from the pool.
+	// When empty, all the drivers are equally eligible.
+	//+listType=set
+	Drivers []Driver `json:"drivers,omitempty"`
+	// Priority is used to pick the policy when several ones select the same Tenant Control Plane:
+	// the policy with the highest priority wins, the name breaks the ties.
+	Priority int32 `json:"priority,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:resource:scope=Cluster,shortName=dspp,categories=steward
+//+kubebuilder:printcolumn:name="Strategy",type="string",JSONPath=".spec.strategy",description="The DataStore placement strategy"
+//+kubebuilder:printcolumn:name="Max Tenants",type="integer",JSONPath=".spec.maxTenantControlPlanes",description="The Tenant Control Planes a DataStore can serve"
+//+kubebuilder:printcolumn:name="Priority",type="integer",JSONPath=".spec.priority",description="The policy priority"
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Age"
+
+// DataStorePlacementPolicy is the Schema for the datastoreplacementpolicies API:
+// it assigns a DataStore from a pool to the Tenant Control Planes created with no DataStore.
+type DataStorePlacementPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec DataStorePlacementPolicySpec `json:"spec,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// DataStorePlacementPolicyList contains a list of DataStorePlacementPolicy.
+type DataStorePlacementPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DataStorePlacementPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DataStorePlacementPolicy{}, &DataStorePlacementPolicyList{})
+}
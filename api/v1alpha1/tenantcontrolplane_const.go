@@ -7,4 +7,23 @@ const (
 	// PausedReconciliationAnnotation is an annotation that can be applied to
 	// Tenant Control Plane objects to prevent the controller from processing such a resource.
 	PausedReconciliationAnnotation = "steward.butlerlabs.dev/paused"
+	// CrossDriverMigrationAnnotation allows to migrate the Tenant Control Plane
+	// to a DataStore backed by a different driver, when set to true.
+	CrossDriverMigrationAnnotation = "steward.butlerlabs.dev/allow-cross-driver-migration"
+	// MigrationModeAnnotation selects how the Tenant Control Plane is migrated to another DataStore:
+	// Freeze, the default, or Live (see DataStoreMigrationMode).
+	MigrationModeAnnotation = "steward.butlerlabs.dev/migration-mode"
+	// DataStorePlacementPolicyAnnotation reports the DataStorePlacementPolicy
+	// which assigned the DataStore to the Tenant Control Plane upon its creation.
+	DataStorePlacementPolicyAnnotation = "steward.butlerlabs.dev/datastore-placement-policy"
+	// SkipUpgradePreflightsAnnotation skips the preflight checks run against the tenant cluster before a Kubernetes upgrade:
+	// either all of them, when set to true, or the comma-separated list of the given checks.
+	SkipUpgradePreflightsAnnotation = "steward.butlerlabs.dev/skip-upgrade-preflights"
+	// StorageVersionMigrationRequestAnnotation requests the storage version migration of all the tenant cluster resources,
+	// such as after an encryption key rotation: it must be applied with an empty value,
+	// and it's replaced with the completion timestamp in the RFC3339 format.
+	StorageVersionMigrationRequestAnnotation = "steward.butlerlabs.dev/migrate-storage"
+	// RestoreOwnerAnnotation reports the TenantControlPlaneRestore holding the Tenant Control Plane,
+	// from its start until its write permissions are restored: it's managed by Steward.
+	RestoreOwnerAnnotation = "steward.butlerlabs.dev/restore"
 )
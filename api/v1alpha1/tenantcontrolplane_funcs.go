@@ -147,3 +147,31 @@ func (in *TenantControlPlane) GetDefaultDatastoreUsername() string {
 func (in *TenantControlPlane) GetDefaultDatastoreSchema() string {
 	return in.normalizeNamespaceName()
 }
+
+// GetWritePermissions returns the effective write permissions of the Tenant Control Plane:
+// along with the declared ones, creations and updates are blocked when the storage quota is exceeded.
+func (in *TenantControlPlane) GetWritePermissions() Permissions {
+	permissions := in.Spec.WritePermissions
+
+	if usage := in.Status.Storage.Usage; in.Spec.StorageQuota != nil && usage != nil && usage.QuotaExceeded {
+		permissions.BlockCreate, permissions.BlockUpdate = true, true
+	}
+
+	return permissions
+}
+
+// GetKubernetesVersion returns the Kubernetes version the Tenant Control Plane must run:
+// along with the declared one, the current step of a sequential upgrade is taken into account,
+// and a version which has been rolled back is replaced by the last-known-good one.
+func (in *TenantControlPlane) GetKubernetesVersion() string {
+	version := in.Spec.Kubernetes.Version
+	if upgrade := in.Status.Kubernetes.Version.Upgrade; upgrade != nil && upgrade.Target == version && upgrade.Current != "" {
+		version = upgrade.Current
+	}
+
+	if rollback := in.Status.Kubernetes.Version.RolledBack; rollback != nil && rollback.FailedVersion == version {
+		return rollback.Version
+	}
+
+	return version
+}
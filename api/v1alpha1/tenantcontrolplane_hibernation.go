@@ -0,0 +1,68 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// HibernationSpec defines when the Tenant Control Plane must be put to sleep, by scaling its Deployment to zero replicas.
+// +kubebuilder:validation:XValidation:rule="has(self.schedules) || has(self.idle) || (has(self.wakeOnRequest) && self.wakeOnRequest)",message="at least a schedule, the idle detection, or the wake on request must be specified"
+type HibernationSpec struct {
+	// Schedules is the list of the sleep and wake windows of the Tenant Control Plane:
+	// the hibernation acts only upon the window boundaries, allowing to manually wake up a sleeping Tenant Control Plane.
+	Schedules []HibernationSchedule `json:"schedules,omitempty"`
+	// Idle puts the Tenant Control Plane to sleep when no API requests from users and workloads are observed for the given duration.
+	Idle *HibernationIdleSpec `json:"idle,omitempty"`
+	// WakeOnRequest routes the Service of the sleeping Tenant Control Plane to the Steward activator:
+	// the first incoming connection wakes up the Tenant Control Plane, and it's forwarded once the API Server is ready.
+	// Requires the activator to be deployed, and the Steward manager to be started with the activator Service name.
+	WakeOnRequest bool `json:"wakeOnRequest,omitempty"`
+}
+
+type HibernationSchedule struct {
+	// Sleep is the cron expression, in the standard five fields format, when the Tenant Control Plane is put to sleep.
+	//+kubebuilder:validation:MinLength=1
+	Sleep string `json:"sleep"`
+	// Wake is the cron expression, in the standard five fields format, when the Tenant Control Plane is woken up.
+	//+kubebuilder:validation:MinLength=1
+	Wake string `json:"wake"`
+	// TimeZone is the IANA name of the time zone used to evaluate the cron expressions.
+	//+kubebuilder:default="UTC"
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+type HibernationIdleSpec struct {
+	// After is the duration with no API requests from users and workloads after which the Tenant Control Plane is put to sleep.
+	After metav1.Duration `json:"after"`
+	// FlowSchemas is the list of the API Priority and Fairness FlowSchemas whose dispatched requests are considered as activity:
+	// the default ones are matching the requests from authenticated users, and from the Service Accounts outside the kube-system Namespace.
+	//+kubebuilder:default={"global-default","service-accounts"}
+	//+kubebuilder:validation:MinItems=1
+	FlowSchemas []string `json:"flowSchemas,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Schedule;Idle
+type HibernationReason string
+
+const (
+	HibernationReasonSchedule HibernationReason = "Schedule"
+	HibernationReasonIdle     HibernationReason = "Idle"
+)
+
+// HibernationStatus defines the observed state of the Tenant Control Plane hibernation.
+type HibernationStatus struct {
+	// Hibernated reports if the Tenant Control Plane has been put to sleep by the hibernation.
+	Hibernated bool `json:"hibernated,omitempty"`
+	// Reason is the hibernation trigger which put the Tenant Control Plane to sleep.
+	Reason HibernationReason `json:"reason,omitempty"`
+	// Replicas is the last observed amount of the Tenant Control Plane replicas before sleeping, restored upon wake up.
+	Replicas *int32 `json:"replicas,omitempty"`
+	// LastTransitionTime is the last time the Tenant Control Plane has been put to sleep, or woken up.
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+	// LastScheduleTime is the last time the schedules have been evaluated.
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+	// LastActivityTime is the last time an API request from users or workloads has been observed.
+	LastActivityTime *metav1.Time `json:"lastActivityTime,omitempty"`
+}
@@ -7,6 +7,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
@@ -74,6 +75,107 @@ type DataStoreSetupStatus struct {
 	Checksum   string      `json:"checksum,omitempty"`
 }
 
+// +kubebuilder:validation:Enum=KeyAdded;KeyPromoted
+type EncryptionKeyRotationPhase string
+
+var (
+	// EncryptionKeyRotationKeyAdded means the new key has been added as a read-only one,
+	// and the API Server instances are rolling out to be able to decrypt it.
+	EncryptionKeyRotationKeyAdded EncryptionKeyRotationPhase = "KeyAdded"
+	// EncryptionKeyRotationKeyPromoted means the new key is used to encrypt data:
+	// once rolled out, the stored Secrets are rewritten and the old key is dropped.
+	EncryptionKeyRotationKeyPromoted EncryptionKeyRotationPhase = "KeyPromoted"
+)
+
+// EncryptionAtRestStatus defines the observed state of the EncryptionConfiguration used by the API Server.
+type EncryptionAtRestStatus struct {
+	SecretName string      `json:"secretName,omitempty"`
+	Checksum   string      `json:"checksum,omitempty"`
+	LastUpdate metav1.Time `json:"lastUpdate,omitempty"`
+	// Provider is the transformer currently used to encrypt the data.
+	Provider string `json:"provider,omitempty"`
+	// KeyRotationPhase reports the progress of an ongoing key rotation, empty if none.
+	KeyRotationPhase EncryptionKeyRotationPhase `json:"keyRotationPhase,omitempty"`
+	// SecretsRewrite reports the progress of the tenant Secrets rewrite with the promoted key, empty if none.
+	SecretsRewrite *EncryptionSecretsRewriteStatus `json:"secretsRewrite,omitempty"`
+}
+
+// EncryptionSecretsRewriteStatus tracks the rewrite of the tenant Secrets, performed a page at a time across the reconciliations.
+type EncryptionSecretsRewriteStatus struct {
+	// Continue is the token of the next page of tenant Secrets to rewrite, empty for the first one.
+	Continue string `json:"continue,omitempty"`
+	// Rewritten is the number of tenant Secrets rewritten so far.
+	Rewritten int64 `json:"rewritten"`
+}
+
+// AuditStatus contains information about the audit Policy used by the API Server.
+type AuditStatus struct {
+	ConfigMapName string      `json:"configMapName,omitempty"`
+	Checksum      string      `json:"checksum,omitempty"`
+	LastUpdate    metav1.Time `json:"lastUpdate,omitempty"`
+}
+
+// AuthenticationStatus contains information about the AuthenticationConfiguration used by the API Server.
+type AuthenticationStatus struct {
+	ConfigMapName string      `json:"configMapName,omitempty"`
+	Checksum      string      `json:"checksum,omitempty"`
+	LastUpdate    metav1.Time `json:"lastUpdate,omitempty"`
+}
+
+// AuthorizationStatus contains information about the AuthorizationConfiguration used by the API Server.
+type AuthorizationStatus struct {
+	ConfigMapName string      `json:"configMapName,omitempty"`
+	Checksum      string      `json:"checksum,omitempty"`
+	LastUpdate    metav1.Time `json:"lastUpdate,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Freeze;Live
+type DataStoreMigrationMode string
+
+var (
+	// DataStoreMigrationModeFreeze blocks the Tenant Control Plane writes for the whole copy of the keyspace.
+	DataStoreMigrationModeFreeze DataStoreMigrationMode = "Freeze"
+	// DataStoreMigrationModeLive copies the keyspace while the writes continue,
+	// blocking them only to replay the changes occurred meanwhile.
+	DataStoreMigrationModeLive DataStoreMigrationMode = "Live"
+)
+
+// +kubebuilder:validation:Enum=Copying;Freezing;Replaying;Completed
+type DataStoreMigrationPhase string
+
+var (
+	DataStoreMigrationPhaseCopying   DataStoreMigrationPhase = "Copying"
+	DataStoreMigrationPhaseFreezing  DataStoreMigrationPhase = "Freezing"
+	DataStoreMigrationPhaseReplaying DataStoreMigrationPhase = "Replaying"
+	DataStoreMigrationPhaseCompleted DataStoreMigrationPhase = "Completed"
+)
+
+// DataStoreMigrationStatus reports the progress of the latest DataStore migration.
+type DataStoreMigrationStatus struct {
+	// DataStore is the name of the DataStore the keyspace is migrated to.
+	DataStore string                  `json:"dataStore"`
+	Mode      DataStoreMigrationMode  `json:"mode"`
+	Phase     DataStoreMigrationPhase `json:"phase"`
+	// JobUID is the UID of the migration Job the progress refers to:
+	// the phase left by a former Job, such as a failed one, is ignored.
+	JobUID string `json:"jobUID,omitempty"`
+	// Revision is the revision of the origin DataStore the keyspace has been copied at:
+	// the etcd revision, or the highest Kine row id.
+	// The live migration replays the changes occurred after it.
+	Revision int64 `json:"revision,omitempty"`
+	// CopiedKeys is the amount of keys copied to the target DataStore, when known.
+	CopiedKeys int64 `json:"copiedKeys,omitempty"`
+	// ReplayedChanges is the amount of changes replayed to the target DataStore by the live migration.
+	ReplayedChanges int64        `json:"replayedChanges,omitempty"`
+	StartTime       *metav1.Time `json:"startTime,omitempty"`
+	// FreezeStartTime is when the Tenant Control Plane writes have been blocked.
+	FreezeStartTime *metav1.Time `json:"freezeStartTime,omitempty"`
+	// FreezeDuration is how long the writes have been blocked by the migration Job,
+	// not including the rollout of the API Server instances to the target DataStore.
+	FreezeDuration *metav1.Duration `json:"freezeDuration,omitempty"`
+	CompletionTime *metav1.Time     `json:"completionTime,omitempty"`
+}
+
 // StorageStatus defines the observed state of StorageStatus.
 type StorageStatus struct {
 	Driver        string                     `json:"driver,omitempty"`
@@ -81,6 +183,69 @@ type StorageStatus struct {
 	Config        DataStoreConfigStatus      `json:"config,omitempty"`
 	Setup         DataStoreSetupStatus       `json:"setup,omitempty"`
 	Certificate   DataStoreCertificateStatus `json:"certificate,omitempty"`
+	// Migration reports the progress of the latest DataStore migration, if any.
+	Migration *DataStoreMigrationStatus `json:"migration,omitempty"`
+	// Usage reports the storage used by the Tenant Control Plane in its DataStore.
+	Usage *DataStoreUsageStatus `json:"usage,omitempty"`
+	// Volume reports the PersistentVolumeClaim storing the database, when using the SQLite driver.
+	Volume *DataStoreVolumeStatus `json:"volume,omitempty"`
+	// Dedicated reports the state of the dedicated etcd cluster, when using the Dedicated DataStore mode.
+	Dedicated *EtcdClusterStatus `json:"dedicated,omitempty"`
+}
+
+// DataStoreVolumeStatus is the PersistentVolumeClaim storing the embedded SQLite database of the Tenant Control Plane.
+type DataStoreVolumeStatus struct {
+	ClaimName string `json:"claimName"`
+}
+
+// DataStoreUsageStatus is the storage used by the Tenant Control Plane in its DataStore.
+type DataStoreUsageStatus struct {
+	// Keys is the amount of keys stored.
+	Keys int64 `json:"keys"`
+	// Size is the space taken by the existing keys and their latest values, as accounted by the DataStore driver:
+	// the database size in use when the etcd cluster is dedicated to the Tenant Control Plane.
+	Size resource.Quantity `json:"size"`
+	// QuotaExceeded reports the usage exceeded the storage quota: creations and updates are blocked.
+	QuotaExceeded bool `json:"quotaExceeded,omitempty"`
+	// LastUpdate is the time the usage has been measured.
+	LastUpdate metav1.Time `json:"lastUpdate,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Migrating;Completed
+type StorageVersionMigrationPhase string
+
+var (
+	StorageVersionMigrationPhaseMigrating StorageVersionMigrationPhase = "Migrating"
+	StorageVersionMigrationPhaseCompleted StorageVersionMigrationPhase = "Completed"
+)
+
+// +kubebuilder:validation:Enum=StorageVersionChanged;Requested
+type StorageVersionMigrationTrigger string
+
+var (
+	// StorageVersionMigrationTriggerStorageVersionChanged migrates the resources whose storage version changed,
+	// such as after a minor upgrade, or the change of a CustomResourceDefinition storage version.
+	StorageVersionMigrationTriggerStorageVersionChanged StorageVersionMigrationTrigger = "StorageVersionChanged"
+	// StorageVersionMigrationTriggerRequested migrates all the resources, as requested by the Tenant Control Plane annotation.
+	StorageVersionMigrationTriggerRequested StorageVersionMigrationTrigger = "Requested"
+)
+
+// StorageVersionMigrationStatus reports the progress of the storage version migration in the tenant cluster:
+// the objects are rewritten through the tenant API to be stored in the DataStore with the current storage version.
+type StorageVersionMigrationStatus struct {
+	Phase   StorageVersionMigrationPhase   `json:"phase,omitempty"`
+	Trigger StorageVersionMigrationTrigger `json:"trigger,omitempty"`
+	// Resources lists the resources of the latest migration, in the resource.group format.
+	Resources []string `json:"resources,omitempty"`
+	// MigratedResources lists the resources already migrated, allowing to resume an interrupted migration.
+	MigratedResources []string `json:"migratedResources,omitempty"`
+	// Continue is the token of the next page of objects of the resource being migrated, empty for the first one.
+	Continue string `json:"continue,omitempty"`
+	// StorageVersionHashes are the storage version hashes of the tenant cluster resources,
+	// keyed by resource.group, as they were once their objects had been migrated.
+	StorageVersionHashes map[string]string `json:"storageVersionHashes,omitempty"`
+	StartTime            *metav1.Time      `json:"startTime,omitempty"`
+	CompletionTime       *metav1.Time      `json:"completionTime,omitempty"`
 }
 
 // KubeconfigStatus contains information about the generated kubeconfig.
@@ -171,8 +336,18 @@ type TenantControlPlaneStatus struct {
 	Certificates CertificatesStatus `json:"certificates,omitempty"`
 	// KubeConfig contains information about the kubenconfigs that control plane pieces need
 	KubeConfig KubeconfigsStatus `json:"kubeconfig,omitempty"`
+	// EncryptionAtRest contains information about the EncryptionConfiguration used by the API Server
+	EncryptionAtRest EncryptionAtRestStatus `json:"encryptionAtRest,omitempty"`
+	// Audit contains information about the audit Policy used by the API Server
+	Audit AuditStatus `json:"audit,omitempty"`
+	// Authentication contains information about the AuthenticationConfiguration used by the API Server
+	Authentication AuthenticationStatus `json:"authentication,omitempty"`
+	// Authorization contains information about the AuthorizationConfiguration used by the API Server
+	Authorization AuthorizationStatus `json:"authorization,omitempty"`
 	// Kubernetes contains information about the reconciliation of the required Kubernetes resources deployed in the admin cluster
 	Kubernetes KubernetesStatus `json:"kubernetesResources,omitempty"`
+	// StorageVersionMigration reports the progress of the storage version migration in the tenant cluster, if any.
+	StorageVersionMigration *StorageVersionMigrationStatus `json:"storageVersionMigration,omitempty"`
 	// KubeadmConfig contains the status of the configuration required by kubeadm
 	KubeadmConfig KubeadmConfigStatus `json:"kubeadmconfig,omitempty"`
 	// KubeadmPhase contains the status of the kubeadm phases action
@@ -181,8 +356,50 @@ type TenantControlPlaneStatus struct {
 	ControlPlaneEndpoint string `json:"controlPlaneEndpoint,omitempty"`
 	// Addons contains the status of the different Addons
 	Addons AddonsStatus `json:"addons,omitempty"`
+	// Hibernation contains the status of the automatic sleep and wake up of the Tenant Control Plane
+	Hibernation HibernationStatus `json:"hibernation,omitempty"`
+	// Conditions contains the latest observations of the Tenant Control Plane reconciliation,
+	// one per area of the managed resources, besides the aggregated Ready one.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
+const (
+	// ReadyCondition reports the aggregated state of the Tenant Control Plane,
+	// it's true only once all the other conditions are true.
+	ReadyCondition = "Ready"
+	// DataStoreReadyCondition reports the setup of the DataStore: multi-tenancy, credentials, schema, and certificates.
+	DataStoreReadyCondition = "DataStoreReady"
+	// CertificatesReadyCondition reports the generation of the Certificate Authorities and the control plane certificates.
+	CertificatesReadyCondition = "CertificatesReady"
+	// KubeconfigsReadyCondition reports the generation of the control plane kubeconfig files.
+	KubeconfigsReadyCondition = "KubeconfigsReady"
+	// DeploymentAvailableCondition reports the configuration and the availability of the control plane Deployment.
+	DeploymentAvailableCondition = "DeploymentAvailable"
+	// AddonsReadyCondition reports the reconciliation of the Addons handled by the management cluster.
+	AddonsReadyCondition = "AddonsReady"
+	// EndpointReachableCondition reports the exposure of the Tenant Control Plane API Server endpoint.
+	EndpointReachableCondition = "EndpointReachable"
+	// UpgradePreflightsPassedCondition reports the outcome of the preflight checks run against the tenant cluster
+	// before a Kubernetes upgrade: it's not part of the aggregated Ready condition.
+	UpgradePreflightsPassedCondition = "UpgradePreflightsPassed"
+)
+
+const (
+	ReconciledReason             = "Reconciled"
+	ReconcilingReason            = "Reconciling"
+	ResourceFailedReason         = "ResourceReconciliationFailed"
+	DeploymentNotAvailableReason = "DeploymentNotAvailable"
+	SleepingReason               = "Sleeping"
+	EndpointNotAssignedReason    = "EndpointNotAssigned"
+	DataStoreUnavailableReason   = "DataStoreUnavailable"
+	PreflightsPassedReason       = "PreflightChecksPassed"
+	PreflightsFailedReason       = "PreflightChecksFailed"
+	PreflightsSkippedReason      = "PreflightChecksSkipped"
+)
+
 // KubernetesStatus defines the status of the resources deployed in the management cluster,
 // such as Deployment and Service.
 type KubernetesStatus struct {
@@ -194,7 +411,7 @@ type KubernetesStatus struct {
 	Gateway    *KubernetesGatewayStatus   `json:"gateway,omitempty"`
 }
 
-// +kubebuilder:validation:Enum=Unknown;Provisioning;CertificateAuthorityRotating;Upgrading;Migrating;Ready;NotReady;Sleeping;WriteLimited
+// +kubebuilder:validation:Enum=Unknown;Provisioning;CertificateAuthorityRotating;Upgrading;Migrating;Restoring;Ready;NotReady;Sleeping;WriteLimited
 type KubernetesVersionStatus string
 
 var (
@@ -205,6 +422,7 @@ var (
 	VersionCARotating   KubernetesVersionStatus = "CertificateAuthorityRotating"
 	VersionUpgrading    KubernetesVersionStatus = "Upgrading"
 	VersionMigrating    KubernetesVersionStatus = "Migrating"
+	VersionRestoring    KubernetesVersionStatus = "Restoring"
 	VersionReady        KubernetesVersionStatus = "Ready"
 	VersionNotReady     KubernetesVersionStatus = "NotReady"
 )
@@ -215,6 +433,44 @@ type KubernetesVersion struct {
 	//+kubebuilder:default=Provisioning
 	// Status returns the current status of the Kubernetes version, such as its provisioning state, or completed upgrade.
 	Status *KubernetesVersionStatus `json:"status,omitempty"`
+	// Upgrade reports the progress of a sequential upgrade through the intermediate minor releases.
+	Upgrade *KubernetesUpgradeStatus `json:"upgrade,omitempty"`
+	// LastKnownGood references the rendered control plane recorded when the last upgrade started.
+	LastKnownGood *KubernetesLastKnownGoodStatus `json:"lastKnownGood,omitempty"`
+	// RolledBack reports the last upgrade reverted since the control plane did not become ready within the rollback deadline:
+	// the failed version is not rolled out again until the desired version is changed.
+	RolledBack *KubernetesRollbackStatus `json:"rolledBack,omitempty"`
+}
+
+// KubernetesLastKnownGoodStatus references the control plane Deployment spec, and the kubeadm configuration,
+// rendered for the version running before an upgrade.
+type KubernetesLastKnownGoodStatus struct {
+	// Version is the Kubernetes version the control plane was running.
+	Version string `json:"version"`
+	// SecretName is the name of the Secret storing the rendered control plane, in the Tenant Control Plane namespace.
+	SecretName string `json:"secretName"`
+	// RecordedAt is the time the upgrade started.
+	RecordedAt metav1.Time `json:"recordedAt"`
+}
+
+// KubernetesRollbackStatus reports an upgrade reverted to the last-known-good version.
+type KubernetesRollbackStatus struct {
+	// FailedVersion is the version the control plane did not become ready with.
+	FailedVersion string `json:"failedVersion"`
+	// Version is the last-known-good version the control plane has been reverted to.
+	Version string `json:"version"`
+	// RolledBackAt is the time the rollback has been performed.
+	RolledBackAt metav1.Time `json:"rolledBackAt"`
+}
+
+// KubernetesUpgradeStatus reports the versions a sequential upgrade is going through.
+type KubernetesUpgradeStatus struct {
+	// Target is the desired version the upgrade has been planned for.
+	Target string `json:"target"`
+	// Path lists the versions the Tenant Control Plane is upgraded to, one at a time, ending with the Target.
+	Path []string `json:"path"`
+	// Current is the version of the Path being currently rolled out.
+	Current string `json:"current"`
 }
 
 // KubernetesDeploymentStatus defines the status for the Tenant Control Plane Deployment in the management cluster.
@@ -239,6 +495,8 @@ type KubernetesServiceStatus struct {
 	Namespace string `json:"namespace"`
 	// The port where the service is running
 	Port int32 `json:"port"`
+	// ActivatorPort is the port of the Steward activator the Service is routed to while the Tenant Control Plane is sleeping.
+	ActivatorPort int32 `json:"activatorPort,omitempty"`
 }
 
 // KubernetesIngressStatus defines the status for the Tenant Control Plane Ingress in the management cluster.
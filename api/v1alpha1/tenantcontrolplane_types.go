@@ -6,8 +6,10 @@ package v1alpha1
 import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	apiserverv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
@@ -90,13 +92,241 @@ type KubeletSpec struct {
 // KubernetesSpec defines the desired state of Kubernetes.
 type KubernetesSpec struct {
 	// Kubernetes Version for the tenant control plane
-	Version string      `json:"version"`
-	Kubelet KubeletSpec `json:"kubelet"`
+	Version string `json:"version"`
+	// UpgradeStrategy defines how the Tenant Control Plane is upgraded to the desired Version:
+	// with the Sequential type, the Version can be several minor releases ahead of the running one,
+	// and Steward upgrades through each intermediate minor release, one at a time.
+	UpgradeStrategy *UpgradeStrategy `json:"upgradeStrategy,omitempty"`
+	Kubelet         KubeletSpec      `json:"kubelet"`
 
 	// List of enabled Admission Controllers for the Tenant cluster.
 	// Full reference available here: https://kubernetes.io/docs/reference/access-authn-authz/admission-controllers
 	//+kubebuilder:default=CertificateApproval;CertificateSigning;CertificateSubjectRestriction;DefaultIngressClass;DefaultStorageClass;DefaultTolerationSeconds;LimitRanger;MutatingAdmissionWebhook;NamespaceLifecycle;PersistentVolumeClaimResize;Priority;ResourceQuota;RuntimeClass;ServiceAccount;StorageObjectInUseProtection;TaintNodesByCondition;ValidatingAdmissionWebhook
 	AdmissionControllers AdmissionControllers `json:"admissionControllers,omitempty"`
+	// EncryptionAtRest enables the encryption of the Tenant Control Plane Secrets persisted in the DataStore.
+	// Steward generates and manages the EncryptionConfiguration consumed by the API Server,
+	// keys can be rotated by annotating the generated Secret with encryption.steward.butlerlabs.dev/rotate.
+	//
+	// Removing the field performs a decryption of the stored Secrets before dropping the configuration.
+	EncryptionAtRest *EncryptionAtRestSpec `json:"encryptionAtRest,omitempty"`
+	// Audit enables the audit logging of the Tenant Control Plane API Server.
+	// Steward renders the audit Policy in a ConfigMap, and configures the selected backends:
+	// changes to the Policy trigger a rollout of the Tenant Control Plane.
+	Audit *AuditSpec `json:"audit,omitempty"`
+	// Authentication enables the structured authentication of the Tenant Control Plane API Server,
+	// supporting multiple JWT issuers: requires Kubernetes v1.30, or greater.
+	// Steward renders the AuthenticationConfiguration in a ConfigMap, passed with the --authentication-config flag.
+	Authentication *AuthenticationSpec `json:"authentication,omitempty"`
+	// Authorization enables the structured authorization of the Tenant Control Plane API Server,
+	// replacing the default Node and RBAC authorization modes: requires Kubernetes v1.30, or greater.
+	// Steward renders the AuthorizationConfiguration in a ConfigMap, passed with the --authorization-config flag.
+	Authorization *AuthorizationSpec `json:"authorization,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Direct;Sequential
+type UpgradeStrategyType string
+
+const (
+	// UpgradeStrategyDirect upgrades straight to the desired version, allowing one minor release at most.
+	UpgradeStrategyDirect UpgradeStrategyType = "Direct"
+	// UpgradeStrategySequential upgrades through each intermediate minor release, up to the desired version.
+	UpgradeStrategySequential UpgradeStrategyType = "Sequential"
+)
+
+type UpgradeStrategy struct {
+	//+kubebuilder:default=Direct
+	Type UpgradeStrategyType `json:"type,omitempty"`
+	// IntermediateVersions pins the version used for an intermediate minor release with the Sequential type,
+	// such as v1.32.4: the first patch release of the minor, such as v1.32.0, is used otherwise.
+	// The versions out of the upgrade path, such as the ones left from a completed upgrade, are ignored.
+	IntermediateVersions []string `json:"intermediateVersions,omitempty"`
+	// RollbackDeadline is the time the control plane Deployment is given to make progress during an upgrade,
+	// enforced as its progress deadline: once exceeded, the Tenant Control Plane is reverted to the last-known-good
+	// version, recorded when the upgrade started. Automatic rollbacks are disabled when unset.
+	RollbackDeadline *metav1.Duration `json:"rollbackDeadline,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=aescbc;aesgcm;secretbox;kms
+type EncryptionProvider string
+
+const (
+	EncryptionProviderAESCBC    EncryptionProvider = "aescbc"
+	EncryptionProviderAESGCM    EncryptionProvider = "aesgcm"
+	EncryptionProviderSecretbox EncryptionProvider = "secretbox"
+	// EncryptionProviderKMS delegates the encryption to the KMS v2 plugin declared in the Tenant Control Plane Deployment.
+	EncryptionProviderKMS EncryptionProvider = "kms"
+)
+
+// EncryptionAtRestSpec defines the encryption at rest settings for the Tenant Control Plane.
+type EncryptionAtRestSpec struct {
+	// Provider is the transformer used to encrypt the Secrets, changing it triggers a key rotation.
+	// Full reference available here: https://kubernetes.io/docs/tasks/administer-cluster/encrypt-data/#providers
+	//+kubebuilder:default=aescbc
+	Provider EncryptionProvider `json:"provider,omitempty"`
+}
+
+// AuditSpec defines the audit logging settings for the Tenant Control Plane API Server.
+// Full reference available here: https://kubernetes.io/docs/tasks/debug/debug-cluster/audit/
+type AuditSpec struct {
+	Policy  AuditPolicySource `json:"policy"`
+	Backend AuditBackendSpec  `json:"backend"`
+}
+
+// AuditPolicySource defines where the audit Policy is read from.
+// +kubebuilder:validation:XValidation:rule="has(self.inline) != has(self.configMapRef)",message="Either inline or configMapRef must be set, but not both."
+type AuditPolicySource struct {
+	// Inline audit Policy, in YAML format.
+	Inline string `json:"inline,omitempty"`
+	// ConfigMapRef references the key of a ConfigMap in the Tenant Control Plane namespace containing the audit Policy:
+	// its content is copied by Steward, thus changes are picked up at the next reconciliation.
+	ConfigMapRef *corev1.ConfigMapKeySelector `json:"configMapRef,omitempty"`
+}
+
+// AuditBackendSpec defines the audit backends of the API Server, both can be enabled at the same time.
+// +kubebuilder:validation:XValidation:rule="has(self.log) || has(self.webhook)",message="At least a backend between log and webhook must be set."
+type AuditBackendSpec struct {
+	Log     *AuditLogBackendSpec     `json:"log,omitempty"`
+	Webhook *AuditWebhookBackendSpec `json:"webhook,omitempty"`
+}
+
+// AuditLogBackendSpec defines the log backend: audit events are written to a file stored in an emptyDir volume,
+// which can be shipped by a sidecar container.
+type AuditLogBackendSpec struct {
+	// SizeLimit of the emptyDir volume storing the audit logs.
+	SizeLimit *resource.Quantity `json:"sizeLimit,omitempty"`
+	//+kubebuilder:default={}
+	Retention AuditLogRetentionSpec `json:"retention,omitempty"`
+	// Shipper is an optional sidecar container in charge of shipping the audit logs,
+	// mounting them in read-only mode at /var/log/kubernetes/audit.
+	Shipper *AuditLogShipperSpec `json:"shipper,omitempty"`
+}
+
+// AuditLogRetentionSpec defines the rotation of the audit log files.
+type AuditLogRetentionSpec struct {
+	// MaxAge is the maximum number of days to retain old audit log files.
+	//+kubebuilder:default=7
+	//+kubebuilder:validation:Minimum=0
+	MaxAge int32 `json:"maxAge,omitempty"`
+	// MaxBackups is the maximum number of audit log files to retain.
+	//+kubebuilder:default=10
+	//+kubebuilder:validation:Minimum=0
+	MaxBackups int32 `json:"maxBackups,omitempty"`
+	// MaxSize is the maximum size in megabytes of the audit log file before it gets rotated.
+	//+kubebuilder:default=100
+	//+kubebuilder:validation:Minimum=0
+	MaxSize int32 `json:"maxSize,omitempty"`
+}
+
+// AuditLogShipperSpec defines the sidecar container shipping the audit logs.
+type AuditLogShipperSpec struct {
+	// Image of the shipper container.
+	//+kubebuilder:validation:MinLength=1
+	Image string `json:"image"`
+	// Command of the shipper container, the image entrypoint is used if not provided.
+	Command []string `json:"command,omitempty"`
+	// Args of the shipper container.
+	Args []string `json:"args,omitempty"`
+	// Env of the shipper container.
+	Env []corev1.EnvVar `json:"env,omitempty"`
+	// Resources of the shipper container.
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// AuditWebhookBackendSpec defines the webhook backend: audit events are sent to a remote API.
+type AuditWebhookBackendSpec struct {
+	// KubeconfigSecretRef references the key of a Secret in the Tenant Control Plane namespace
+	// containing the kubeconfig used to reach the remote API: changes to the Secret trigger a rollout.
+	KubeconfigSecretRef corev1.SecretKeySelector `json:"kubeconfigSecretRef"`
+	// Mode is the strategy for sending audit events.
+	//+kubebuilder:validation:Enum=batch;blocking;blocking-strict
+	//+kubebuilder:default=batch
+	Mode string `json:"mode,omitempty"`
+	// InitialBackoff is the amount of time to wait before retrying the first failed request.
+	InitialBackoff *metav1.Duration `json:"initialBackoff,omitempty"`
+}
+
+// AuthenticationSpec defines the structured authentication settings for the Tenant Control Plane API Server.
+// Full reference available here: https://kubernetes.io/docs/reference/access-authn-authz/authentication/#using-authentication-configuration
+type AuthenticationSpec struct {
+	// JWT is the list of authenticators used to authenticate users using JWT compliant tokens,
+	// each one must have a unique issuer URL.
+	//+kubebuilder:validation:MinItems=1
+	JWT []JWTAuthenticatorSpec `json:"jwt"`
+}
+
+// JWTAuthenticatorSpec is the JWT authenticator of the AuthenticationConfiguration,
+// extended to reference the issuer certificate authority from a ConfigMap.
+type JWTAuthenticatorSpec struct {
+	apiserverv1.JWTAuthenticator `json:",inline"`
+	// CertificateAuthorityRef references the key of a ConfigMap in the Tenant Control Plane namespace containing
+	// the PEM-encoded certificate authority used to validate the connection to the issuer.
+	// Mutually exclusive with issuer.certificateAuthority.
+	CertificateAuthorityRef *corev1.ConfigMapKeySelector `json:"certificateAuthorityRef,omitempty"`
+}
+
+// AuthorizationSpec defines the structured authorization settings for the Tenant Control Plane API Server.
+// Full reference available here: https://kubernetes.io/docs/reference/access-authn-authz/authorization/#using-configuration-file-for-authorization
+type AuthorizationSpec struct {
+	// Authorizers is the ordered chain of authorizers evaluated by the API Server,
+	// the Node and RBAC ones are required unless AllowDefaultAuthorizersRemoval is enabled.
+	//+kubebuilder:validation:MinItems=1
+	Authorizers []AuthorizerSpec `json:"authorizers"`
+	// AllowDefaultAuthorizersRemoval allows a chain lacking the Node, or RBAC, authorizer:
+	// kubelets and cluster components relying on them could be unable to operate.
+	AllowDefaultAuthorizersRemoval bool `json:"allowDefaultAuthorizersRemoval,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Node;RBAC;Webhook
+type AuthorizerType string
+
+const (
+	AuthorizerTypeNode    AuthorizerType = "Node"
+	AuthorizerTypeRBAC    AuthorizerType = "RBAC"
+	AuthorizerTypeWebhook AuthorizerType = "Webhook"
+)
+
+// AuthorizerSpec defines an authorizer of the chain.
+// +kubebuilder:validation:XValidation:rule="self.type == 'Webhook' ? has(self.webhook) : !has(self.webhook)",message="The webhook settings must be set only for the Webhook authorizer type."
+type AuthorizerSpec struct {
+	Type AuthorizerType `json:"type"`
+	// Name of the authorizer, it must be unique and a valid DNS subdomain.
+	//+kubebuilder:validation:MinLength=1
+	Name    string                 `json:"name"`
+	Webhook *WebhookAuthorizerSpec `json:"webhook,omitempty"`
+}
+
+// WebhookAuthorizerSpec defines the settings of a webhook authorizer.
+type WebhookAuthorizerSpec struct {
+	// KubeconfigSecretRef references the key of a Secret in the Tenant Control Plane namespace
+	// containing the kubeconfig used to reach the remote webhook: changes to the Secret trigger a rollout.
+	KubeconfigSecretRef corev1.SecretKeySelector `json:"kubeconfigSecretRef"`
+	// AuthorizedTTL is the duration to cache authorized responses from the webhook.
+	//+kubebuilder:default="5m"
+	AuthorizedTTL metav1.Duration `json:"authorizedTTL,omitempty"`
+	// CacheAuthorizedRequests specifies whether authorized requests should be cached: requires Kubernetes v1.34, or greater.
+	CacheAuthorizedRequests *bool `json:"cacheAuthorizedRequests,omitempty"`
+	// UnauthorizedTTL is the duration to cache unauthorized responses from the webhook.
+	//+kubebuilder:default="30s"
+	UnauthorizedTTL metav1.Duration `json:"unauthorizedTTL,omitempty"`
+	// CacheUnauthorizedRequests specifies whether unauthorized requests should be cached: requires Kubernetes v1.34, or greater.
+	CacheUnauthorizedRequests *bool `json:"cacheUnauthorizedRequests,omitempty"`
+	// Timeout for the webhook request, the maximum allowed value is 30s.
+	//+kubebuilder:default="3s"
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+	// SubjectAccessReviewVersion is the API version of the SubjectAccessReview object sent to and expected from the webhook.
+	//+kubebuilder:validation:Enum=v1;v1beta1
+	//+kubebuilder:default=v1
+	SubjectAccessReviewVersion string `json:"subjectAccessReviewVersion,omitempty"`
+	// MatchConditionSubjectAccessReviewVersion is the SubjectAccessReview version the CEL match conditions are evaluated against.
+	//+kubebuilder:validation:Enum=v1
+	//+kubebuilder:default=v1
+	MatchConditionSubjectAccessReviewVersion string `json:"matchConditionSubjectAccessReviewVersion,omitempty"`
+	// FailurePolicy defines how to treat failures when the webhook cannot be reached, or returns a malformed response.
+	//+kubebuilder:validation:Enum=NoOpinion;Deny
+	//+kubebuilder:default=NoOpinion
+	FailurePolicy string `json:"failurePolicy,omitempty"`
+	// MatchConditions is a list of CEL expressions that must be met for a request to be sent to the webhook.
+	MatchConditions []apiserverv1.WebhookMatchCondition `json:"matchConditions,omitempty"`
 }
 
 type AdditionalPort struct {
@@ -238,6 +468,36 @@ type DeploymentSpec struct {
 	//+kubebuilder:default="default"
 	// ServiceAccountName allows to specify the service account to be mounted to the pods of the Control plane deployment
 	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+	// KMSPlugin defines the KMS v2 plugin running as a sidecar of the API Server,
+	// required when the encryption at rest is using the kms provider.
+	KMSPlugin *KMSPluginSpec `json:"kmsPlugin,omitempty"`
+}
+
+// KMSPluginSpec defines the KMS v2 gRPC plugin used for the envelope encryption of the Tenant Control Plane data.
+// The plugin must listen on the Unix Domain Socket unix:///var/run/kmsplugin/socket.sock:
+// the socket directory is shared with the API Server by Steward.
+type KMSPluginSpec struct {
+	// Name of the KMS provider in the EncryptionConfiguration: it's part of the stored data,
+	// and cannot be changed while the kms provider is in use.
+	//+kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	//+kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+	// Image of the KMS plugin container.
+	//+kubebuilder:validation:MinLength=1
+	Image string `json:"image"`
+	// Command of the KMS plugin container, the image entrypoint is used if not provided.
+	Command []string `json:"command,omitempty"`
+	// Args of the KMS plugin container.
+	Args []string `json:"args,omitempty"`
+	// Env of the KMS plugin container, useful to provide the KMS credentials.
+	Env []corev1.EnvVar `json:"env,omitempty"`
+	// VolumeMounts of the KMS plugin container: volumes must be declared using AdditionalVolumes.
+	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
+	// Resources of the KMS plugin container.
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+	// Timeout for the gRPC calls performed by the API Server to the KMS plugin.
+	//+kubebuilder:default="3s"
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
 }
 
 // AdditionalVolumeMounts allows mounting additional volumes to the Control Plane components.
@@ -391,8 +651,24 @@ type DataStoreOverride struct {
 	DataStore string `json:"dataStore,omitempty"`
 }
 
+// DataStoreMode is where the Tenant Control Plane stores its data, either a shared DataStore or a dedicated etcd cluster.
+// +kubebuilder:validation:Enum=Shared;Dedicated
+type DataStoreMode string
+
+const (
+	// DataStoreModeShared stores the data in the DataStore referred by the Tenant Control Plane, shared with other ones.
+	DataStoreModeShared DataStoreMode = "Shared"
+	// DataStoreModeDedicated stores the data in an etcd cluster provisioned for the Tenant Control Plane only.
+	DataStoreModeDedicated DataStoreMode = "Dedicated"
+)
+
+// DedicatedDataStoreName is the name reported by the Tenant Control Planes using their dedicated etcd cluster:
+// it's not a valid object name, thus it never conflicts with an actual DataStore.
+const DedicatedDataStoreName = "Dedicated"
+
 // TenantControlPlaneSpec defines the desired state of TenantControlPlane.
 // +kubebuilder:validation:XValidation:rule="!has(oldSelf.dataStore) || has(self.dataStore)", message="unsetting the dataStore is not supported"
+// +kubebuilder:validation:XValidation:rule="!has(self.dataStoreMode) || self.dataStoreMode != 'Dedicated' || !has(self.dataStoreOverrides) || size(self.dataStoreOverrides) == 0", message="dataStoreOverrides are not supported with the Dedicated dataStoreMode"
 // +kubebuilder:validation:XValidation:rule="!has(oldSelf.dataStoreSchema) || has(self.dataStoreSchema)", message="unsetting the dataStoreSchema is not supported"
 // +kubebuilder:validation:XValidation:rule="!has(oldSelf.dataStoreUsername) || has(self.dataStoreUsername)", message="unsetting the dataStoreUsername is not supported"
 // +kubebuilder:validation:XValidation:rule="!has(self.networkProfile.loadBalancerSourceRanges) || (size(self.networkProfile.loadBalancerSourceRanges) == 0 || self.controlPlane.service.serviceType == 'LoadBalancer')", message="LoadBalancer source ranges are supported only with LoadBalancer service type"
@@ -407,13 +683,26 @@ type TenantControlPlaneSpec struct {
 	// this phase can be used to prevent Datastore quota exhaustion or for your own business logic
 	// (e.g.: blocking creation and update, but allowing deletion to "clean up" space).
 	WritePermissions Permissions `json:"writePermissions,omitempty"`
+	// StorageQuota is the maximum storage the Tenant Control Plane can use in its DataStore, as reported by the status usage:
+	// once exceeded, creations and updates are blocked, along with the WritePermissions ones, until the usage falls back below it.
+	// Deletions are allowed to clean up space.
+	StorageQuota *resource.Quantity `json:"storageQuota,omitempty"`
 	// DataStore specifies the DataStore that should be used to store the Kubernetes data for the given Tenant Control Plane.
 	// When Steward runs with the default DataStore flag, all empty values will inherit the default value.
-	// By leaving it empty and running Steward with no default DataStore flag, it is possible to achieve automatic assignment to a specific DataStore object.
+	// When a DataStorePlacementPolicy selects the Tenant Control Plane, an empty value is assigned a DataStore of its pool upon creation,
+	// taking precedence over the default DataStore flag.
 	//
 	// Migration from one DataStore to another backed by the same Driver is possible. See: https://steward.butlerlabs.dev/guides/datastore-migration/
-	// Migration from one DataStore to another backed by a different Driver is not supported.
+	// Migration from one DataStore to another backed by a different Driver requires the steward.butlerlabs.dev/allow-cross-driver-migration annotation,
+	// and is supported between etcd, MySQL, and PostgreSQL only.
 	DataStore string `json:"dataStore,omitempty"`
+	// DataStoreMode defines whether the Tenant Control Plane stores its data in the shared DataStore referred by the DataStore field,
+	// or in a dedicated etcd cluster provisioned by Steward in the Tenant Control Plane namespace, and owned by it.
+	// Switching the mode migrates the data between the shared DataStore and the dedicated etcd cluster.
+	//+kubebuilder:default=Shared
+	DataStoreMode DataStoreMode `json:"dataStoreMode,omitempty"`
+	// DedicatedDataStore defines the etcd cluster provisioned when the DataStoreMode is Dedicated.
+	DedicatedDataStore *EtcdClusterSpec `json:"dedicatedDataStore,omitempty"`
 	// DataStoreSchema allows to specify the name of the database (for relational DataStores) or the key prefix (for etcd). This
 	// value is optional and immutable. Note that Steward currently doesn't ensure that DataStoreSchema values are unique. It's up
 	// to the user to avoid clashes between different TenantControlPlanes. If not set upon creation, Steward will default the
@@ -435,6 +724,9 @@ type TenantControlPlaneSpec struct {
 	NetworkProfile NetworkProfileSpec `json:"networkProfile,omitempty"`
 	// Addons contain which addons are enabled
 	Addons AddonsSpec `json:"addons,omitempty"`
+	// Hibernation allows to automatically put the Tenant Control Plane to sleep according to schedules,
+	// or when idle: sleeping is achieved by scaling the Tenant Control Plane replicas to zero.
+	Hibernation *HibernationSpec `json:"hibernation,omitempty"`
 }
 
 //+kubebuilder:object:root=true
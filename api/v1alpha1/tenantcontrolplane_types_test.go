@@ -75,4 +75,24 @@ var _ = Describe("Cluster controller", func() {
 			Expect(err.Error()).To(ContainSubstring("LoadBalancer source ranges are supported only with LoadBalancer service type"))
 		})
 	})
+
+	Context("Dedicated DataStore mode", func() {
+		It("defaults the dedicated etcd cluster", func() {
+			tcp.Spec.DataStoreMode = DataStoreModeDedicated
+			tcp.Spec.DedicatedDataStore = &EtcdClusterSpec{}
+
+			err := k8sClient.Create(ctx, tcp)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tcp.Spec.DedicatedDataStore.Replicas).To(Equal(int32(3)))
+		})
+
+		It("denies DataStore overrides", func() {
+			tcp.Spec.DataStoreMode = DataStoreModeDedicated
+			tcp.Spec.DataStoreOverrides = []DataStoreOverride{{Resource: "/events", DataStore: "events"}}
+
+			err := k8sClient.Create(ctx, tcp)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("dataStoreOverrides are not supported with the Dedicated dataStoreMode"))
+		})
+	})
 })
@@ -0,0 +1,124 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BackupStorage defines where the backup archives are stored.
+// +kubebuilder:validation:XValidation:rule="has(self.persistentVolumeClaim) != has(self.s3)",message="exactly one of persistentVolumeClaim or s3 must be specified"
+type BackupStorage struct {
+	// PersistentVolumeClaim stores the archives in a volume:
+	// the claim must exist in the Steward Namespace, where the backup Jobs are running.
+	PersistentVolumeClaim *BackupPersistentVolumeClaimStorage `json:"persistentVolumeClaim,omitempty"`
+	// S3 stores the archives in a bucket of an S3-compatible object storage.
+	S3 *BackupS3Storage `json:"s3,omitempty"`
+}
+
+type BackupPersistentVolumeClaimStorage struct {
+	// ClaimName is the name of the PersistentVolumeClaim in the Steward Namespace.
+	//+kubebuilder:validation:MinLength=1
+	ClaimName string `json:"claimName"`
+	// Path is the directory of the volume where the archives are stored.
+	Path string `json:"path,omitempty"`
+}
+
+type BackupS3Storage struct {
+	// Endpoint is the host, and the optional port, of the S3-compatible object storage.
+	//+kubebuilder:validation:MinLength=1
+	Endpoint string `json:"endpoint"`
+	// Bucket is the name of the bucket where the archives are stored.
+	//+kubebuilder:validation:MinLength=1
+	Bucket string `json:"bucket"`
+	// Prefix is prepended to the object names of the archives.
+	Prefix string `json:"prefix,omitempty"`
+	// Region of the bucket, if required by the object storage.
+	Region string `json:"region,omitempty"`
+	// Insecure disables TLS when connecting to the object storage.
+	Insecure bool `json:"insecure,omitempty"`
+	// CredentialsSecret is the Secret in the Namespace of the backup containing the
+	// `accessKeyID` and `secretAccessKey` keys used to authenticate against the object storage.
+	CredentialsSecret corev1.LocalObjectReference `json:"credentialsSecret"`
+}
+
+// TenantControlPlaneBackupSpec defines the desired state of TenantControlPlaneBackup.
+type TenantControlPlaneBackupSpec struct {
+	// TenantControlPlane is the name of the Tenant Control Plane to back up, in the same Namespace.
+	//+kubebuilder:validation:MinLength=1
+	//+kubebuilder:validation:XValidation:rule="self == oldSelf",message="tenantControlPlane is immutable"
+	TenantControlPlane string `json:"tenantControlPlane"`
+	// Storage is where the backup archive is stored.
+	//+kubebuilder:validation:XValidation:rule="self == oldSelf",message="storage is immutable"
+	Storage BackupStorage `json:"storage"`
+}
+
+// +kubebuilder:validation:Enum=Pending;Running;Completed;Failed
+type BackupPhase string
+
+const (
+	BackupPhasePending   BackupPhase = "Pending"
+	BackupPhaseRunning   BackupPhase = "Running"
+	BackupPhaseCompleted BackupPhase = "Completed"
+	BackupPhaseFailed    BackupPhase = "Failed"
+)
+
+// TenantControlPlaneBackupStatus defines the observed state of TenantControlPlaneBackup.
+type TenantControlPlaneBackupStatus struct {
+	// Phase of the backup.
+	Phase BackupPhase `json:"phase,omitempty"`
+	// Message reports the reason of the failed backup.
+	Message string `json:"message,omitempty"`
+	// DataStore is the name of the DataStore the keyspace has been exported from.
+	DataStore string `json:"dataStore,omitempty"`
+	// Driver is the driver of the DataStore the keyspace has been exported from.
+	Driver string `json:"driver,omitempty"`
+	// Location is the URL of the backup archive.
+	Location string `json:"location,omitempty"`
+	// Size is the size of the backup archive, in bytes.
+	Size int64 `json:"size,omitempty"`
+	// Keys is the amount of keys stored in the backup archive.
+	Keys int64 `json:"keys,omitempty"`
+	// Revision is the DataStore revision of the exported keyspace.
+	Revision int64 `json:"revision,omitempty"`
+	// Checksum is the SHA-256 checksum of the backup archive.
+	Checksum string `json:"checksum,omitempty"`
+	// StartTime is the time the backup started.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime is the time the backup completed, or failed.
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:shortName=tcpbackup,categories=steward
+//+kubebuilder:printcolumn:name="Tenant Control Plane",type="string",JSONPath=".spec.tenantControlPlane",description="The backed up Tenant Control Plane"
+//+kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="The backup phase"
+//+kubebuilder:printcolumn:name="Keys",type="integer",JSONPath=".status.keys",description="The amount of exported keys"
+//+kubebuilder:printcolumn:name="Size",type="integer",JSONPath=".status.size",description="The archive size in bytes"
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Age"
+
+// TenantControlPlaneBackup is the Schema for the tenantcontrolplanebackups API:
+// it exports the keyspace of a Tenant Control Plane from its DataStore into a portable archive.
+type TenantControlPlaneBackup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TenantControlPlaneBackupSpec   `json:"spec,omitempty"`
+	Status TenantControlPlaneBackupStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// TenantControlPlaneBackupList contains a list of TenantControlPlaneBackup.
+type TenantControlPlaneBackupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TenantControlPlaneBackup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TenantControlPlaneBackup{}, &TenantControlPlaneBackupList{})
+}
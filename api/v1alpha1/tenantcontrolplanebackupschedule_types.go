@@ -0,0 +1,73 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TenantControlPlaneBackupScheduleSpec defines the desired state of TenantControlPlaneBackupSchedule.
+type TenantControlPlaneBackupScheduleSpec struct {
+	// Schedule is the cron expression, in the standard five fields format, when the backups are created.
+	//+kubebuilder:validation:MinLength=1
+	Schedule string `json:"schedule"`
+	// TimeZone is the IANA name of the time zone used to evaluate the cron expression.
+	//+kubebuilder:default="UTC"
+	TimeZone string `json:"timeZone,omitempty"`
+	// Suspend stops the creation of new backups, without affecting the existing ones.
+	Suspend bool `json:"suspend,omitempty"`
+	// Template is the spec of the created backups.
+	Template TenantControlPlaneBackupSpec `json:"template"`
+	// SuccessfulBackupsHistoryLimit is the amount of completed backups to retain:
+	// the archives of the deleted backups are not removed from the storage.
+	//+kubebuilder:default=7
+	//+kubebuilder:validation:Minimum=0
+	SuccessfulBackupsHistoryLimit *int32 `json:"successfulBackupsHistoryLimit,omitempty"`
+	// FailedBackupsHistoryLimit is the amount of failed backups to retain.
+	//+kubebuilder:default=1
+	//+kubebuilder:validation:Minimum=0
+	FailedBackupsHistoryLimit *int32 `json:"failedBackupsHistoryLimit,omitempty"`
+}
+
+// TenantControlPlaneBackupScheduleStatus defines the observed state of TenantControlPlaneBackupSchedule.
+type TenantControlPlaneBackupScheduleStatus struct {
+	// LastScheduleTime is the last time a backup has been created.
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+	// LastSuccessfulBackup is the name of the last completed backup.
+	LastSuccessfulBackup string `json:"lastSuccessfulBackup,omitempty"`
+	// Message reports the reason the schedule cannot be evaluated.
+	Message string `json:"message,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:shortName=tcpbackupschedule,categories=steward
+//+kubebuilder:printcolumn:name="Tenant Control Plane",type="string",JSONPath=".spec.template.tenantControlPlane",description="The backed up Tenant Control Plane"
+//+kubebuilder:printcolumn:name="Schedule",type="string",JSONPath=".spec.schedule",description="The backup schedule"
+//+kubebuilder:printcolumn:name="Suspend",type="boolean",JSONPath=".spec.suspend",description="The schedule is suspended"
+//+kubebuilder:printcolumn:name="Last Schedule",type="date",JSONPath=".status.lastScheduleTime",description="The last scheduled backup"
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Age"
+
+// TenantControlPlaneBackupSchedule is the Schema for the tenantcontrolplanebackupschedules API:
+// it creates TenantControlPlaneBackup objects on a cron schedule, retaining a limited history.
+type TenantControlPlaneBackupSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TenantControlPlaneBackupScheduleSpec   `json:"spec,omitempty"`
+	Status TenantControlPlaneBackupScheduleStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// TenantControlPlaneBackupScheduleList contains a list of TenantControlPlaneBackupSchedule.
+type TenantControlPlaneBackupScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TenantControlPlaneBackupSchedule `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TenantControlPlaneBackupSchedule{}, &TenantControlPlaneBackupScheduleList{})
+}
@@ -0,0 +1,116 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RestoreSource defines the archive to restore.
+// +kubebuilder:validation:XValidation:rule="has(self.backup) != has(self.archive)",message="exactly one of backup or archive must be specified"
+type RestoreSource struct {
+	// Backup is the name of a completed TenantControlPlaneBackup in the same Namespace.
+	Backup string `json:"backup,omitempty"`
+	// Archive refers to an archive stored out of a TenantControlPlaneBackup,
+	// such as the one of a Tenant Control Plane of another management cluster.
+	Archive *RestoreArchive `json:"archive,omitempty"`
+}
+
+type RestoreArchive struct {
+	// Storage is where the archive is stored.
+	Storage BackupStorage `json:"storage"`
+	// Name is the name of the archive, relative to the storage root and including the S3 prefix,
+	// such as `<namespace>/<tenantControlPlane>/<backup>.jsonl.gz`.
+	//+kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Checksum is the expected SHA-256 checksum of the archive, in the `sha256:<hex>` format.
+	Checksum string `json:"checksum,omitempty"`
+}
+
+// TenantControlPlaneRestoreSpec defines the desired state of TenantControlPlaneRestore.
+type TenantControlPlaneRestoreSpec struct {
+	// TenantControlPlane is the name of the Tenant Control Plane to restore, in the same Namespace:
+	// its keyspace is replaced with the one of the archive, in the DataStore it's currently using.
+	//+kubebuilder:validation:MinLength=1
+	//+kubebuilder:validation:XValidation:rule="self == oldSelf",message="tenantControlPlane is immutable"
+	TenantControlPlane string `json:"tenantControlPlane"`
+	// Source is the archive to restore.
+	//+kubebuilder:validation:XValidation:rule="self == oldSelf",message="source is immutable"
+	Source RestoreSource `json:"source"`
+	// Confirmed lifts the write block put on the Tenant Control Plane by the restore:
+	// the operator must set it once the restored Tenant Control Plane has been verified.
+	Confirmed bool `json:"confirmed,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Pending;Restoring;AwaitingConfirmation;Completed;Failed
+type RestorePhase string
+
+const (
+	RestorePhasePending              RestorePhase = "Pending"
+	RestorePhaseRestoring            RestorePhase = "Restoring"
+	RestorePhaseAwaitingConfirmation RestorePhase = "AwaitingConfirmation"
+	RestorePhaseCompleted            RestorePhase = "Completed"
+	RestorePhaseFailed               RestorePhase = "Failed"
+)
+
+// TenantControlPlaneRestoreStatus defines the observed state of TenantControlPlaneRestore.
+type TenantControlPlaneRestoreStatus struct {
+	// Phase of the restore.
+	Phase RestorePhase `json:"phase,omitempty"`
+	// Message reports the reason the restore is pending, or failed.
+	Message string `json:"message,omitempty"`
+	// DataStore is the name of the DataStore the keyspace has been restored into.
+	DataStore string `json:"dataStore,omitempty"`
+	// Schema is the schema the keyspace has been restored into.
+	Schema string `json:"schema,omitempty"`
+	// SourceSchema is the schema the archive keyspace has been exported from.
+	SourceSchema string `json:"sourceSchema,omitempty"`
+	// SourceDriver is the driver of the DataStore the archive keyspace has been exported from.
+	SourceDriver string `json:"sourceDriver,omitempty"`
+	// Keys is the amount of restored keys.
+	Keys int64 `json:"keys,omitempty"`
+	// Checksum is the SHA-256 checksum of the restored archive.
+	Checksum string `json:"checksum,omitempty"`
+	// WritePermissions are the write permissions of the Tenant Control Plane before the restore blocked the writes,
+	// applied back upon confirmation.
+	WritePermissions *Permissions `json:"writePermissions,omitempty"`
+	// StartTime is the time the restore started.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// RestoreTime is the time the keyspace has been restored.
+	RestoreTime *metav1.Time `json:"restoreTime,omitempty"`
+	// CompletionTime is the time the restore has been confirmed, or failed.
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:shortName=tcprestore,categories=steward
+//+kubebuilder:printcolumn:name="Tenant Control Plane",type="string",JSONPath=".spec.tenantControlPlane",description="The restored Tenant Control Plane"
+//+kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="The restore phase"
+//+kubebuilder:printcolumn:name="Keys",type="integer",JSONPath=".status.keys",description="The amount of restored keys"
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Age"
+
+// TenantControlPlaneRestore is the Schema for the tenantcontrolplanerestores API:
+// it replaces the keyspace of a Tenant Control Plane with the one of a backup archive,
+// blocking the writes until the operator confirms the restored state.
+type TenantControlPlaneRestore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TenantControlPlaneRestoreSpec   `json:"spec,omitempty"`
+	Status TenantControlPlaneRestoreStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// TenantControlPlaneRestoreList contains a list of TenantControlPlaneRestore.
+type TenantControlPlaneRestoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TenantControlPlaneRestore `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TenantControlPlaneRestore{}, &TenantControlPlaneRestoreList{})
+}
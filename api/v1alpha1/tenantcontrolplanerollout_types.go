@@ -0,0 +1,141 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TenantControlPlaneRolloutAddons are the addon image tags set along with the version,
+// applied only to the Tenant Control Planes with the given addon enabled.
+type TenantControlPlaneRolloutAddons struct {
+	// CoreDNSImageTag is the image tag of the CoreDNS addon.
+	CoreDNSImageTag string `json:"coreDNSImageTag,omitempty"`
+	// KubeProxyImageTag is the image tag of the kube-proxy addon.
+	KubeProxyImageTag string `json:"kubeProxyImageTag,omitempty"`
+	// KonnectivityVersion is the version of both the Konnectivity server and agent.
+	KonnectivityVersion string `json:"konnectivityVersion,omitempty"`
+}
+
+// TenantControlPlaneRolloutSpec defines the desired state of TenantControlPlaneRollout.
+type TenantControlPlaneRolloutSpec struct {
+	// TenantControlPlaneSelector selects the Tenant Control Planes to upgrade, by their labels, across all the namespaces:
+	// when empty, all the Tenant Control Planes are selected.
+	TenantControlPlaneSelector metav1.LabelSelector `json:"tenantControlPlaneSelector,omitempty"`
+	// Version is the Kubernetes version the selected Tenant Control Planes are upgraded to,
+	// through their own upgrade strategy.
+	//+kubebuilder:validation:MinLength=1
+	Version string `json:"version"`
+	// Addons optionally sets the image tags of the addons along with the version.
+	Addons *TenantControlPlaneRolloutAddons `json:"addons,omitempty"`
+	// BatchSize is the amount of Tenant Control Planes of each batch, upgraded in the namespace and name order:
+	// the next batch is started once all the upgrades of the current one are finished, and its soak time elapsed.
+	// A small batch size allows to canary a handful of Tenant Control Planes before the rest.
+	//+kubebuilder:default=1
+	//+kubebuilder:validation:Minimum=1
+	BatchSize int32 `json:"batchSize,omitempty"`
+	// MaxUnavailable is the amount of Tenant Control Planes of a batch being upgraded at the same time,
+	// thus not serving with the target version: when unset, all the batch is upgraded at once.
+	//+kubebuilder:validation:Minimum=1
+	MaxUnavailable *int32 `json:"maxUnavailable,omitempty"`
+	// PauseOnFailure stops starting new upgrades once a Tenant Control Plane fails its upgrade,
+	// such as with failed preflight checks, or a rollback: the rollout resumes once the failed upgrade is fixed.
+	//+kubebuilder:default=true
+	PauseOnFailure *bool `json:"pauseOnFailure,omitempty"`
+	// SoakTime is how long to wait after a batch has been upgraded before starting the next one.
+	SoakTime *metav1.Duration `json:"soakTime,omitempty"`
+	// Paused stops starting new upgrades, without affecting the ones in progress.
+	Paused bool `json:"paused,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Progressing;Soaking;Paused;Failed;Completed
+type RolloutPhase string
+
+var (
+	RolloutPhaseProgressing RolloutPhase = "Progressing"
+	// RolloutPhaseSoaking means the current batch has been upgraded, and the next one waits for the soak time.
+	RolloutPhaseSoaking RolloutPhase = "Soaking"
+	RolloutPhasePaused  RolloutPhase = "Paused"
+	// RolloutPhaseFailed means the rollout stopped starting new upgrades, since at least one of them failed.
+	RolloutPhaseFailed    RolloutPhase = "Failed"
+	RolloutPhaseCompleted RolloutPhase = "Completed"
+)
+
+// +kubebuilder:validation:Enum=Pending;Upgrading;Upgraded;Failed
+type RolloutTenantControlPlanePhase string
+
+var (
+	RolloutTenantControlPlanePhasePending   RolloutTenantControlPlanePhase = "Pending"
+	RolloutTenantControlPlanePhaseUpgrading RolloutTenantControlPlanePhase = "Upgrading"
+	RolloutTenantControlPlanePhaseUpgraded  RolloutTenantControlPlanePhase = "Upgraded"
+	RolloutTenantControlPlanePhaseFailed    RolloutTenantControlPlanePhase = "Failed"
+)
+
+// RolloutTenantControlPlaneStatus reports the progress of the upgrade of a Tenant Control Plane selected by the rollout.
+type RolloutTenantControlPlaneStatus struct {
+	Namespace string                         `json:"namespace"`
+	Name      string                         `json:"name"`
+	Phase     RolloutTenantControlPlanePhase `json:"phase"`
+	// Batch is the batch the upgrade has been started with, zero-based: unset while pending.
+	Batch *int32 `json:"batch,omitempty"`
+	// Message reports the reason of a failed upgrade.
+	Message string `json:"message,omitempty"`
+	// RejectedGeneration is the Tenant Control Plane generation its upgrade has been rejected with:
+	// the upgrade is not retried until the Tenant Control Plane spec changes.
+	RejectedGeneration int64        `json:"rejectedGeneration,omitempty"`
+	StartTime          *metav1.Time `json:"startTime,omitempty"`
+	CompletionTime     *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// TenantControlPlaneRolloutStatus defines the observed state of TenantControlPlaneRollout.
+type TenantControlPlaneRolloutStatus struct {
+	Phase RolloutPhase `json:"phase,omitempty"`
+	// Version is the target version the Tenant Control Planes progress refers to:
+	// changing the desired one starts the rollout from the beginning.
+	Version string `json:"version,omitempty"`
+	// CurrentBatch is the last started batch, zero-based.
+	CurrentBatch *int32 `json:"currentBatch,omitempty"`
+	// BatchCompletionTime is the time the current batch has been upgraded, used to evaluate the soak time.
+	BatchCompletionTime *metav1.Time `json:"batchCompletionTime,omitempty"`
+	// TenantControlPlanes reports the progress of the selected Tenant Control Planes, in the rollout order.
+	TenantControlPlanes []RolloutTenantControlPlaneStatus `json:"tenantControlPlanes,omitempty"`
+	Total               int32                             `json:"total,omitempty"`
+	Upgraded            int32                             `json:"upgraded,omitempty"`
+	Failed              int32                             `json:"failed,omitempty"`
+	// Message reports the reason the rollout is not progressing.
+	Message string `json:"message,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:scope=Cluster,shortName=tcprollout,categories=steward
+//+kubebuilder:printcolumn:name="Version",type="string",JSONPath=".spec.version",description="The target Kubernetes version"
+//+kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="The rollout phase"
+//+kubebuilder:printcolumn:name="Upgraded",type="integer",JSONPath=".status.upgraded",description="The upgraded Tenant Control Planes"
+//+kubebuilder:printcolumn:name="Failed",type="integer",JSONPath=".status.failed",description="The failed Tenant Control Plane upgrades"
+//+kubebuilder:printcolumn:name="Total",type="integer",JSONPath=".status.total",description="The selected Tenant Control Planes"
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Age"
+
+// TenantControlPlaneRollout is the Schema for the tenantcontrolplanerollouts API:
+// it upgrades the selected Tenant Control Planes to a Kubernetes version, in batches.
+type TenantControlPlaneRollout struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TenantControlPlaneRolloutSpec   `json:"spec,omitempty"`
+	Status TenantControlPlaneRolloutStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// TenantControlPlaneRolloutList contains a list of TenantControlPlaneRollout.
+type TenantControlPlaneRolloutList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TenantControlPlaneRollout `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TenantControlPlaneRollout{}, &TenantControlPlaneRolloutList{})
+}
@@ -107,7 +107,7 @@ var _ = Describe("Datastores validation test", func() {
 
 			err := k8sClient.Create(ctx, ds)
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(ContainSubstring("When driver is not etcd, either tlsConfig or basicAuth must be provided"))
+			Expect(err.Error()).To(ContainSubstring("When driver is not etcd or SQLite, either tlsConfig or basicAuth must be provided"))
 		})
 
 		It("datastores of type PostgreSQL can have basicAuth", func() {
@@ -173,5 +173,108 @@ var _ = Describe("Datastores validation test", func() {
 			err := k8sClient.Create(context.Background(), ds)
 			Expect(err).ToNot(HaveOccurred())
 		})
+
+		It("datastores of type SQLite need neither endpoints, nor credentials", func() {
+			ds = &DataStore{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "good-sqlite",
+				},
+				Spec: DataStoreSpec{
+					Driver: "SQLite",
+				},
+			}
+
+			err := k8sClient.Create(context.Background(), ds)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("datastores not of type SQLite must have endpoints", func() {
+			ds = &DataStore{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "bad-pg",
+				},
+				Spec: DataStoreSpec{
+					Driver: "PostgreSQL",
+					BasicAuth: &BasicAuth{
+						Username: ContentRef{
+							Content: []byte("postgres"),
+						},
+						Password: ContentRef{
+							Content: []byte("postgres"),
+						},
+					},
+				},
+			}
+
+			err := k8sClient.Create(context.Background(), ds)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("endpoints must be provided unless the driver is SQLite"))
+		})
+
+		It("datastores not of type SQLite cannot have the sqlite configuration", func() {
+			ds = &DataStore{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "bad-pg",
+				},
+				Spec: DataStoreSpec{
+					Driver:    "PostgreSQL",
+					Endpoints: []string{"pg-server:5432"},
+					BasicAuth: &BasicAuth{
+						Username: ContentRef{
+							Content: []byte("postgres"),
+						},
+						Password: ContentRef{
+							Content: []byte("postgres"),
+						},
+					},
+					SQLite: &SQLiteConfig{},
+				},
+			}
+
+			err := k8sClient.Create(context.Background(), ds)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("sqlite can be set only when driver is SQLite"))
+		})
+
+		It("managed datastores of type etcd need neither endpoints, nor TLS configuration", func() {
+			ds = &DataStore{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "good-managed-etcd",
+				},
+				Spec: DataStoreSpec{
+					Driver:  "etcd",
+					Managed: &ManagedEtcdSpec{},
+				},
+			}
+
+			err := k8sClient.Create(context.Background(), ds)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ds.Spec.Managed.Replicas).To(Equal(int32(3)))
+		})
+
+		It("managed datastores must be of type etcd", func() {
+			ds = &DataStore{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "bad-managed-pg",
+				},
+				Spec: DataStoreSpec{
+					Driver:    "PostgreSQL",
+					Endpoints: []string{"pg-server:5432"},
+					BasicAuth: &BasicAuth{
+						Username: ContentRef{
+							Content: []byte("postgres"),
+						},
+						Password: ContentRef{
+							Content: []byte("postgres"),
+						},
+					},
+					Managed: &ManagedEtcdSpec{},
+				},
+			}
+
+			err := k8sClient.Create(context.Background(), ds)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("managed can be set only when driver is etcd"))
+		})
 	})
 })
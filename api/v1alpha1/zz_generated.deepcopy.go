@@ -10,7 +10,9 @@ package v1alpha1
 import (
 	"k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	apiserverv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
 	apisv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
@@ -227,6 +229,335 @@ func (in AdmissionControllers) DeepCopy() AdmissionControllers {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditBackendSpec) DeepCopyInto(out *AuditBackendSpec) {
+	*out = *in
+	if in.Log != nil {
+		in, out := &in.Log, &out.Log
+		*out = new(AuditLogBackendSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(AuditWebhookBackendSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditBackendSpec.
+func (in *AuditBackendSpec) DeepCopy() *AuditBackendSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditBackendSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditLogBackendSpec) DeepCopyInto(out *AuditLogBackendSpec) {
+	*out = *in
+	if in.SizeLimit != nil {
+		in, out := &in.SizeLimit, &out.SizeLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	out.Retention = in.Retention
+	if in.Shipper != nil {
+		in, out := &in.Shipper, &out.Shipper
+		*out = new(AuditLogShipperSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditLogBackendSpec.
+func (in *AuditLogBackendSpec) DeepCopy() *AuditLogBackendSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditLogBackendSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditLogRetentionSpec) DeepCopyInto(out *AuditLogRetentionSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditLogRetentionSpec.
+func (in *AuditLogRetentionSpec) DeepCopy() *AuditLogRetentionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditLogRetentionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditLogShipperSpec) DeepCopyInto(out *AuditLogShipperSpec) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditLogShipperSpec.
+func (in *AuditLogShipperSpec) DeepCopy() *AuditLogShipperSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditLogShipperSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditPolicySource) DeepCopyInto(out *AuditPolicySource) {
+	*out = *in
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(v1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditPolicySource.
+func (in *AuditPolicySource) DeepCopy() *AuditPolicySource {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditPolicySource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditSpec) DeepCopyInto(out *AuditSpec) {
+	*out = *in
+	in.Policy.DeepCopyInto(&out.Policy)
+	in.Backend.DeepCopyInto(&out.Backend)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditSpec.
+func (in *AuditSpec) DeepCopy() *AuditSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditStatus) DeepCopyInto(out *AuditStatus) {
+	*out = *in
+	in.LastUpdate.DeepCopyInto(&out.LastUpdate)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditStatus.
+func (in *AuditStatus) DeepCopy() *AuditStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditWebhookBackendSpec) DeepCopyInto(out *AuditWebhookBackendSpec) {
+	*out = *in
+	in.KubeconfigSecretRef.DeepCopyInto(&out.KubeconfigSecretRef)
+	if in.InitialBackoff != nil {
+		in, out := &in.InitialBackoff, &out.InitialBackoff
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditWebhookBackendSpec.
+func (in *AuditWebhookBackendSpec) DeepCopy() *AuditWebhookBackendSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditWebhookBackendSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthenticationSpec) DeepCopyInto(out *AuthenticationSpec) {
+	*out = *in
+	if in.JWT != nil {
+		in, out := &in.JWT, &out.JWT
+		*out = make([]JWTAuthenticatorSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationSpec.
+func (in *AuthenticationSpec) DeepCopy() *AuthenticationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthenticationStatus) DeepCopyInto(out *AuthenticationStatus) {
+	*out = *in
+	in.LastUpdate.DeepCopyInto(&out.LastUpdate)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationStatus.
+func (in *AuthenticationStatus) DeepCopy() *AuthenticationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationSpec) DeepCopyInto(out *AuthorizationSpec) {
+	*out = *in
+	if in.Authorizers != nil {
+		in, out := &in.Authorizers, &out.Authorizers
+		*out = make([]AuthorizerSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationSpec.
+func (in *AuthorizationSpec) DeepCopy() *AuthorizationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationStatus) DeepCopyInto(out *AuthorizationStatus) {
+	*out = *in
+	in.LastUpdate.DeepCopyInto(&out.LastUpdate)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationStatus.
+func (in *AuthorizationStatus) DeepCopy() *AuthorizationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizerSpec) DeepCopyInto(out *AuthorizerSpec) {
+	*out = *in
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(WebhookAuthorizerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizerSpec.
+func (in *AuthorizerSpec) DeepCopy() *AuthorizerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupPersistentVolumeClaimStorage) DeepCopyInto(out *BackupPersistentVolumeClaimStorage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupPersistentVolumeClaimStorage.
+func (in *BackupPersistentVolumeClaimStorage) DeepCopy() *BackupPersistentVolumeClaimStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupPersistentVolumeClaimStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupS3Storage) DeepCopyInto(out *BackupS3Storage) {
+	*out = *in
+	out.CredentialsSecret = in.CredentialsSecret
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupS3Storage.
+func (in *BackupS3Storage) DeepCopy() *BackupS3Storage {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupS3Storage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupStorage) DeepCopyInto(out *BackupStorage) {
+	*out = *in
+	if in.PersistentVolumeClaim != nil {
+		in, out := &in.PersistentVolumeClaim, &out.PersistentVolumeClaim
+		*out = new(BackupPersistentVolumeClaimStorage)
+		**out = **in
+	}
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(BackupS3Storage)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupStorage.
+func (in *BackupStorage) DeepCopy() *BackupStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BasicAuth) DeepCopyInto(out *BasicAuth) {
 	*out = *in
@@ -566,6 +897,38 @@ func (in *DataStoreList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataStoreMigrationStatus) DeepCopyInto(out *DataStoreMigrationStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.FreezeStartTime != nil {
+		in, out := &in.FreezeStartTime, &out.FreezeStartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.FreezeDuration != nil {
+		in, out := &in.FreezeDuration, &out.FreezeDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataStoreMigrationStatus.
+func (in *DataStoreMigrationStatus) DeepCopy() *DataStoreMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DataStoreMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataStoreOverride) DeepCopyInto(out *DataStoreOverride) {
 	*out = *in
@@ -582,29 +945,109 @@ func (in *DataStoreOverride) DeepCopy() *DataStoreOverride {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DataStoreSetupStatus) DeepCopyInto(out *DataStoreSetupStatus) {
+func (in *DataStorePlacementPolicy) DeepCopyInto(out *DataStorePlacementPolicy) {
 	*out = *in
-	in.LastUpdate.DeepCopyInto(&out.LastUpdate)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataStoreSetupStatus.
-func (in *DataStoreSetupStatus) DeepCopy() *DataStoreSetupStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataStorePlacementPolicy.
+func (in *DataStorePlacementPolicy) DeepCopy() *DataStorePlacementPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(DataStoreSetupStatus)
+	out := new(DataStorePlacementPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataStorePlacementPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DataStoreSpec) DeepCopyInto(out *DataStoreSpec) {
+func (in *DataStorePlacementPolicyList) DeepCopyInto(out *DataStorePlacementPolicyList) {
 	*out = *in
-	if in.Endpoints != nil {
-		in, out := &in.Endpoints, &out.Endpoints
-		*out = make(Endpoints, len(*in))
-		copy(*out, *in)
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DataStorePlacementPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataStorePlacementPolicyList.
+func (in *DataStorePlacementPolicyList) DeepCopy() *DataStorePlacementPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(DataStorePlacementPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataStorePlacementPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataStorePlacementPolicySpec) DeepCopyInto(out *DataStorePlacementPolicySpec) {
+	*out = *in
+	in.TenantControlPlaneSelector.DeepCopyInto(&out.TenantControlPlaneSelector)
+	in.DataStoreSelector.DeepCopyInto(&out.DataStoreSelector)
+	if in.Drivers != nil {
+		in, out := &in.Drivers, &out.Drivers
+		*out = make([]Driver, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataStorePlacementPolicySpec.
+func (in *DataStorePlacementPolicySpec) DeepCopy() *DataStorePlacementPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DataStorePlacementPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataStoreSetupStatus) DeepCopyInto(out *DataStoreSetupStatus) {
+	*out = *in
+	in.LastUpdate.DeepCopyInto(&out.LastUpdate)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataStoreSetupStatus.
+func (in *DataStoreSetupStatus) DeepCopy() *DataStoreSetupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DataStoreSetupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataStoreSpec) DeepCopyInto(out *DataStoreSpec) {
+	*out = *in
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make(Endpoints, len(*in))
+		copy(*out, *in)
+	}
 	if in.BasicAuth != nil {
 		in, out := &in.BasicAuth, &out.BasicAuth
 		*out = new(BasicAuth)
@@ -615,6 +1058,16 @@ func (in *DataStoreSpec) DeepCopyInto(out *DataStoreSpec) {
 		*out = new(TLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SQLite != nil {
+		in, out := &in.SQLite, &out.SQLite
+		*out = new(SQLiteConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Managed != nil {
+		in, out := &in.Managed, &out.Managed
+		*out = new(ManagedEtcdSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataStoreSpec.
@@ -635,6 +1088,31 @@ func (in *DataStoreStatus) DeepCopyInto(out *DataStoreStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.LastProbeTime != nil {
+		in, out := &in.LastProbeTime, &out.LastProbeTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastProbeLatency != nil {
+		in, out := &in.LastProbeLatency, &out.LastProbeLatency
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.CertificatesExpiration != nil {
+		in, out := &in.CertificatesExpiration, &out.CertificatesExpiration
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Managed != nil {
+		in, out := &in.Managed, &out.Managed
+		*out = new(EtcdClusterStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataStoreStatus.
@@ -647,6 +1125,38 @@ func (in *DataStoreStatus) DeepCopy() *DataStoreStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataStoreUsageStatus) DeepCopyInto(out *DataStoreUsageStatus) {
+	*out = *in
+	out.Size = in.Size.DeepCopy()
+	in.LastUpdate.DeepCopyInto(&out.LastUpdate)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataStoreUsageStatus.
+func (in *DataStoreUsageStatus) DeepCopy() *DataStoreUsageStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DataStoreUsageStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataStoreVolumeStatus) DeepCopyInto(out *DataStoreVolumeStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataStoreVolumeStatus.
+func (in *DataStoreVolumeStatus) DeepCopy() *DataStoreVolumeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DataStoreVolumeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DatastoreUsedSecret) DeepCopyInto(out *DatastoreUsedSecret) {
 	*out = *in
@@ -736,6 +1246,11 @@ func (in *DeploymentSpec) DeepCopyInto(out *DeploymentSpec) {
 		*out = new(AdditionalVolumeMounts)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.KMSPlugin != nil {
+		in, out := &in.KMSPlugin, &out.KMSPlugin
+		*out = new(KMSPluginSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentSpec.
@@ -781,6 +1296,57 @@ func (in *ETCDCertificatesStatus) DeepCopy() *ETCDCertificatesStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EncryptionAtRestSpec) DeepCopyInto(out *EncryptionAtRestSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionAtRestSpec.
+func (in *EncryptionAtRestSpec) DeepCopy() *EncryptionAtRestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EncryptionAtRestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EncryptionAtRestStatus) DeepCopyInto(out *EncryptionAtRestStatus) {
+	*out = *in
+	in.LastUpdate.DeepCopyInto(&out.LastUpdate)
+	if in.SecretsRewrite != nil {
+		in, out := &in.SecretsRewrite, &out.SecretsRewrite
+		*out = new(EncryptionSecretsRewriteStatus)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionAtRestStatus.
+func (in *EncryptionAtRestStatus) DeepCopy() *EncryptionAtRestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EncryptionAtRestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EncryptionSecretsRewriteStatus) DeepCopyInto(out *EncryptionSecretsRewriteStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionSecretsRewriteStatus.
+func (in *EncryptionSecretsRewriteStatus) DeepCopy() *EncryptionSecretsRewriteStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EncryptionSecretsRewriteStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in Endpoints) DeepCopyInto(out *Endpoints) {
 	{
@@ -800,6 +1366,68 @@ func (in Endpoints) DeepCopy() Endpoints {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdClusterSpec) DeepCopyInto(out *EtcdClusterSpec) {
+	*out = *in
+	in.Storage.DeepCopyInto(&out.Storage)
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	out.DefragmentationInterval = in.DefragmentationInterval
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdClusterSpec.
+func (in *EtcdClusterSpec) DeepCopy() *EtcdClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdClusterStatus) DeepCopyInto(out *EtcdClusterStatus) {
+	*out = *in
+	if in.LastDefragmentation != nil {
+		in, out := &in.LastDefragmentation, &out.LastDefragmentation
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdClusterStatus.
+func (in *EtcdClusterStatus) DeepCopy() *EtcdClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdStorageSpec) DeepCopyInto(out *EtcdStorageSpec) {
+	*out = *in
+	if in.StorageClassName != nil {
+		in, out := &in.StorageClassName, &out.StorageClassName
+		*out = new(string)
+		**out = **in
+	}
+	out.Size = in.Size.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdStorageSpec.
+func (in *EtcdStorageSpec) DeepCopy() *EtcdStorageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdStorageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalKubernetesObjectStatus) DeepCopyInto(out *ExternalKubernetesObjectStatus) {
 	*out = *in
@@ -898,6 +1526,99 @@ func (in *GatewaySpec) DeepCopy() *GatewaySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernationIdleSpec) DeepCopyInto(out *HibernationIdleSpec) {
+	*out = *in
+	out.After = in.After
+	if in.FlowSchemas != nil {
+		in, out := &in.FlowSchemas, &out.FlowSchemas
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationIdleSpec.
+func (in *HibernationIdleSpec) DeepCopy() *HibernationIdleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationIdleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernationSchedule) DeepCopyInto(out *HibernationSchedule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationSchedule.
+func (in *HibernationSchedule) DeepCopy() *HibernationSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernationSpec) DeepCopyInto(out *HibernationSpec) {
+	*out = *in
+	if in.Schedules != nil {
+		in, out := &in.Schedules, &out.Schedules
+		*out = make([]HibernationSchedule, len(*in))
+		copy(*out, *in)
+	}
+	if in.Idle != nil {
+		in, out := &in.Idle, &out.Idle
+		*out = new(HibernationIdleSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationSpec.
+func (in *HibernationSpec) DeepCopy() *HibernationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernationStatus) DeepCopyInto(out *HibernationStatus) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastActivityTime != nil {
+		in, out := &in.LastActivityTime, &out.LastActivityTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationStatus.
+func (in *HibernationStatus) DeepCopy() *HibernationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImageOverrideTrait) DeepCopyInto(out *ImageOverrideTrait) {
 	*out = *in
@@ -971,21 +1692,91 @@ func (in JSONPatches) DeepCopy() JSONPatches {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KonnectivityAgentSpec) DeepCopyInto(out *KonnectivityAgentSpec) {
+func (in *JWTAuthenticatorSpec) DeepCopyInto(out *JWTAuthenticatorSpec) {
 	*out = *in
-	if in.Tolerations != nil {
-		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	in.JWTAuthenticator.DeepCopyInto(&out.JWTAuthenticator)
+	if in.CertificateAuthorityRef != nil {
+		in, out := &in.CertificateAuthorityRef, &out.CertificateAuthorityRef
+		*out = new(v1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.ExtraArgs != nil {
-		in, out := &in.ExtraArgs, &out.ExtraArgs
-		*out = make(ExtraArgs, len(*in))
-		copy(*out, *in)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTAuthenticatorSpec.
+func (in *JWTAuthenticatorSpec) DeepCopy() *JWTAuthenticatorSpec {
+	if in == nil {
+		return nil
 	}
-	if in.Replicas != nil {
+	out := new(JWTAuthenticatorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KMSPluginSpec) DeepCopyInto(out *KMSPluginSpec) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VolumeMounts != nil {
+		in, out := &in.VolumeMounts, &out.VolumeMounts
+		*out = make([]v1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KMSPluginSpec.
+func (in *KMSPluginSpec) DeepCopy() *KMSPluginSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KMSPluginSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonnectivityAgentSpec) DeepCopyInto(out *KonnectivityAgentSpec) {
+	*out = *in
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExtraArgs != nil {
+		in, out := &in.ExtraArgs, &out.ExtraArgs
+		*out = make(ExtraArgs, len(*in))
+		copy(*out, *in)
+	}
+	if in.Replicas != nil {
 		in, out := &in.Replicas, &out.Replicas
 		*out = new(int32)
 		**out = **in
@@ -1385,6 +2176,38 @@ func (in *KubernetesIngressStatus) DeepCopy() *KubernetesIngressStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesLastKnownGoodStatus) DeepCopyInto(out *KubernetesLastKnownGoodStatus) {
+	*out = *in
+	in.RecordedAt.DeepCopyInto(&out.RecordedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesLastKnownGoodStatus.
+func (in *KubernetesLastKnownGoodStatus) DeepCopy() *KubernetesLastKnownGoodStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesLastKnownGoodStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesRollbackStatus) DeepCopyInto(out *KubernetesRollbackStatus) {
+	*out = *in
+	in.RolledBackAt.DeepCopyInto(&out.RolledBackAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesRollbackStatus.
+func (in *KubernetesRollbackStatus) DeepCopy() *KubernetesRollbackStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesRollbackStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubernetesServiceStatus) DeepCopyInto(out *KubernetesServiceStatus) {
 	*out = *in
@@ -1404,12 +2227,37 @@ func (in *KubernetesServiceStatus) DeepCopy() *KubernetesServiceStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubernetesSpec) DeepCopyInto(out *KubernetesSpec) {
 	*out = *in
+	if in.UpgradeStrategy != nil {
+		in, out := &in.UpgradeStrategy, &out.UpgradeStrategy
+		*out = new(UpgradeStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Kubelet.DeepCopyInto(&out.Kubelet)
 	if in.AdmissionControllers != nil {
 		in, out := &in.AdmissionControllers, &out.AdmissionControllers
 		*out = make(AdmissionControllers, len(*in))
 		copy(*out, *in)
 	}
+	if in.EncryptionAtRest != nil {
+		in, out := &in.EncryptionAtRest, &out.EncryptionAtRest
+		*out = new(EncryptionAtRestSpec)
+		**out = **in
+	}
+	if in.Audit != nil {
+		in, out := &in.Audit, &out.Audit
+		*out = new(AuditSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(AuthenticationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Authorization != nil {
+		in, out := &in.Authorization, &out.Authorization
+		*out = new(AuthorizationSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesSpec.
@@ -1450,6 +2298,26 @@ func (in *KubernetesStatus) DeepCopy() *KubernetesStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesUpgradeStatus) DeepCopyInto(out *KubernetesUpgradeStatus) {
+	*out = *in
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesUpgradeStatus.
+func (in *KubernetesUpgradeStatus) DeepCopy() *KubernetesUpgradeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesUpgradeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubernetesVersion) DeepCopyInto(out *KubernetesVersion) {
 	*out = *in
@@ -1458,6 +2326,21 @@ func (in *KubernetesVersion) DeepCopyInto(out *KubernetesVersion) {
 		*out = new(KubernetesVersionStatus)
 		**out = **in
 	}
+	if in.Upgrade != nil {
+		in, out := &in.Upgrade, &out.Upgrade
+		*out = new(KubernetesUpgradeStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastKnownGood != nil {
+		in, out := &in.LastKnownGood, &out.LastKnownGood
+		*out = new(KubernetesLastKnownGoodStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RolledBack != nil {
+		in, out := &in.RolledBack, &out.RolledBack
+		*out = new(KubernetesRollbackStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesVersion.
@@ -1470,6 +2353,22 @@ func (in *KubernetesVersion) DeepCopy() *KubernetesVersion {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedEtcdSpec) DeepCopyInto(out *ManagedEtcdSpec) {
+	*out = *in
+	in.EtcdClusterSpec.DeepCopyInto(&out.EtcdClusterSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedEtcdSpec.
+func (in *ManagedEtcdSpec) DeepCopy() *ManagedEtcdSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedEtcdSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkProfileSpec) DeepCopyInto(out *NetworkProfileSpec) {
 	*out = *in
@@ -1551,6 +2450,91 @@ func (in *RegistrySettings) DeepCopy() *RegistrySettings {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreArchive) DeepCopyInto(out *RestoreArchive) {
+	*out = *in
+	in.Storage.DeepCopyInto(&out.Storage)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreArchive.
+func (in *RestoreArchive) DeepCopy() *RestoreArchive {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreArchive)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreSource) DeepCopyInto(out *RestoreSource) {
+	*out = *in
+	if in.Archive != nil {
+		in, out := &in.Archive, &out.Archive
+		*out = new(RestoreArchive)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreSource.
+func (in *RestoreSource) DeepCopy() *RestoreSource {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutTenantControlPlaneStatus) DeepCopyInto(out *RolloutTenantControlPlaneStatus) {
+	*out = *in
+	if in.Batch != nil {
+		in, out := &in.Batch, &out.Batch
+		*out = new(int32)
+		**out = **in
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutTenantControlPlaneStatus.
+func (in *RolloutTenantControlPlaneStatus) DeepCopy() *RolloutTenantControlPlaneStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutTenantControlPlaneStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SQLiteConfig) DeepCopyInto(out *SQLiteConfig) {
+	*out = *in
+	if in.StorageClassName != nil {
+		in, out := &in.StorageClassName, &out.StorageClassName
+		*out = new(string)
+		**out = **in
+	}
+	out.Size = in.Size.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SQLiteConfig.
+func (in *SQLiteConfig) DeepCopy() *SQLiteConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SQLiteConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretReference) DeepCopyInto(out *SecretReference) {
 	*out = *in
@@ -1596,6 +2580,26 @@ func (in *StorageStatus) DeepCopyInto(out *StorageStatus) {
 	out.Config = in.Config
 	in.Setup.DeepCopyInto(&out.Setup)
 	in.Certificate.DeepCopyInto(&out.Certificate)
+	if in.Migration != nil {
+		in, out := &in.Migration, &out.Migration
+		*out = new(DataStoreMigrationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = new(DataStoreUsageStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Volume != nil {
+		in, out := &in.Volume, &out.Volume
+		*out = new(DataStoreVolumeStatus)
+		**out = **in
+	}
+	if in.Dedicated != nil {
+		in, out := &in.Dedicated, &out.Dedicated
+		*out = new(EtcdClusterStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageStatus.
@@ -1608,6 +2612,46 @@ func (in *StorageStatus) DeepCopy() *StorageStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageVersionMigrationStatus) DeepCopyInto(out *StorageVersionMigrationStatus) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MigratedResources != nil {
+		in, out := &in.MigratedResources, &out.MigratedResources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StorageVersionHashes != nil {
+		in, out := &in.StorageVersionHashes, &out.StorageVersionHashes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageVersionMigrationStatus.
+func (in *StorageVersionMigrationStatus) DeepCopy() *StorageVersionMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageVersionMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TCPProxyHostAlias) DeepCopyInto(out *TCPProxyHostAlias) {
 	*out = *in
@@ -1749,31 +2793,58 @@ func (in *TenantControlPlane) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TenantControlPlaneList) DeepCopyInto(out *TenantControlPlaneList) {
+func (in *TenantControlPlaneBackup) DeepCopyInto(out *TenantControlPlaneBackup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantControlPlaneBackup.
+func (in *TenantControlPlaneBackup) DeepCopy() *TenantControlPlaneBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantControlPlaneBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TenantControlPlaneBackup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantControlPlaneBackupList) DeepCopyInto(out *TenantControlPlaneBackupList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]TenantControlPlane, len(*in))
+		*out = make([]TenantControlPlaneBackup, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantControlPlaneList.
-func (in *TenantControlPlaneList) DeepCopy() *TenantControlPlaneList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantControlPlaneBackupList.
+func (in *TenantControlPlaneBackupList) DeepCopy() *TenantControlPlaneBackupList {
 	if in == nil {
 		return nil
 	}
-	out := new(TenantControlPlaneList)
+	out := new(TenantControlPlaneBackupList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *TenantControlPlaneList) DeepCopyObject() runtime.Object {
+func (in *TenantControlPlaneBackupList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1781,40 +2852,495 @@ func (in *TenantControlPlaneList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TenantControlPlaneSpec) DeepCopyInto(out *TenantControlPlaneSpec) {
+func (in *TenantControlPlaneBackupSchedule) DeepCopyInto(out *TenantControlPlaneBackupSchedule) {
 	*out = *in
-	out.WritePermissions = in.WritePermissions
-	if in.DataStoreOverrides != nil {
-		in, out := &in.DataStoreOverrides, &out.DataStoreOverrides
-		*out = make([]DataStoreOverride, len(*in))
-		copy(*out, *in)
-	}
-	in.ControlPlane.DeepCopyInto(&out.ControlPlane)
-	in.Kubernetes.DeepCopyInto(&out.Kubernetes)
-	in.NetworkProfile.DeepCopyInto(&out.NetworkProfile)
-	in.Addons.DeepCopyInto(&out.Addons)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantControlPlaneSpec.
-func (in *TenantControlPlaneSpec) DeepCopy() *TenantControlPlaneSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantControlPlaneBackupSchedule.
+func (in *TenantControlPlaneBackupSchedule) DeepCopy() *TenantControlPlaneBackupSchedule {
 	if in == nil {
 		return nil
 	}
-	out := new(TenantControlPlaneSpec)
+	out := new(TenantControlPlaneBackupSchedule)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TenantControlPlaneStatus) DeepCopyInto(out *TenantControlPlaneStatus) {
-	*out = *in
-	in.Storage.DeepCopyInto(&out.Storage)
-	in.Certificates.DeepCopyInto(&out.Certificates)
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TenantControlPlaneBackupSchedule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantControlPlaneBackupScheduleList) DeepCopyInto(out *TenantControlPlaneBackupScheduleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TenantControlPlaneBackupSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantControlPlaneBackupScheduleList.
+func (in *TenantControlPlaneBackupScheduleList) DeepCopy() *TenantControlPlaneBackupScheduleList {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantControlPlaneBackupScheduleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TenantControlPlaneBackupScheduleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantControlPlaneBackupScheduleSpec) DeepCopyInto(out *TenantControlPlaneBackupScheduleSpec) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+	if in.SuccessfulBackupsHistoryLimit != nil {
+		in, out := &in.SuccessfulBackupsHistoryLimit, &out.SuccessfulBackupsHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FailedBackupsHistoryLimit != nil {
+		in, out := &in.FailedBackupsHistoryLimit, &out.FailedBackupsHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantControlPlaneBackupScheduleSpec.
+func (in *TenantControlPlaneBackupScheduleSpec) DeepCopy() *TenantControlPlaneBackupScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantControlPlaneBackupScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantControlPlaneBackupScheduleStatus) DeepCopyInto(out *TenantControlPlaneBackupScheduleStatus) {
+	*out = *in
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantControlPlaneBackupScheduleStatus.
+func (in *TenantControlPlaneBackupScheduleStatus) DeepCopy() *TenantControlPlaneBackupScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantControlPlaneBackupScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantControlPlaneBackupSpec) DeepCopyInto(out *TenantControlPlaneBackupSpec) {
+	*out = *in
+	in.Storage.DeepCopyInto(&out.Storage)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantControlPlaneBackupSpec.
+func (in *TenantControlPlaneBackupSpec) DeepCopy() *TenantControlPlaneBackupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantControlPlaneBackupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantControlPlaneBackupStatus) DeepCopyInto(out *TenantControlPlaneBackupStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantControlPlaneBackupStatus.
+func (in *TenantControlPlaneBackupStatus) DeepCopy() *TenantControlPlaneBackupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantControlPlaneBackupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantControlPlaneList) DeepCopyInto(out *TenantControlPlaneList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TenantControlPlane, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantControlPlaneList.
+func (in *TenantControlPlaneList) DeepCopy() *TenantControlPlaneList {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantControlPlaneList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TenantControlPlaneList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantControlPlaneRestore) DeepCopyInto(out *TenantControlPlaneRestore) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantControlPlaneRestore.
+func (in *TenantControlPlaneRestore) DeepCopy() *TenantControlPlaneRestore {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantControlPlaneRestore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TenantControlPlaneRestore) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantControlPlaneRestoreList) DeepCopyInto(out *TenantControlPlaneRestoreList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TenantControlPlaneRestore, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantControlPlaneRestoreList.
+func (in *TenantControlPlaneRestoreList) DeepCopy() *TenantControlPlaneRestoreList {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantControlPlaneRestoreList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TenantControlPlaneRestoreList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantControlPlaneRestoreSpec) DeepCopyInto(out *TenantControlPlaneRestoreSpec) {
+	*out = *in
+	in.Source.DeepCopyInto(&out.Source)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantControlPlaneRestoreSpec.
+func (in *TenantControlPlaneRestoreSpec) DeepCopy() *TenantControlPlaneRestoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantControlPlaneRestoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantControlPlaneRestoreStatus) DeepCopyInto(out *TenantControlPlaneRestoreStatus) {
+	*out = *in
+	if in.WritePermissions != nil {
+		in, out := &in.WritePermissions, &out.WritePermissions
+		*out = new(Permissions)
+		**out = **in
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.RestoreTime != nil {
+		in, out := &in.RestoreTime, &out.RestoreTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantControlPlaneRestoreStatus.
+func (in *TenantControlPlaneRestoreStatus) DeepCopy() *TenantControlPlaneRestoreStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantControlPlaneRestoreStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantControlPlaneRollout) DeepCopyInto(out *TenantControlPlaneRollout) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantControlPlaneRollout.
+func (in *TenantControlPlaneRollout) DeepCopy() *TenantControlPlaneRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantControlPlaneRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TenantControlPlaneRollout) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantControlPlaneRolloutAddons) DeepCopyInto(out *TenantControlPlaneRolloutAddons) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantControlPlaneRolloutAddons.
+func (in *TenantControlPlaneRolloutAddons) DeepCopy() *TenantControlPlaneRolloutAddons {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantControlPlaneRolloutAddons)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantControlPlaneRolloutList) DeepCopyInto(out *TenantControlPlaneRolloutList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TenantControlPlaneRollout, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantControlPlaneRolloutList.
+func (in *TenantControlPlaneRolloutList) DeepCopy() *TenantControlPlaneRolloutList {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantControlPlaneRolloutList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TenantControlPlaneRolloutList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantControlPlaneRolloutSpec) DeepCopyInto(out *TenantControlPlaneRolloutSpec) {
+	*out = *in
+	in.TenantControlPlaneSelector.DeepCopyInto(&out.TenantControlPlaneSelector)
+	if in.Addons != nil {
+		in, out := &in.Addons, &out.Addons
+		*out = new(TenantControlPlaneRolloutAddons)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PauseOnFailure != nil {
+		in, out := &in.PauseOnFailure, &out.PauseOnFailure
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SoakTime != nil {
+		in, out := &in.SoakTime, &out.SoakTime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantControlPlaneRolloutSpec.
+func (in *TenantControlPlaneRolloutSpec) DeepCopy() *TenantControlPlaneRolloutSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantControlPlaneRolloutSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantControlPlaneRolloutStatus) DeepCopyInto(out *TenantControlPlaneRolloutStatus) {
+	*out = *in
+	if in.CurrentBatch != nil {
+		in, out := &in.CurrentBatch, &out.CurrentBatch
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BatchCompletionTime != nil {
+		in, out := &in.BatchCompletionTime, &out.BatchCompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.TenantControlPlanes != nil {
+		in, out := &in.TenantControlPlanes, &out.TenantControlPlanes
+		*out = make([]RolloutTenantControlPlaneStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantControlPlaneRolloutStatus.
+func (in *TenantControlPlaneRolloutStatus) DeepCopy() *TenantControlPlaneRolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantControlPlaneRolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantControlPlaneSpec) DeepCopyInto(out *TenantControlPlaneSpec) {
+	*out = *in
+	out.WritePermissions = in.WritePermissions
+	if in.StorageQuota != nil {
+		in, out := &in.StorageQuota, &out.StorageQuota
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.DedicatedDataStore != nil {
+		in, out := &in.DedicatedDataStore, &out.DedicatedDataStore
+		*out = new(EtcdClusterSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DataStoreOverrides != nil {
+		in, out := &in.DataStoreOverrides, &out.DataStoreOverrides
+		*out = make([]DataStoreOverride, len(*in))
+		copy(*out, *in)
+	}
+	in.ControlPlane.DeepCopyInto(&out.ControlPlane)
+	in.Kubernetes.DeepCopyInto(&out.Kubernetes)
+	in.NetworkProfile.DeepCopyInto(&out.NetworkProfile)
+	in.Addons.DeepCopyInto(&out.Addons)
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(HibernationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantControlPlaneSpec.
+func (in *TenantControlPlaneSpec) DeepCopy() *TenantControlPlaneSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantControlPlaneSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantControlPlaneStatus) DeepCopyInto(out *TenantControlPlaneStatus) {
+	*out = *in
+	in.Storage.DeepCopyInto(&out.Storage)
+	in.Certificates.DeepCopyInto(&out.Certificates)
 	in.KubeConfig.DeepCopyInto(&out.KubeConfig)
+	in.EncryptionAtRest.DeepCopyInto(&out.EncryptionAtRest)
+	in.Audit.DeepCopyInto(&out.Audit)
+	in.Authentication.DeepCopyInto(&out.Authentication)
+	in.Authorization.DeepCopyInto(&out.Authorization)
 	in.Kubernetes.DeepCopyInto(&out.Kubernetes)
+	if in.StorageVersionMigration != nil {
+		in, out := &in.StorageVersionMigration, &out.StorageVersionMigration
+		*out = new(StorageVersionMigrationStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	in.KubeadmConfig.DeepCopyInto(&out.KubeadmConfig)
 	in.KubeadmPhase.DeepCopyInto(&out.KubeadmPhase)
 	in.Addons.DeepCopyInto(&out.Addons)
+	in.Hibernation.DeepCopyInto(&out.Hibernation)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantControlPlaneStatus.
@@ -1842,6 +3368,65 @@ func (in *TenantControlPlaneStatusDataStore) DeepCopy() *TenantControlPlaneStatu
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeStrategy) DeepCopyInto(out *UpgradeStrategy) {
+	*out = *in
+	if in.IntermediateVersions != nil {
+		in, out := &in.IntermediateVersions, &out.IntermediateVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RollbackDeadline != nil {
+		in, out := &in.RollbackDeadline, &out.RollbackDeadline
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeStrategy.
+func (in *UpgradeStrategy) DeepCopy() *UpgradeStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookAuthorizerSpec) DeepCopyInto(out *WebhookAuthorizerSpec) {
+	*out = *in
+	in.KubeconfigSecretRef.DeepCopyInto(&out.KubeconfigSecretRef)
+	out.AuthorizedTTL = in.AuthorizedTTL
+	if in.CacheAuthorizedRequests != nil {
+		in, out := &in.CacheAuthorizedRequests, &out.CacheAuthorizedRequests
+		*out = new(bool)
+		**out = **in
+	}
+	out.UnauthorizedTTL = in.UnauthorizedTTL
+	if in.CacheUnauthorizedRequests != nil {
+		in, out := &in.CacheUnauthorizedRequests, &out.CacheUnauthorizedRequests
+		*out = new(bool)
+		**out = **in
+	}
+	out.Timeout = in.Timeout
+	if in.MatchConditions != nil {
+		in, out := &in.MatchConditions, &out.MatchConditions
+		*out = make([]apiserverv1.WebhookMatchCondition, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookAuthorizerSpec.
+func (in *WebhookAuthorizerSpec) DeepCopy() *WebhookAuthorizerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookAuthorizerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerBootstrapSpec) DeepCopyInto(out *WorkerBootstrapSpec) {
 	*out = *in
@@ -101,3 +101,10 @@ Kubeconfig Generator Deployment name.
 {{- printf "%s-%s" .Release.Name "kubeconfig-generator" | trunc 63 | trimSuffix "-" }}
 {{- end }}
 {{- end }}
+
+{{/*
+Activator Deployment and Service name.
+*/}}
+{{- define "steward.activatorName" -}}
+{{- printf "%s-%s" (include "steward.fullname" .) "activator" | trunc 63 | trimSuffix "-" }}
+{{- end }}
@@ -0,0 +1,185 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/internal/backup"
+	"github.com/butlerdotdev/steward/internal/datastore"
+)
+
+func NewCmd(scheme *runtime.Scheme) *cobra.Command {
+	// CLI flags
+	var (
+		tenantControlPlaneBackup string
+		timeout                  time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:          "backup",
+		Short:        "Export the keyspace of a TenantControlPlane into the archive declared by a TenantControlPlaneBackup",
+		SilenceUsage: true,
+		RunE: func(*cobra.Command, []string) error {
+			ctx, cancelFn := context.WithTimeout(context.Background(), timeout)
+			defer cancelFn()
+
+			log := ctrl.Log
+
+			log.Info("generating the controller-runtime client")
+
+			client, err := ctrlclient.New(ctrl.GetConfigOrDie(), ctrlclient.Options{
+				Scheme: scheme,
+			})
+			if err != nil {
+				return err
+			}
+
+			parts := strings.Split(tenantControlPlaneBackup, string(types.Separator))
+			if len(parts) != 2 {
+				return fmt.Errorf("non well-formed namespaced name for the tenant control plane backup, expected <NAMESPACE>/NAME, got %s", tenantControlPlaneBackup)
+			}
+
+			log.Info("retrieving the TenantControlPlaneBackup")
+
+			tcpBackup := &stewardv1alpha1.TenantControlPlaneBackup{}
+			if err = client.Get(ctx, types.NamespacedName{Namespace: parts[0], Name: parts[1]}, tcpBackup); err != nil {
+				return err
+			}
+
+			status, backupErr := run(ctx, client, *tcpBackup)
+			if backupErr != nil {
+				status.Phase, status.Message = stewardv1alpha1.BackupPhaseFailed, backupErr.Error()
+			}
+
+			status.StartTime, status.CompletionTime = tcpBackup.Status.StartTime, &metav1.Time{Time: time.Now()}
+
+			log.Info("updating the TenantControlPlaneBackup status", "phase", status.Phase)
+
+			if err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+				if gErr := client.Get(ctx, types.NamespacedName{Namespace: parts[0], Name: parts[1]}, tcpBackup); gErr != nil {
+					return gErr
+				}
+
+				tcpBackup.Status = status
+
+				return client.Status().Update(ctx, tcpBackup)
+			}); err != nil {
+				return fmt.Errorf("unable to update the backup status: %w", err)
+			}
+
+			if backupErr != nil {
+				return fmt.Errorf("unable to back up the TenantControlPlane: %w", backupErr)
+			}
+
+			log.Info("backup completed")
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&tenantControlPlaneBackup, "tenant-control-plane-backup", "", "Namespaced-name of the TenantControlPlaneBackup that must be performed (e.g.: default/test-nightly)")
+	cmd.Flags().DurationVar(&timeout, "timeout", 30*time.Minute, "Amount of time for the context timeout")
+
+	_ = cmd.MarkFlagRequired("tenant-control-plane-backup")
+
+	return cmd
+}
+
+func run(ctx context.Context, client ctrlclient.Client, tcpBackup stewardv1alpha1.TenantControlPlaneBackup) (stewardv1alpha1.TenantControlPlaneBackupStatus, error) {
+	log := ctrl.Log
+
+	var status stewardv1alpha1.TenantControlPlaneBackupStatus
+
+	log.Info("retrieving the TenantControlPlane")
+
+	tcp := &stewardv1alpha1.TenantControlPlane{}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: tcpBackup.GetNamespace(), Name: tcpBackup.Spec.TenantControlPlane}, tcp); err != nil {
+		return status, err
+	}
+
+	if tcp.Status.Storage.DataStoreName == "" {
+		return status, fmt.Errorf("the TenantControlPlane storage has not been set up yet")
+	}
+
+	log.Info("retrieving the TenantControlPlane used DataStore")
+
+	ds, err := datastore.GetDataStore(ctx, client, *tcp, tcp.Status.Storage.DataStoreName)
+	if err != nil {
+		return status, err
+	}
+
+	status.DataStore, status.Driver = ds.GetName(), string(ds.Spec.Driver)
+
+	log.Info("generating the storage connection")
+
+	connection, err := datastore.NewStorageConnection(ctx, client, *ds)
+	if err != nil {
+		return status, err
+	}
+	defer connection.Close()
+
+	log.Info("opening the backup storage")
+
+	storage, err := backup.NewStorage(ctx, client, tcpBackup.GetNamespace(), tcpBackup.Spec.Storage)
+	if err != nil {
+		return status, err
+	}
+
+	name := backup.ArchiveName(tcpBackup)
+
+	upload, err := storage.Create(ctx, name)
+	if err != nil {
+		return status, err
+	}
+
+	archive, err := datastore.NewArchiveWriter(upload, datastore.ArchiveHeader{
+		Driver:             string(ds.Spec.Driver),
+		TenantControlPlane: tcp.GetName(),
+		Schema:             tcp.Status.Storage.Setup.Schema,
+	})
+	if err != nil {
+		upload.Abort()
+
+		return status, err
+	}
+
+	log.Info("export of the keyspace started")
+
+	if err = connection.Export(ctx, *tcp, archive); err != nil {
+		upload.Abort()
+
+		return status, fmt.Errorf("unable to export the keyspace from %s: %w", ds.GetName(), err)
+	}
+
+	if err = archive.Close(); err != nil {
+		upload.Abort()
+
+		return status, err
+	}
+
+	if err = upload.Close(); err != nil {
+		return status, err
+	}
+
+	log.Info("export of the keyspace completed", "keys", archive.Keys(), "size", archive.Size())
+
+	status.Phase = stewardv1alpha1.BackupPhaseCompleted
+	status.Location = storage.Location(name)
+	status.Size, status.Keys, status.Revision, status.Checksum = archive.Size(), archive.Keys(), archive.Revision(), archive.Checksum()
+
+	return status, nil
+}
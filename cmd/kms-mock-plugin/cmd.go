@@ -0,0 +1,76 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package kmsmockplugin
+
+import (
+	"encoding/base64"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/kms/pkg/service"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	builder "github.com/butlerdotdev/steward/internal/builders/controlplane"
+	"github.com/butlerdotdev/steward/internal/kms/mock"
+)
+
+func NewCmd(_ *runtime.Scheme) *cobra.Command {
+	// CLI flags
+	var (
+		listenAddress string
+		key           string
+		timeout       time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:          "kms-mock-plugin",
+		Short:        "Start a KMS v2 plugin backed by a local key, meant for testing purposes only",
+		SilenceUsage: true,
+		RunE: func(*cobra.Command, []string) error {
+			ctx := ctrl.SetupSignalHandler()
+
+			log := ctrl.Log.WithName("kms-mock-plugin")
+
+			decodedKey, err := base64.StdEncoding.DecodeString(key)
+			if err != nil {
+				return errors.Wrap(err, "cannot decode the key encryption key")
+			}
+
+			if len(decodedKey) == 0 {
+				log.Info("no key encryption key provided, a random one will be used: data will be unreadable upon restart")
+			}
+
+			svc, err := mock.NewService(decodedKey)
+			if err != nil {
+				return err
+			}
+			// Removing the stale socket of a previous run, otherwise the listener would fail.
+			if err = os.Remove(listenAddress); err != nil && !os.IsNotExist(err) {
+				return errors.Wrap(err, "cannot remove the stale socket")
+			}
+
+			server := service.NewGRPCService(listenAddress, timeout, svc)
+
+			go func() {
+				<-ctx.Done()
+
+				server.Shutdown()
+			}()
+
+			log.Info("listening for KMS requests", "address", listenAddress)
+
+			return server.ListenAndServe()
+		},
+	}
+
+	cmd.Flags().StringVar(&listenAddress, "listen-address", strings.TrimPrefix(builder.KMSPluginEndpoint, "unix://"), "Path of the Unix Domain Socket the plugin listens to")
+	cmd.Flags().StringVar(&key, "key", os.Getenv("KMS_MOCK_KEY"), "Base64 encoded 32 bytes key encryption key, a random one is generated if empty")
+	cmd.Flags().DurationVar(&timeout, "timeout", 3*time.Second, "Connection timeout of the gRPC server")
+
+	return cmd
+}
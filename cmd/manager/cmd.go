@@ -15,6 +15,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"k8s.io/apimachinery/pkg/runtime"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/rest"
 	"k8s.io/klog/v2"
@@ -59,7 +60,15 @@ func NewCmd(scheme *runtime.Scheme) *cobra.Command {
 		maxConcurrentReconciles       int
 		certificateExpirationDeadline time.Duration
 
+		dataStoreProbeInterval                  time.Duration
+		dataStoreLatencyThreshold               time.Duration
+		dataStoreCertificateExpirationThreshold time.Duration
+		storageUsageInterval                    time.Duration
+
 		webhookCAPath string
+
+		activatorService string
+		activatorPorts   utilnet.PortRange
 	)
 
 	cmd := &cobra.Command{
@@ -133,12 +142,40 @@ func NewCmd(scheme *runtime.Scheme) *cobra.Command {
 
 			tcpChannel, certChannel := make(chan event.GenericEvent), make(chan event.GenericEvent)
 
-			if err = (&controllers.DataStore{Client: mgr.GetClient(), TenantControlPlaneTrigger: tcpChannel}).SetupWithManager(mgr); err != nil {
+			if err = (&controllers.DataStore{
+				Client:                         mgr.GetClient(),
+				TenantControlPlaneTrigger:      tcpChannel,
+				ProbeInterval:                  dataStoreProbeInterval,
+				ProbeTimeout:                   controllerReconcileTimeout,
+				LatencyThreshold:               dataStoreLatencyThreshold,
+				CertificateExpirationThreshold: dataStoreCertificateExpirationThreshold,
+			}).SetupWithManager(mgr); err != nil {
 				setupLog.Error(err, "unable to create controller", "controller", "DataStore")
 
 				return err
 			}
 
+			if err = (&controllers.ManagedDataStore{
+				Client:                         mgr.GetClient(),
+				StewardNamespace:               managerNamespace,
+				Timeout:                        controllerReconcileTimeout,
+				CertificateExpirationThreshold: certificateExpirationDeadline,
+			}).SetupWithManager(mgr); err != nil {
+				setupLog.Error(err, "unable to create controller", "controller", "ManagedDataStore")
+
+				return err
+			}
+
+			if err = (&controllers.DedicatedDataStore{
+				Client:                         mgr.GetClient(),
+				Timeout:                        controllerReconcileTimeout,
+				CertificateExpirationThreshold: certificateExpirationDeadline,
+			}).SetupWithManager(mgr); err != nil {
+				setupLog.Error(err, "unable to create controller", "controller", "DedicatedDataStore")
+
+				return err
+			}
+
 			discoveryClient, err := discovery.NewDiscoveryClientForConfig(mgr.GetConfig())
 			if err != nil {
 				setupLog.Error(err, "unable to create discovery client")
@@ -154,6 +191,8 @@ func NewCmd(scheme *runtime.Scheme) *cobra.Command {
 					KineContainerImage:      kineImage,
 					TmpBaseDirectory:        tmpDirectory,
 					CertExpirationThreshold: certificateExpirationDeadline,
+					ActivatorService:        activatorService,
+					ActivatorPorts:          activatorPorts,
 				},
 				ReconcileTimeout:        controllerReconcileTimeout,
 				CertificateChan:         certChannel,
@@ -181,6 +220,53 @@ func NewCmd(scheme *runtime.Scheme) *cobra.Command {
 				return err
 			}
 
+			if err = (&controllers.Hibernation{Client: mgr.GetClient(), APIReader: mgr.GetAPIReader()}).SetupWithManager(mgr); err != nil {
+				setupLog.Error(err, "unable to create controller", "controller", "Hibernation")
+
+				return err
+			}
+
+			if storageUsageInterval > 0 {
+				if err = (&controllers.StorageUsage{
+					Client:   mgr.GetClient(),
+					Interval: storageUsageInterval,
+					Timeout:  controllerReconcileTimeout,
+				}).SetupWithManager(mgr); err != nil {
+					setupLog.Error(err, "unable to create controller", "controller", "StorageUsage")
+
+					return err
+				}
+			}
+
+			if err = (&controllers.TenantControlPlaneBackup{
+				Client:                mgr.GetClient(),
+				StewardNamespace:      managerNamespace,
+				StewardServiceAccount: managerServiceAccountName,
+				BackupImage:           migrateJobImage,
+			}).SetupWithManager(mgr); err != nil {
+				setupLog.Error(err, "unable to create controller", "controller", "TenantControlPlaneBackup")
+
+				return err
+			}
+
+			if err = (&controllers.TenantControlPlaneBackupSchedule{Client: mgr.GetClient()}).SetupWithManager(mgr); err != nil {
+				setupLog.Error(err, "unable to create controller", "controller", "TenantControlPlaneBackupSchedule")
+
+				return err
+			}
+
+			if err = (&controllers.TenantControlPlaneRestore{Client: mgr.GetClient()}).SetupWithManager(mgr); err != nil {
+				setupLog.Error(err, "unable to create controller", "controller", "TenantControlPlaneRestore")
+
+				return err
+			}
+
+			if err = (&controllers.TenantControlPlaneRollout{Client: mgr.GetClient()}).SetupWithManager(mgr); err != nil {
+				setupLog.Error(err, "unable to create controller", "controller", "TenantControlPlaneRollout")
+
+				return err
+			}
+
 			if err = (&stewardv1alpha1.DatastoreUsedSecret{}).SetupWithManager(ctx, mgr); err != nil {
 				setupLog.Error(err, "unable to create indexer", "indexer", "DatastoreUsedSecret")
 
@@ -212,6 +298,7 @@ func NewCmd(scheme *runtime.Scheme) *cobra.Command {
 				routes.TenantControlPlaneDefaults{}: {
 					handlers.TenantControlPlaneDefaults{
 						DefaultDatastore: datastore,
+						Client:           mgr.GetClient(),
 					},
 				},
 				routes.TenantControlPlaneValidate{}: {
@@ -234,6 +321,10 @@ func NewCmd(scheme *runtime.Scheme) *cobra.Command {
 					},
 					handlers.TenantControlPlaneServiceCIDR{},
 					handlers.TenantControlPlaneLoadBalancerSourceRanges{},
+					handlers.TenantControlPlaneKMS{},
+					handlers.TenantControlPlaneAuthentication{},
+					handlers.TenantControlPlaneAuthorization{},
+					handlers.TenantControlPlaneHibernation{},
 					handlers.TenantControlPlaneGatewayValidation{
 						Client:          mgr.GetClient(),
 						DiscoveryClient: discoveryClient,
@@ -258,6 +349,7 @@ func NewCmd(scheme *runtime.Scheme) *cobra.Command {
 				MigrateServiceName:      managerServiceName,
 				MigrateServiceNamespace: managerNamespace,
 				AdminClient:             mgr.GetClient(),
+				EventRecorder:           mgr.GetEventRecorderFor("soot-manager"),
 			}).SetupWithManager(mgr); err != nil {
 				setupLog.Error(err, "unable to set up soot manager")
 
@@ -301,7 +393,7 @@ func NewCmd(scheme *runtime.Scheme) *cobra.Command {
 	cmd.Flags().StringVar(&tmpDirectory, "tmp-directory", "/tmp/steward", "Directory which will be used to work with temporary files.")
 	cmd.Flags().StringVar(&kineImage, "kine-image", "rancher/kine:v0.11.10-amd64", "Container image along with tag to use for the Kine sidecar container (used only if etcd-storage-type is set to one of kine strategies).")
 	cmd.Flags().StringVar(&datastore, "datastore", "", "Optional, the default DataStore that should be used by Steward to setup the required storage of Tenant Control Planes with undeclared DataStore.")
-	cmd.Flags().StringVar(&migrateJobImage, "migrate-image", fmt.Sprintf("%s/butlerlabs/steward:%s", internal.ContainerRepository, internal.GitTag), "Specify the container image to launch when a TenantControlPlane is migrated to a new datastore.")
+	cmd.Flags().StringVar(&migrateJobImage, "migrate-image", fmt.Sprintf("%s/butlerlabs/steward:%s", internal.ContainerRepository, internal.GitTag), "Specify the container image to launch when a TenantControlPlane is migrated to a new datastore, backed up, or restored.")
 	cmd.Flags().IntVar(&maxConcurrentReconciles, "max-concurrent-tcp-reconciles", 1, "Specify the number of workers for the Tenant Control Plane controller (beware of CPU consumption)")
 	cmd.Flags().StringVar(&managerNamespace, "pod-namespace", os.Getenv("POD_NAMESPACE"), "The Kubernetes Namespace on which the Operator is running in, required for the TenantControlPlane migration jobs.")
 	cmd.Flags().StringVar(&managerServiceName, "webhook-service-name", "steward-webhook-service", "The Steward webhook server Service name which is used to get validation webhooks, required for the TenantControlPlane migration jobs.")
@@ -310,6 +402,14 @@ func NewCmd(scheme *runtime.Scheme) *cobra.Command {
 	cmd.Flags().DurationVar(&controllerReconcileTimeout, "controller-reconcile-timeout", 30*time.Second, "The reconciliation request timeout before the controller withdraw the external resource calls, such as dealing with the Datastore, or the Tenant Control Plane API endpoint.")
 	cmd.Flags().DurationVar(&cacheResyncPeriod, "cache-resync-period", 10*time.Hour, "The controller-runtime.Manager cache resync period.")
 	cmd.Flags().DurationVar(&certificateExpirationDeadline, "certificate-expiration-deadline", 24*time.Hour, "Define the deadline upon certificate expiration to start the renewal process, cannot be less than a 24 hours.")
+	cmd.Flags().DurationVar(&dataStoreProbeInterval, "datastore-probe-interval", 30*time.Second, "The interval between two health probes of each DataStore, reported in its conditions: the probe is disabled when set to zero.")
+	cmd.Flags().DurationVar(&dataStoreLatencyThreshold, "datastore-latency-threshold", time.Second, "The DataStore probe latency beyond which the DataStore is reported as Degraded.")
+	cmd.Flags().DurationVar(&dataStoreCertificateExpirationThreshold, "datastore-certificate-expiration-threshold", 30*24*time.Hour, "The remaining validity of the DataStore certificates below which the DataStore is reported as Degraded.")
+	cmd.Flags().DurationVar(&storageUsageInterval, "storage-usage-interval", time.Minute, "The interval between two measurements of the storage used by each Tenant Control Plane, enforcing its storage quota: the measurement is disabled when set to zero.")
+
+	cmd.Flags().StringVar(&activatorService, "activator-service-name", "", "The Steward activator Service name, enabling the wake on request of the sleeping Tenant Control Planes.")
+	activatorPorts = utilnet.PortRange{Base: 20000, Size: 10000}
+	cmd.Flags().Var(&activatorPorts, "activator-port-range", "The range of ports allocated by the Steward activator to the sleeping Tenant Control Planes.")
 
 	cobra.OnInitialize(func() {
 		viper.AutomaticEnv()
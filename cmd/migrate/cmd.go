@@ -10,13 +10,47 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	sootcontrollers "github.com/butlerdotdev/steward/controllers/soot/controllers"
 	"github.com/butlerdotdev/steward/internal/datastore"
+	"github.com/butlerdotdev/steward/internal/utilities"
+)
+
+var (
+	// freezePollInterval is the interval between two checks of the Tenant Control Plane writes being blocked.
+	freezePollInterval = 2 * time.Second
+	// freezeSettleDelay is how long the live migration waits for the freeze webhook to be loaded by the API Server instances,
+	// and for the admitted requests to be completed, before replaying the changes.
+	freezeSettleDelay = 5 * time.Second
+	// freezeWebhookInstalled returns true once the freeze webhook has been installed in the tenant cluster.
+	freezeWebhookInstalled = func(ctx context.Context, client ctrlclient.Client, tcp *stewardv1alpha1.TenantControlPlane) (bool, error) {
+		tenantClient, err := utilities.GetTenantClient(ctx, client, tcp)
+		if err != nil {
+			return false, err
+		}
+
+		if err = tenantClient.Get(ctx, types.NamespacedName{Name: sootcontrollers.FreezeWebhookConfigurationName}, &admissionregistrationv1.ValidatingWebhookConfiguration{}); err != nil {
+			if k8serrors.IsNotFound(err) {
+				return false, nil
+			}
+
+			ctrl.Log.Error(err, "cannot retrieve the freeze webhook, retrying")
+
+			return false, nil
+		}
+
+		return true, nil
+	}
 )
 
 func NewCmd(scheme *runtime.Scheme) *cobra.Command {
@@ -25,6 +59,9 @@ func NewCmd(scheme *runtime.Scheme) *cobra.Command {
 		tenantControlPlane    string
 		targetDataStore       string
 		cleanupPriorMigration bool
+		allowCrossDriver      bool
+		mode                  string
+		jobUID                string
 		timeout               time.Duration
 	)
 
@@ -61,26 +98,37 @@ func NewCmd(scheme *runtime.Scheme) *cobra.Command {
 
 			log.Info("retrieving the TenantControlPlane used DataStore")
 
-			originDs := &stewardv1alpha1.DataStore{}
-			if err = client.Get(ctx, types.NamespacedName{Name: tcp.Status.Storage.DataStoreName}, originDs); err != nil {
+			originDs, err := datastore.GetDataStore(ctx, client, *tcp, tcp.Status.Storage.DataStoreName)
+			if err != nil {
 				return err
 			}
 
 			log.Info("retrieving the target DataStore")
 
-			targetDs := &stewardv1alpha1.DataStore{}
-			if err = client.Get(ctx, types.NamespacedName{Name: targetDataStore}, targetDs); err != nil {
+			targetDs, err := datastore.GetDataStore(ctx, client, *tcp, targetDataStore)
+			if err != nil {
 				return err
 			}
 
-			if tcp.Status.Storage.Driver != string(targetDs.Spec.Driver) {
-				return fmt.Errorf("migration between DataStore with different driver is not supported")
+			crossDriver := tcp.Status.Storage.Driver != string(targetDs.Spec.Driver)
+
+			if crossDriver && !allowCrossDriver {
+				return fmt.Errorf("migration between DataStore with different driver has not been allowed")
+			}
+
+			if err = datastore.CheckStreamDrivers(stewardv1alpha1.Driver(tcp.Status.Storage.Driver), targetDs.Spec.Driver); err != nil {
+				return err
 			}
 
 			if tcp.Status.Storage.DataStoreName == targetDs.GetName() {
 				return fmt.Errorf("cannot migrate to the same DataStore")
 			}
 
+			migrationMode := stewardv1alpha1.DataStoreMigrationMode(mode)
+			if migrationMode != stewardv1alpha1.DataStoreMigrationModeFreeze && migrationMode != stewardv1alpha1.DataStoreMigrationModeLive {
+				return fmt.Errorf("unsupported migration mode %s", mode)
+			}
+
 			log.Info("generating the origin storage connection")
 
 			originConnection, err := datastore.NewStorageConnection(ctx, client, *originDs)
@@ -97,6 +145,12 @@ func NewCmd(scheme *runtime.Scheme) *cobra.Command {
 			}
 			defer targetConnection.Close()
 
+			if migrationMode == stewardv1alpha1.DataStoreMigrationModeLive {
+				if err = datastore.CheckLiveMigration(originConnection, targetConnection); err != nil {
+					return err
+				}
+			}
+
 			if cleanupPriorMigration {
 				log.Info("Checking if target DataStore should be clean-up prior migration")
 
@@ -110,13 +164,60 @@ func NewCmd(scheme *runtime.Scheme) *cobra.Command {
 					log.Info("Cleaning up prior migration has been completed")
 				}
 			}
+
+			key := types.NamespacedName{Namespace: tcp.GetNamespace(), Name: tcp.GetName()}
+
+			if migrationMode == stewardv1alpha1.DataStoreMigrationModeLive {
+				if err = migrateLive(ctx, client, key, *tcp, targetDs.GetName(), jobUID, originConnection, targetConnection); err != nil {
+					return fmt.Errorf("unable to migrate data from %s to %s: %w", originDs.GetName(), targetDs.GetName(), err)
+				}
+
+				log.Info("migration completed")
+
+				return nil
+			}
+			// The writes have been blocked by the Tenant Control Plane reconciliation upon the Job creation.
+			startTime := metav1.Now()
+
+			if err = updateStatus(ctx, client, key, func(status *stewardv1alpha1.DataStoreMigrationStatus) {
+				*status = stewardv1alpha1.DataStoreMigrationStatus{
+					DataStore:       targetDs.GetName(),
+					Mode:            stewardv1alpha1.DataStoreMigrationModeFreeze,
+					Phase:           stewardv1alpha1.DataStoreMigrationPhaseCopying,
+					JobUID:          jobUID,
+					StartTime:       &startTime,
+					FreezeStartTime: &startTime,
+				}
+			}); err != nil {
+				return err
+			}
 			// Start migrating from the old Datastore to the new one
 			log.Info("migration from origin to target started")
 
-			if err = originConnection.Migrate(ctx, *tcp, targetConnection); err != nil {
+			var keys, revision int64
+
+			if crossDriver {
+				// The drivers store the keyspace differently: the keys are copied one by one.
+				keys, revision, err = datastore.Stream(ctx, *tcp, originConnection, targetConnection)
+				if err != nil {
+					return fmt.Errorf("unable to migrate data from %s to %s: %w", originDs.GetName(), targetDs.GetName(), err)
+				}
+
+				log.Info("keyspace has been copied", "keys", keys, "origin", originDs.Spec.Driver, "target", targetDs.Spec.Driver)
+			} else if err = originConnection.Migrate(ctx, *tcp, targetConnection); err != nil {
 				return fmt.Errorf("unable to migrate data from %s to %s: %w", originDs.GetName(), targetDs.GetName(), err)
 			}
 
+			if err = updateStatus(ctx, client, key, func(status *stewardv1alpha1.DataStoreMigrationStatus) {
+				completionTime := metav1.Now()
+
+				status.Phase, status.CompletionTime = stewardv1alpha1.DataStoreMigrationPhaseCompleted, &completionTime
+				status.Revision, status.CopiedKeys = revision, keys
+				status.FreezeDuration = &metav1.Duration{Duration: completionTime.Sub(startTime.Time)}
+			}); err != nil {
+				return err
+			}
+
 			log.Info("migration completed")
 
 			return nil
@@ -126,6 +227,9 @@ func NewCmd(scheme *runtime.Scheme) *cobra.Command {
 	cmd.Flags().StringVar(&tenantControlPlane, "tenant-control-plane", "", "Namespaced-name of the TenantControlPlane that must be migrated (e.g.: default/test)")
 	cmd.Flags().StringVar(&targetDataStore, "target-datastore", "", "Name of the Datastore to which the TenantControlPlane will be migrated")
 	cmd.Flags().BoolVar(&cleanupPriorMigration, "cleanup-prior-migration", false, "When set to true, migration job will drop existing data in the target DataStore: useful to avoid stale data when migrating back and forth between DataStores.")
+	cmd.Flags().BoolVar(&allowCrossDriver, "allow-cross-driver", false, "When set to true, the data can be migrated to a DataStore with a different driver, copying the keys one by one.")
+	cmd.Flags().StringVar(&mode, "mode", string(stewardv1alpha1.DataStoreMigrationModeFreeze), "Migration mode, either Freeze, blocking the writes for the whole copy, or Live, blocking them only to replay the changes occurred during the copy.")
+	cmd.Flags().StringVar(&jobUID, "job-uid", "", "UID of the migration Job, scoping the progress reported in the TenantControlPlane status.")
 	cmd.Flags().DurationVar(&timeout, "timeout", 5*time.Minute, "Amount of time for the context timeout")
 
 	_ = cmd.MarkFlagRequired("tenant-control-plane")
@@ -133,3 +237,115 @@ func NewCmd(scheme *runtime.Scheme) *cobra.Command {
 
 	return cmd
 }
+
+// migrateLive copies the keyspace while the Tenant Control Plane writes continue:
+// once copied, the writes are blocked, and the changes occurred during the copy are replayed to the target DataStore.
+func migrateLive(ctx context.Context, client ctrlclient.Client, key types.NamespacedName, tcp stewardv1alpha1.TenantControlPlane, dataStore, jobUID string, origin, target datastore.Connection) error {
+	log := ctrl.Log
+
+	startTime := metav1.Now()
+
+	if err := updateStatus(ctx, client, key, func(status *stewardv1alpha1.DataStoreMigrationStatus) {
+		*status = stewardv1alpha1.DataStoreMigrationStatus{
+			DataStore: dataStore,
+			Mode:      stewardv1alpha1.DataStoreMigrationModeLive,
+			Phase:     stewardv1alpha1.DataStoreMigrationPhaseCopying,
+			JobUID:    jobUID,
+			StartTime: &startTime,
+		}
+	}); err != nil {
+		return err
+	}
+
+	log.Info("copy of the keyspace started, the writes are allowed")
+
+	keys, revision, err := datastore.Stream(ctx, tcp, origin, target)
+	if err != nil {
+		return err
+	}
+
+	log.Info("keyspace has been copied, blocking the writes", "keys", keys, "revision", revision)
+	// The Tenant Control Plane reconciliation moves to the Migrating status, blocking the writes, upon the Freezing phase.
+	freezeStartTime := metav1.Now()
+
+	if err = updateStatus(ctx, client, key, func(status *stewardv1alpha1.DataStoreMigrationStatus) {
+		status.Phase, status.FreezeStartTime = stewardv1alpha1.DataStoreMigrationPhaseFreezing, &freezeStartTime
+		status.Revision, status.CopiedKeys = revision, keys
+	}); err != nil {
+		return err
+	}
+
+	if err = waitForFreeze(ctx, client, key); err != nil {
+		return fmt.Errorf("the writes have not been blocked: %w", err)
+	}
+
+	if err = updateStatus(ctx, client, key, func(status *stewardv1alpha1.DataStoreMigrationStatus) {
+		status.Phase = stewardv1alpha1.DataStoreMigrationPhaseReplaying
+	}); err != nil {
+		return err
+	}
+
+	log.Info("replay of the changes started", "revision", revision)
+
+	replayed, current, err := datastore.Replay(ctx, tcp, origin, target, revision)
+	if err != nil {
+		return err
+	}
+
+	log.Info("changes have been replayed", "changes", replayed, "revision", current)
+
+	return updateStatus(ctx, client, key, func(status *stewardv1alpha1.DataStoreMigrationStatus) {
+		completionTime := metav1.Now()
+
+		status.Phase, status.CompletionTime, status.ReplayedChanges = stewardv1alpha1.DataStoreMigrationPhaseCompleted, &completionTime, replayed
+		status.FreezeDuration = &metav1.Duration{Duration: completionTime.Sub(freezeStartTime.Time)}
+	})
+}
+
+// waitForFreeze blocks until the freeze webhook has been installed in the tenant cluster,
+// waiting for the API Server instances to load it.
+func waitForFreeze(ctx context.Context, client ctrlclient.Client, key types.NamespacedName) error {
+	if err := wait.PollUntilContextCancel(ctx, freezePollInterval, true, func(ctx context.Context) (bool, error) {
+		tcp := &stewardv1alpha1.TenantControlPlane{}
+		if err := client.Get(ctx, key, tcp); err != nil {
+			return false, err
+		}
+
+		if status := tcp.Status.Kubernetes.Version.Status; status == nil || *status != stewardv1alpha1.VersionMigrating {
+			return false, nil
+		}
+
+		return freezeWebhookInstalled(ctx, client, tcp)
+	}); err != nil {
+		return err
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(freezeSettleDelay):
+		return nil
+	}
+}
+
+// updateStatus records the progress of the migration in the Tenant Control Plane status.
+func updateStatus(ctx context.Context, client ctrlclient.Client, key types.NamespacedName, mutateFn func(status *stewardv1alpha1.DataStoreMigrationStatus)) error {
+	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		tcp := &stewardv1alpha1.TenantControlPlane{}
+		if err := client.Get(ctx, key, tcp); err != nil {
+			return err
+		}
+
+		if tcp.Status.Storage.Migration == nil {
+			tcp.Status.Storage.Migration = &stewardv1alpha1.DataStoreMigrationStatus{}
+		}
+
+		mutateFn(tcp.Status.Storage.Migration)
+
+		return client.Status().Update(ctx, tcp)
+	}); err != nil {
+		return fmt.Errorf("unable to update the migration status: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,219 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package migrate
+
+import (
+	"context"
+	"errors"
+	"io"
+	"maps"
+	"slices"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/internal/datastore"
+)
+
+// memoryConnection is an in-memory etcd keyspace supporting the live migration, unimplemented methods panic.
+type memoryConnection struct {
+	datastore.Connection
+
+	keys     map[string][]byte
+	revision int64
+	// changes are the changes occurred after the revision.
+	changes []datastore.KeyChange
+}
+
+func (c *memoryConnection) Driver() string {
+	return string(stewardv1alpha1.EtcdDriver)
+}
+
+func (c *memoryConnection) Check(context.Context) error {
+	return nil
+}
+
+func (c *memoryConnection) DBExists(context.Context, string) (bool, error) {
+	return true, nil
+}
+
+func (c *memoryConnection) Export(_ context.Context, _ stewardv1alpha1.TenantControlPlane, archive *datastore.ArchiveWriter) error {
+	for _, key := range slices.Sorted(maps.Keys(c.keys)) {
+		if err := archive.Write(key, c.keys[key]); err != nil {
+			return err
+		}
+	}
+
+	archive.SetRevision(c.revision)
+
+	return nil
+}
+
+func (c *memoryConnection) Import(_ context.Context, _ stewardv1alpha1.TenantControlPlane, archive *datastore.ArchiveReader) error {
+	for {
+		record, err := archive.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		c.keys[record.Key] = record.Value
+	}
+}
+
+func (c *memoryConnection) Changes(_ context.Context, _ stewardv1alpha1.TenantControlPlane, revision int64, fn func(change datastore.KeyChange) error) (int64, error) {
+	Expect(revision).To(Equal(c.revision))
+
+	for _, change := range c.changes {
+		if err := fn(change); err != nil {
+			return 0, err
+		}
+	}
+
+	return c.revision + int64(len(c.changes)), nil
+}
+
+func (c *memoryConnection) Apply(_ context.Context, _ stewardv1alpha1.TenantControlPlane, changes []datastore.KeyChange) error {
+	for _, change := range changes {
+		if change.Deleted {
+			delete(c.keys, change.Key)
+
+			continue
+		}
+
+		c.keys[change.Key] = change.Value
+	}
+
+	return nil
+}
+
+var _ = Describe("Live migration", func() {
+	var (
+		ctx            context.Context
+		tcp            *stewardv1alpha1.TenantControlPlane
+		key            types.NamespacedName
+		client         ctrlclient.Client
+		origin, target *memoryConnection
+		// phases are the migration phases recorded in the Tenant Control Plane status, in order.
+		phases []stewardv1alpha1.DataStoreMigrationPhase
+		// freeze tells whether the Tenant Control Plane reconciliation blocks the writes upon the Freezing phase.
+		freeze bool
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		tcp = &stewardv1alpha1.TenantControlPlane{ObjectMeta: metav1.ObjectMeta{Name: "tcp", Namespace: "default"}}
+		tcp.Status.Kubernetes.Version.Status = &stewardv1alpha1.VersionReady
+		key = types.NamespacedName{Namespace: tcp.GetNamespace(), Name: tcp.GetName()}
+
+		origin = &memoryConnection{
+			keys:     map[string][]byte{"/registry/configmaps/default/first": []byte("1"), "/registry/configmaps/default/second": []byte("2")},
+			revision: 42,
+			changes: []datastore.KeyChange{
+				{Key: "/registry/configmaps/default/second", Value: []byte("2.1")},
+				{Key: "/registry/configmaps/default/first", Deleted: true},
+				{Key: "/registry/configmaps/default/third", Value: []byte("3")},
+			},
+		}
+		target = &memoryConnection{keys: map[string][]byte{}}
+
+		phases, freeze = nil, true
+
+		scheme := runtime.NewScheme()
+		Expect(stewardv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		client = fake.NewClientBuilder().WithScheme(scheme).WithObjects(tcp).WithStatusSubresource(tcp).WithInterceptorFuncs(interceptor.Funcs{
+			SubResourceUpdate: func(ctx context.Context, client ctrlclient.Client, subResourceName string, obj ctrlclient.Object, opts ...ctrlclient.SubResourceUpdateOption) error {
+				// Simulating the Tenant Control Plane reconciliation, blocking the writes upon the Freezing phase.
+				if updated, ok := obj.(*stewardv1alpha1.TenantControlPlane); ok && updated.Status.Storage.Migration != nil {
+					phases = append(phases, updated.Status.Storage.Migration.Phase)
+
+					if freeze && updated.Status.Storage.Migration.Phase == stewardv1alpha1.DataStoreMigrationPhaseFreezing {
+						updated.Status.Kubernetes.Version.Status = &stewardv1alpha1.VersionMigrating
+					}
+				}
+
+				return client.SubResource(subResourceName).Update(ctx, obj, opts...)
+			},
+		}).Build()
+
+		pollInterval, settleDelay, webhookInstalled := freezePollInterval, freezeSettleDelay, freezeWebhookInstalled
+		DeferCleanup(func() {
+			freezePollInterval, freezeSettleDelay, freezeWebhookInstalled = pollInterval, settleDelay, webhookInstalled
+		})
+
+		freezePollInterval, freezeSettleDelay = 10*time.Millisecond, 0
+		freezeWebhookInstalled = func(context.Context, ctrlclient.Client, *stewardv1alpha1.TenantControlPlane) (bool, error) {
+			return true, nil
+		}
+	})
+
+	It("should replay the changes occurred during the copy once the writes are blocked", func() {
+		Expect(migrateLive(ctx, client, key, *tcp, "target", "job-uid", origin, target)).To(Succeed())
+
+		Expect(phases).To(Equal([]stewardv1alpha1.DataStoreMigrationPhase{
+			stewardv1alpha1.DataStoreMigrationPhaseCopying,
+			stewardv1alpha1.DataStoreMigrationPhaseFreezing,
+			stewardv1alpha1.DataStoreMigrationPhaseReplaying,
+			stewardv1alpha1.DataStoreMigrationPhaseCompleted,
+		}))
+		Expect(target.keys).To(Equal(map[string][]byte{
+			"/registry/configmaps/default/second": []byte("2.1"),
+			"/registry/configmaps/default/third":  []byte("3"),
+		}))
+
+		Expect(client.Get(ctx, key, tcp)).To(Succeed())
+
+		migration := tcp.Status.Storage.Migration
+		Expect(migration.JobUID).To(Equal("job-uid"))
+		Expect(migration.Mode).To(Equal(stewardv1alpha1.DataStoreMigrationModeLive))
+		Expect(migration.Revision).To(Equal(int64(42)))
+		Expect(migration.CopiedKeys).To(Equal(int64(2)))
+		Expect(migration.ReplayedChanges).To(Equal(int64(3)))
+		Expect(migration.FreezeStartTime).ToNot(BeNil())
+		Expect(migration.FreezeDuration).ToNot(BeNil())
+	})
+
+	It("should not replay the changes until the freeze webhook has been installed", func() {
+		freezeWebhookInstalled = func(context.Context, ctrlclient.Client, *stewardv1alpha1.TenantControlPlane) (bool, error) {
+			return false, nil
+		}
+
+		timeoutCtx, cancelFn := context.WithTimeout(ctx, 200*time.Millisecond)
+		defer cancelFn()
+
+		Expect(migrateLive(timeoutCtx, client, key, *tcp, "target", "job-uid", origin, target)).To(MatchError(ContainSubstring("the writes have not been blocked")))
+		Expect(phases).To(Equal([]stewardv1alpha1.DataStoreMigrationPhase{
+			stewardv1alpha1.DataStoreMigrationPhaseCopying,
+			stewardv1alpha1.DataStoreMigrationPhaseFreezing,
+		}))
+		Expect(target.keys).To(HaveKeyWithValue("/registry/configmaps/default/second", []byte("2")))
+	})
+
+	It("should wait for the Tenant Control Plane to block the writes", func() {
+		freeze = false
+
+		timeoutCtx, cancelFn := context.WithTimeout(ctx, 200*time.Millisecond)
+		defer cancelFn()
+
+		Expect(waitForFreeze(timeoutCtx, client, key)).To(HaveOccurred())
+
+		tcp.Status.Kubernetes.Version.Status = &stewardv1alpha1.VersionMigrating
+		Expect(client.Status().Update(ctx, tcp)).To(Succeed())
+
+		Expect(waitForFreeze(ctx, client, key)).To(Succeed())
+	})
+})
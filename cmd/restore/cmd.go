@@ -0,0 +1,237 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package restore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/internal/backup"
+	"github.com/butlerdotdev/steward/internal/datastore"
+)
+
+func NewCmd(scheme *runtime.Scheme) *cobra.Command {
+	// CLI flags
+	var (
+		tenantControlPlaneRestore string
+		workDir                   string
+		timeout                   time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:          "restore",
+		Short:        "Replace the keyspace of a TenantControlPlane with the archive declared by a TenantControlPlaneRestore",
+		SilenceUsage: true,
+		RunE: func(*cobra.Command, []string) error {
+			ctx, cancelFn := context.WithTimeout(context.Background(), timeout)
+			defer cancelFn()
+
+			log := ctrl.Log
+
+			log.Info("generating the controller-runtime client")
+
+			client, err := ctrlclient.New(ctrl.GetConfigOrDie(), ctrlclient.Options{
+				Scheme: scheme,
+			})
+			if err != nil {
+				return err
+			}
+
+			parts := strings.Split(tenantControlPlaneRestore, string(types.Separator))
+			if len(parts) != 2 {
+				return fmt.Errorf("non well-formed namespaced name for the tenant control plane restore, expected <NAMESPACE>/NAME, got %s", tenantControlPlaneRestore)
+			}
+
+			log.Info("retrieving the TenantControlPlaneRestore")
+
+			restore := &stewardv1alpha1.TenantControlPlaneRestore{}
+			if err = client.Get(ctx, types.NamespacedName{Namespace: parts[0], Name: parts[1]}, restore); err != nil {
+				return err
+			}
+
+			if restore.Status.Phase != stewardv1alpha1.RestorePhaseRestoring {
+				return fmt.Errorf("the TenantControlPlaneRestore is in the %s phase, expected %s", restore.Status.Phase, stewardv1alpha1.RestorePhaseRestoring)
+			}
+
+			status := restore.Status.DeepCopy()
+
+			if restoreErr := run(ctx, client, *restore, workDir, status); restoreErr != nil {
+				status.Phase, status.Message, status.CompletionTime = stewardv1alpha1.RestorePhaseFailed, restoreErr.Error(), &metav1.Time{Time: time.Now()}
+				err = fmt.Errorf("unable to restore the TenantControlPlane: %w", restoreErr)
+			} else {
+				status.Phase, status.Message, status.RestoreTime = stewardv1alpha1.RestorePhaseAwaitingConfirmation, "", &metav1.Time{Time: time.Now()}
+			}
+
+			log.Info("updating the TenantControlPlaneRestore status", "phase", status.Phase)
+
+			if uErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+				if gErr := client.Get(ctx, types.NamespacedName{Namespace: parts[0], Name: parts[1]}, restore); gErr != nil {
+					return gErr
+				}
+
+				restore.Status = *status
+
+				return client.Status().Update(ctx, restore)
+			}); uErr != nil {
+				return fmt.Errorf("unable to update the restore status: %w", uErr)
+			}
+
+			if err != nil {
+				return err
+			}
+
+			log.Info("restore completed, waiting for the confirmation")
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&tenantControlPlaneRestore, "tenant-control-plane-restore", "", "Namespaced-name of the TenantControlPlaneRestore that must be performed (e.g.: default/test-restore)")
+	cmd.Flags().StringVar(&workDir, "work-dir", os.TempDir(), "Directory storing the verified copy of the archive, sized for the whole archive")
+	cmd.Flags().DurationVar(&timeout, "timeout", 30*time.Minute, "Amount of time for the context timeout")
+
+	_ = cmd.MarkFlagRequired("tenant-control-plane-restore")
+
+	return cmd
+}
+
+func run(ctx context.Context, client ctrlclient.Client, restore stewardv1alpha1.TenantControlPlaneRestore, workDir string, status *stewardv1alpha1.TenantControlPlaneRestoreStatus) error {
+	log := ctrl.Log
+
+	log.Info("retrieving the TenantControlPlane")
+
+	tcp := &stewardv1alpha1.TenantControlPlane{}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: restore.GetNamespace(), Name: restore.Spec.TenantControlPlane}, tcp); err != nil {
+		return err
+	}
+
+	if tcp.Status.Storage.DataStoreName == "" {
+		return fmt.Errorf("the TenantControlPlane storage has not been set up yet")
+	}
+
+	log.Info("retrieving the TenantControlPlane used DataStore")
+
+	ds, err := datastore.GetDataStore(ctx, client, *tcp, tcp.Status.Storage.DataStoreName)
+	if err != nil {
+		return err
+	}
+
+	status.DataStore, status.Schema = ds.GetName(), tcp.Status.Storage.Setup.Schema
+
+	log.Info("opening the backup storage")
+
+	source, err := backup.ResolveRestoreSource(ctx, client, restore)
+	if err != nil {
+		return err
+	}
+
+	storage, err := backup.NewStorage(ctx, client, restore.GetNamespace(), source.Storage)
+	if err != nil {
+		return err
+	}
+	// The archive is verified before touching the DataStore, and imported from the verified local copy:
+	// a corrupted, truncated, or replaced archive must not replace the keyspace.
+	copied, err := os.CreateTemp(workDir, "archive-*")
+	if err != nil {
+		return fmt.Errorf("unable to create the local copy of the archive: %w", err)
+	}
+	defer func() {
+		_ = copied.Close()
+		_ = os.Remove(copied.Name())
+	}()
+
+	log.Info("verification of the archive started")
+
+	header, checksum, err := verify(ctx, storage, source, copied)
+	if err != nil {
+		return fmt.Errorf("unable to verify the archive %s: %w", storage.Location(source.Name), err)
+	}
+
+	status.SourceDriver, status.SourceSchema, status.Checksum = header.Driver, header.Schema, checksum
+
+	if err = datastore.CheckStreamDrivers(stewardv1alpha1.Driver(header.Driver), ds.Spec.Driver); err != nil {
+		return err
+	}
+
+	log.Info("generating the storage connection")
+
+	connection, err := datastore.NewStorageConnection(ctx, client, *ds)
+	if err != nil {
+		return err
+	}
+	defer connection.Close()
+
+	if _, err = copied.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("unable to read the local copy of the archive: %w", err)
+	}
+
+	archive, err := datastore.NewArchiveReader(copied)
+	if err != nil {
+		return err
+	}
+
+	log.Info("import of the keyspace started", "sourceSchema", header.Schema, "schema", tcp.Status.Storage.Setup.Schema)
+
+	if err = connection.Import(ctx, *tcp, archive); err != nil {
+		return fmt.Errorf("unable to import the keyspace into %s: %w", ds.GetName(), err)
+	}
+	log.Info("import of the keyspace completed", "keys", archive.Keys())
+
+	status.Keys = archive.Keys()
+
+	return nil
+}
+
+// verify reads the whole archive, checking its integrity and the expected checksum, if any, while copying it to the given writer:
+// it returns the archive header, and its actual checksum.
+func verify(ctx context.Context, storage backup.Storage, source *backup.RestoreSource, copied io.Writer) (*datastore.ArchiveHeader, string, error) {
+	content, err := storage.Open(ctx, source.Name)
+	if err != nil {
+		return nil, "", err
+	}
+	defer content.Close()
+
+	tee := io.TeeReader(content, copied)
+
+	archive, err := datastore.NewArchiveReader(tee)
+	if err != nil {
+		return nil, "", err
+	}
+
+	for {
+		if _, err = archive.Next(); err != nil {
+			break
+		}
+	}
+
+	if !errors.Is(err, io.EOF) {
+		return nil, "", err
+	}
+	// Copying any trailing content too, the local copy must be read as the verified one.
+	if _, err = io.Copy(io.Discard, tee); err != nil {
+		return nil, "", err
+	}
+
+	if source.Checksum != "" && archive.Checksum() != source.Checksum {
+		return nil, "", fmt.Errorf("checksum mismatch, expected %s, got %s", source.Checksum, archive.Checksum())
+	}
+
+	header := archive.Header()
+
+	return &header, archive.Checksum(), nil
+}
@@ -0,0 +1,245 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/ptr"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+)
+
+// Activator listens on the ports allocated to the sleeping Tenant Control Planes routed to it:
+// the first incoming connection wakes up the Tenant Control Plane, restoring its previous replicas,
+// and the held connections are forwarded to the API Server Pods once ready, without terminating TLS.
+type Activator struct {
+	Client client.Client
+	// APIReader is used to retrieve the Tenant Control Plane Pods without caching all the Pods of the management cluster.
+	APIReader client.Reader
+	// WakeTimeout is the maximum time a connection is held while waiting for the Tenant Control Plane to be ready.
+	WakeTimeout time.Duration
+
+	listeners     map[k8stypes.NamespacedName]*activatorListener
+	listenersLock sync.Mutex
+}
+
+type activatorListener struct {
+	net.Listener
+
+	port int32
+}
+
+func (r *Activator) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	logger := log.FromContext(ctx)
+
+	var port int32
+
+	var tcp stewardv1alpha1.TenantControlPlane
+	if err := r.Client.Get(ctx, request.NamespacedName, &tcp); err != nil {
+		if !k8serrors.IsNotFound(err) {
+			logger.Error(err, "cannot retrieve the required resource")
+
+			return reconcile.Result{}, err
+		}
+	} else {
+		port = tcp.Status.Kubernetes.Service.ActivatorPort
+	}
+
+	r.listenersLock.Lock()
+	defer r.listenersLock.Unlock()
+
+	current, ok := r.listeners[request.NamespacedName]
+	if ok && current.port == port {
+		return reconcile.Result{}, nil
+	}
+	// Closing the listener doesn't affect the connections being already held.
+	if ok {
+		logger.Info("closing the activator listener", "port", current.port)
+
+		_ = current.Close()
+		delete(r.listeners, request.NamespacedName)
+	}
+
+	if port == 0 {
+		return reconcile.Result{}, nil
+	}
+
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		logger.Error(err, "cannot listen on the activator port", "port", port)
+
+		return reconcile.Result{}, err
+	}
+
+	logger.Info("listening on the activator port", "port", port)
+
+	r.listeners[request.NamespacedName] = &activatorListener{Listener: listener, port: port}
+
+	go r.serve(logger, listener, request.NamespacedName)
+
+	return reconcile.Result{}, nil
+}
+
+func (r *Activator) serve(logger logr.Logger, listener net.Listener, key k8stypes.NamespacedName) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				logger.Error(err, "cannot accept the connection")
+			}
+
+			return
+		}
+
+		go r.handle(logger, conn, key)
+	}
+}
+
+func (r *Activator) handle(logger logr.Logger, conn net.Conn, key k8stypes.NamespacedName) {
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.WakeTimeout)
+	defer cancel()
+
+	address, err := r.wake(log.IntoContext(ctx, logger), key)
+	if err != nil {
+		logger.Error(err, "cannot wake up the Tenant Control Plane", "remote", conn.RemoteAddr().String())
+
+		return
+	}
+
+	upstream, err := (&net.Dialer{}).DialContext(ctx, "tcp", address)
+	if err != nil {
+		logger.Error(err, "cannot connect to the API Server", "address", address)
+
+		return
+	}
+	defer upstream.Close()
+
+	pipe(conn, upstream)
+}
+
+// wake scales the sleeping Tenant Control Plane back to its previous replicas,
+// returning the address of a ready API Server Pod once the Tenant Control Plane is ready.
+func (r *Activator) wake(ctx context.Context, key k8stypes.NamespacedName) (string, error) {
+	var address string
+
+	err := wait.PollUntilContextCancel(ctx, time.Second, true, func(ctx context.Context) (bool, error) {
+		var tcp stewardv1alpha1.TenantControlPlane
+		if err := r.Client.Get(ctx, key, &tcp); err != nil {
+			return false, errors.Wrap(err, "cannot retrieve the Tenant Control Plane")
+		}
+
+		if ptr.Deref(tcp.Spec.ControlPlane.Deployment.Replicas, 2) == 0 {
+			patch := client.MergeFrom(tcp.DeepCopy())
+			tcp.Spec.ControlPlane.Deployment.Replicas = ptr.To(ptr.Deref(tcp.Status.Hibernation.Replicas, 2))
+
+			if err := r.Client.Patch(ctx, &tcp, patch); err != nil {
+				return false, errors.Wrap(err, "cannot scale the Tenant Control Plane")
+			}
+
+			log.FromContext(ctx).Info("Tenant Control Plane has been woken up", "replicas", *tcp.Spec.ControlPlane.Deployment.Replicas)
+
+			return false, nil
+		}
+
+		if ptr.Deref(tcp.Status.Kubernetes.Version.Status, stewardv1alpha1.VersionUnknown) != stewardv1alpha1.VersionReady {
+			return false, nil
+		}
+
+		var err error
+		address, err = r.upstream(ctx, &tcp)
+
+		return len(address) > 0, err
+	})
+
+	return address, err
+}
+
+// upstream returns the address of a random ready API Server Pod, if any.
+func (r *Activator) upstream(ctx context.Context, tcp *stewardv1alpha1.TenantControlPlane) (string, error) {
+	selector, err := labels.Parse(tcp.Status.Kubernetes.Deployment.Selector)
+	if err != nil {
+		return "", errors.Wrap(err, "cannot parse the Tenant Control Plane Pods selector")
+	}
+
+	var pods corev1.PodList
+	if err = r.APIReader.List(ctx, &pods, client.InNamespace(tcp.GetNamespace()), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return "", errors.Wrap(err, "cannot list the Tenant Control Plane Pods")
+	}
+
+	var addresses []string
+
+	for _, pod := range pods.Items {
+		if pod.Status.PodIP == "" || pod.GetDeletionTimestamp() != nil {
+			continue
+		}
+
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == corev1.PodReady && condition.Status == corev1.ConditionTrue {
+				addresses = append(addresses, net.JoinHostPort(pod.Status.PodIP, strconv.FormatInt(int64(tcp.Spec.NetworkProfile.Port), 10)))
+			}
+		}
+	}
+
+	if len(addresses) == 0 {
+		return "", nil
+	}
+
+	return addresses[rand.IntN(len(addresses))], nil //nolint:gosec
+}
+
+// pipe copies the data between the given connections in both directions, until both are completed.
+func pipe(downstream, upstream net.Conn) {
+	var wg sync.WaitGroup
+
+	copyFn := func(dst, src net.Conn) {
+		defer wg.Done()
+
+		_, _ = io.Copy(dst, src)
+		// Propagating the half-close allows the other direction to complete.
+		if conn, ok := dst.(interface{ CloseWrite() error }); ok {
+			_ = conn.CloseWrite()
+
+			return
+		}
+
+		_ = dst.Close()
+	}
+
+	wg.Add(2)
+
+	go copyFn(upstream, downstream)
+	go copyFn(downstream, upstream)
+
+	wg.Wait()
+}
+
+func (r *Activator) SetupWithManager(mgr controllerruntime.Manager) error {
+	r.listeners = make(map[k8stypes.NamespacedName]*activatorListener)
+
+	return controllerruntime.NewControllerManagedBy(mgr).
+		Named("activator").
+		For(&stewardv1alpha1.TenantControlPlane{}).
+		Complete(r)
+}
@@ -15,6 +15,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	clientcmdapiv1 "k8s.io/client-go/tools/clientcmd/api/v1"
+	"k8s.io/client-go/tools/record"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -35,7 +36,8 @@ type CertificateLifecycle struct {
 	Deadline  time.Duration
 	EnqueueFn func(secret *corev1.Secret)
 
-	client client.Client
+	client   client.Client
+	recorder record.EventRecorder
 }
 
 func (s *CertificateLifecycle) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
@@ -92,6 +94,7 @@ func (s *CertificateLifecycle) Reconcile(ctx context.Context, request reconcile.
 	if deadline.After(crt.NotAfter) {
 		logger.Info("certificate near expiration, must be rotated")
 
+		s.recordExpiration(secret, crt)
 		s.EnqueueFn(&secret)
 
 		logger.Info("certificate rotation triggered")
@@ -135,6 +138,34 @@ func (s *CertificateLifecycle) EnqueueForKubeconfigGenerator(secret *corev1.Secr
 	}
 }
 
+// recordExpiration emits an Event on the Tenant Control Plane owning the Secret,
+// since the Secret itself could be not accessible by the tenant owners.
+func (s *CertificateLifecycle) recordExpiration(secret corev1.Secret, crt *x509.Certificate) {
+	if s.recorder == nil {
+		return
+	}
+
+	for _, or := range secret.GetOwnerReferences() {
+		if or.Kind != "TenantControlPlane" {
+			continue
+		}
+
+		tcp := &stewardv1alpha1.TenantControlPlane{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: or.APIVersion,
+				Kind:       or.Kind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      or.Name,
+				Namespace: secret.Namespace,
+				UID:       or.UID,
+			},
+		}
+
+		s.recorder.Eventf(tcp, corev1.EventTypeNormal, utils.EventReasonCertificateExpiring, "certificate stored in Secret %s expires at %s, triggering its rotation", secret.Name, crt.NotAfter.Format(time.RFC3339))
+	}
+}
+
 func (s *CertificateLifecycle) extractCertificateFromBareSecret(secret corev1.Secret) (*x509.Certificate, error) {
 	var crt *x509.Certificate
 	var err error
@@ -176,6 +207,7 @@ func (s *CertificateLifecycle) extractCertificateFromKubeconfig(secret corev1.Se
 
 func (s *CertificateLifecycle) SetupWithManager(mgr controllerruntime.Manager) error {
 	s.client = mgr.GetClient()
+	s.recorder = mgr.GetEventRecorderFor("certificate-lifecycle-controller")
 
 	supportedStrategies := sets.New[string](utilities.CertificateX509Label, utilities.CertificateKubeconfigLabel)
 
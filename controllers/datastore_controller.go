@@ -5,9 +5,13 @@ package controllers
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	k8stypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -24,6 +28,7 @@ import (
 
 	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
 	"github.com/butlerdotdev/steward/controllers/utils"
+	"github.com/butlerdotdev/steward/internal/datastore"
 )
 
 type DataStore struct {
@@ -32,6 +37,18 @@ type DataStore struct {
 	// if a Data Source is updated, we have to be sure that the reconciliation of the certificates content
 	// for each Tenant Control Plane is put in place properly.
 	TenantControlPlaneTrigger chan event.GenericEvent
+	// ProbeInterval is the interval between two probes of the DataStore health, the probe is disabled if zero.
+	ProbeInterval time.Duration
+	// ProbeTimeout bounds the duration of the DataStore connectivity check.
+	ProbeTimeout time.Duration
+	// LatencyThreshold is the probe latency beyond which the DataStore is reported as degraded.
+	LatencyThreshold time.Duration
+	// CertificateExpirationThreshold is the remaining validity of the DataStore certificates
+	// below which the DataStore is reported as degraded.
+	CertificateExpirationThreshold time.Duration
+
+	// probeFn checks the DataStore health, defaulting to datastore.Probe.
+	probeFn func(ctx context.Context, c client.Client, ds stewardv1alpha1.DataStore) (*datastore.ProbeResult, error)
 }
 
 //+kubebuilder:rbac:groups=steward.butlerlabs.dev,resources=datastores,verbs=get;list;watch;create;update;patch;delete
@@ -59,9 +76,30 @@ func (r *DataStore) Reconcile(ctx context.Context, request reconcile.Request) (r
 		return reconcile.Result{}, nil
 	}
 
+	// Tenant Control Planes are reconciled back upon a DataStore change, or when it becomes reachable again.
+	triggerTenants := ds.Generation != ds.Status.ObservedGeneration
+
+	var probe *dataStoreProbe
+
+	requeueAfter := r.ProbeInterval
+	// The Tenant Control Plane events would trigger a probe each: the latest one is kept until the interval elapses,
+	// unless the DataStore has been changed in the meanwhile.
+	if elapsed, recent := r.sinceLastProbe(ds); recent && !triggerTenants {
+		requeueAfter -= elapsed
+	} else if r.ProbeInterval > 0 {
+		probe = r.probe(ctx, ds)
+
+		previous := meta.FindStatusCondition(ds.Status.Conditions, stewardv1alpha1.ReadyCondition)
+		triggerTenants = triggerTenants || (previous != nil && previous.Status != probe.ready.Status)
+	}
+
 	var tcpList stewardv1alpha1.TenantControlPlaneList
 
 	updateErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if gErr := r.Client.Get(ctx, request.NamespacedName, &ds); gErr != nil {
+			return errors.Wrap(gErr, "cannot retrieve the given instance")
+		}
+
 		if lErr := r.Client.List(ctx, &tcpList, client.MatchingFieldsSelector{
 			Selector: fields.OneTermEqualSelector(stewardv1alpha1.TenantControlPlaneUsedDataStoreKey, ds.GetName()),
 		}); lErr != nil {
@@ -74,6 +112,16 @@ func (r *DataStore) Reconcile(ctx context.Context, request reconcile.Request) (r
 		}
 
 		ds.Status.UsedBy = tcpSets.List()
+		ds.Status.ObservedGeneration = ds.Generation
+
+		switch {
+		case probe != nil:
+			probe.apply(&ds)
+		case r.ProbeInterval == 0:
+			// The probe has been disabled: stale conditions would prevent the Tenant Control Plane reconciliation.
+			meta.RemoveStatusCondition(&ds.Status.Conditions, stewardv1alpha1.ReadyCondition)
+			meta.RemoveStatusCondition(&ds.Status.Conditions, stewardv1alpha1.DataStoreDegradedCondition)
+		}
 
 		if sErr := r.Client.Status().Update(ctx, &ds); sErr != nil {
 			return errors.Wrap(sErr, "cannot update the status for the given instance")
@@ -86,6 +134,14 @@ func (r *DataStore) Reconcile(ctx context.Context, request reconcile.Request) (r
 
 		return reconcile.Result{}, updateErr
 	}
+
+	if probe != nil && probe.ready.Status != metav1.ConditionTrue {
+		logger.Info("the DataStore is not ready", "reason", probe.ready.Reason, "message", probe.ready.Message)
+	}
+
+	if !triggerTenants {
+		return reconcile.Result{RequeueAfter: requeueAfter}, nil
+	}
 	// Triggering the reconciliation of the Tenant Control Plane upon a Secret change
 	for _, tcp := range tcpList.Items {
 		var shrunkTCP stewardv1alpha1.TenantControlPlane
@@ -96,10 +152,97 @@ func (r *DataStore) Reconcile(ctx context.Context, request reconcile.Request) (r
 		go utils.TriggerChannel(ctx, r.TenantControlPlaneTrigger, shrunkTCP)
 	}
 
-	return reconcile.Result{}, nil
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// sinceLastProbe returns the time elapsed since the latest probe, and whether it's within the probe interval.
+func (r *DataStore) sinceLastProbe(ds stewardv1alpha1.DataStore) (time.Duration, bool) {
+	if r.ProbeInterval == 0 || ds.Status.LastProbeTime == nil {
+		return 0, false
+	}
+
+	elapsed := time.Since(ds.Status.LastProbeTime.Time)
+
+	return elapsed, elapsed >= 0 && elapsed < r.ProbeInterval
+}
+
+// dataStoreProbe is the health of the DataStore, as observed by the probe.
+type dataStoreProbe struct {
+	time       metav1.Time
+	latency    metav1.Duration
+	expiration *metav1.Time
+	ready      metav1.Condition
+	degraded   metav1.Condition
+}
+
+func (p *dataStoreProbe) apply(ds *stewardv1alpha1.DataStore) {
+	ds.Status.LastProbeTime, ds.Status.LastProbeLatency, ds.Status.CertificatesExpiration = &p.time, &p.latency, p.expiration
+
+	for _, condition := range []metav1.Condition{p.ready, p.degraded} {
+		condition.ObservedGeneration = ds.Generation
+		meta.SetStatusCondition(&ds.Status.Conditions, condition)
+	}
+}
+
+// probe checks the connectivity, and the certificates, of the DataStore.
+func (r *DataStore) probe(ctx context.Context, ds stewardv1alpha1.DataStore) *dataStoreProbe {
+	probeCtx, cancelFn := context.WithTimeout(ctx, r.ProbeTimeout)
+	defer cancelFn()
+
+	probe := &dataStoreProbe{
+		time: metav1.Now(),
+		ready: metav1.Condition{
+			Type:    stewardv1alpha1.ReadyCondition,
+			Status:  metav1.ConditionTrue,
+			Reason:  stewardv1alpha1.DataStoreHealthyReason,
+			Message: "the data store is reachable",
+		},
+		degraded: metav1.Condition{
+			Type:    stewardv1alpha1.DataStoreDegradedCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  stewardv1alpha1.DataStoreHealthyReason,
+			Message: "the data store is healthy",
+		},
+	}
+
+	result, err := r.probeFn(probeCtx, r.Client, ds)
+	if err == nil {
+		err = result.Err
+	}
+
+	if result != nil {
+		probe.latency = metav1.Duration{Duration: result.Latency.Round(time.Millisecond)}
+
+		if result.CertificatesExpiration != nil {
+			probe.expiration = &metav1.Time{Time: *result.CertificatesExpiration}
+		}
+	}
+
+	switch {
+	case err != nil:
+		probe.ready.Status, probe.ready.Reason, probe.ready.Message = metav1.ConditionFalse, stewardv1alpha1.DataStoreConnectionFailedReason, err.Error()
+	case probe.expiration != nil && probe.expiration.Before(&probe.time):
+		probe.ready.Status, probe.ready.Reason = metav1.ConditionFalse, stewardv1alpha1.DataStoreCertificateExpiredReason
+		probe.ready.Message = fmt.Sprintf("the certificates expired at %s", probe.expiration.UTC().Format(time.RFC3339))
+	}
+
+	switch {
+	case probe.ready.Status != metav1.ConditionTrue:
+		probe.degraded.Status, probe.degraded.Reason, probe.degraded.Message = metav1.ConditionUnknown, probe.ready.Reason, "the data store is not ready"
+	case probe.expiration != nil && probe.expiration.Sub(probe.time.Time) < r.CertificateExpirationThreshold:
+		probe.degraded.Status, probe.degraded.Reason = metav1.ConditionTrue, stewardv1alpha1.DataStoreCertificateExpiringReason
+		probe.degraded.Message = fmt.Sprintf("the certificates expire at %s", probe.expiration.UTC().Format(time.RFC3339))
+	case r.LatencyThreshold > 0 && probe.latency.Duration > r.LatencyThreshold:
+		probe.degraded.Status, probe.degraded.Reason = metav1.ConditionTrue, stewardv1alpha1.DataStoreHighLatencyReason
+		probe.degraded.Message = fmt.Sprintf("the connectivity check took %s, above the %s threshold", probe.latency.Duration, r.LatencyThreshold)
+	}
+
+	return probe
 }
 
 func (r *DataStore) SetupWithManager(mgr controllerruntime.Manager) error {
+	r.probeFn = datastore.Probe
+
 	enqueueFn := func(tcp *stewardv1alpha1.TenantControlPlane, limitingInterface workqueue.TypedRateLimitingInterface[reconcile.Request]) {
 		if dataStoreName := tcp.Status.Storage.DataStoreName; len(dataStoreName) > 0 {
 			limitingInterface.AddRateLimited(reconcile.Request{
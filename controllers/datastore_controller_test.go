@@ -0,0 +1,145 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/internal/datastore"
+)
+
+var _ = Describe("DataStore", func() {
+	var (
+		ctx         context.Context
+		ds          *stewardv1alpha1.DataStore
+		result      *datastore.ProbeResult
+		probed      int
+		reconciler  *DataStore
+		reconcileFn func() reconcile.Result
+		condition   func(conditionType string) *metav1.Condition
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		ds = &stewardv1alpha1.DataStore{
+			ObjectMeta: metav1.ObjectMeta{Name: "default", Generation: 1},
+			Spec:       stewardv1alpha1.DataStoreSpec{Driver: stewardv1alpha1.EtcdDriver},
+		}
+
+		result, probed = &datastore.ProbeResult{Latency: 10 * time.Millisecond}, 0
+	})
+
+	JustBeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(stewardv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		indexer := &stewardv1alpha1.TenantControlPlaneStatusDataStore{}
+
+		reconciler = &DataStore{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(ds).WithStatusSubresource(ds).
+				WithIndex(indexer.Object(), indexer.Field(), indexer.ExtractValue()).Build(),
+			TenantControlPlaneTrigger:      make(chan event.GenericEvent),
+			ProbeInterval:                  time.Minute,
+			ProbeTimeout:                   time.Second,
+			LatencyThreshold:               time.Second,
+			CertificateExpirationThreshold: 30 * 24 * time.Hour,
+			probeFn: func(context.Context, client.Client, stewardv1alpha1.DataStore) (*datastore.ProbeResult, error) {
+				probed++
+
+				return result, nil
+			},
+		}
+
+		reconcileFn = func() reconcile.Result {
+			res, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(ds)})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reconciler.Client.Get(ctx, client.ObjectKeyFromObject(ds), ds)).To(Succeed())
+
+			return res
+		}
+
+		condition = func(conditionType string) *metav1.Condition {
+			return meta.FindStatusCondition(ds.Status.Conditions, conditionType)
+		}
+	})
+
+	It("should report a healthy DataStore", func() {
+		Expect(reconcileFn()).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		Expect(condition(stewardv1alpha1.ReadyCondition)).To(HaveField("Status", metav1.ConditionTrue))
+		Expect(condition(stewardv1alpha1.DataStoreDegradedCondition)).To(HaveField("Status", metav1.ConditionFalse))
+		Expect(ds.Status.LastProbeLatency.Duration).To(Equal(10 * time.Millisecond))
+	})
+
+	It("should report an unreachable DataStore", func() {
+		result.Err = fmt.Errorf("connection refused")
+
+		reconcileFn()
+		Expect(condition(stewardv1alpha1.ReadyCondition)).To(And(
+			HaveField("Status", metav1.ConditionFalse),
+			HaveField("Reason", stewardv1alpha1.DataStoreConnectionFailedReason),
+			HaveField("Message", "connection refused"),
+		))
+		Expect(condition(stewardv1alpha1.DataStoreDegradedCondition)).To(HaveField("Status", metav1.ConditionUnknown))
+	})
+
+	It("should report the certificates close to expiration", func() {
+		result.CertificatesExpiration = ptr.To(time.Now().Add(24 * time.Hour))
+
+		reconcileFn()
+		Expect(condition(stewardv1alpha1.ReadyCondition)).To(HaveField("Status", metav1.ConditionTrue))
+		Expect(condition(stewardv1alpha1.DataStoreDegradedCondition)).To(And(
+			HaveField("Status", metav1.ConditionTrue),
+			HaveField("Reason", stewardv1alpha1.DataStoreCertificateExpiringReason),
+		))
+		Expect(ds.Status.CertificatesExpiration).ToNot(BeNil())
+	})
+
+	It("should report the expired certificates", func() {
+		result.CertificatesExpiration = ptr.To(time.Now().Add(-time.Hour))
+
+		reconcileFn()
+		Expect(condition(stewardv1alpha1.ReadyCondition)).To(And(
+			HaveField("Status", metav1.ConditionFalse),
+			HaveField("Reason", stewardv1alpha1.DataStoreCertificateExpiredReason),
+		))
+	})
+
+	It("should report the high latency", func() {
+		result.Latency = 2 * time.Second
+
+		reconcileFn()
+		Expect(condition(stewardv1alpha1.DataStoreDegradedCondition)).To(HaveField("Reason", stewardv1alpha1.DataStoreHighLatencyReason))
+	})
+
+	It("should not probe again within the probe interval", func() {
+		reconcileFn()
+		Expect(probed).To(Equal(1))
+
+		res := reconcileFn()
+		Expect(probed).To(Equal(1))
+		Expect(res.RequeueAfter).To(And(BeNumerically(">", 0), BeNumerically("<", time.Minute)))
+		Expect(condition(stewardv1alpha1.ReadyCondition)).To(HaveField("Status", metav1.ConditionTrue))
+		// A DataStore change is probed right away.
+		ds.Generation++
+		Expect(reconciler.Client.Update(ctx, ds)).To(Succeed())
+
+		reconcileFn()
+		Expect(probed).To(Equal(2))
+	})
+})
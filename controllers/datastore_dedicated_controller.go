@@ -0,0 +1,168 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/controllers/utils"
+	"github.com/butlerdotdev/steward/internal/builders/etcd"
+	"github.com/butlerdotdev/steward/internal/datastore"
+	"github.com/butlerdotdev/steward/internal/resources"
+	ds "github.com/butlerdotdev/steward/internal/resources/datastore"
+)
+
+// DedicatedDataStore deploys, and operates, the etcd clusters dedicated to the Tenant Control Planes with the Dedicated DataStore mode:
+// the etcd cluster is kept until the Tenant Control Plane data has been migrated to a shared DataStore.
+type DedicatedDataStore struct {
+	Client client.Client
+	// Timeout bounds the duration of the operations against the etcd cluster.
+	Timeout time.Duration
+	// CertificateExpirationThreshold is the remaining validity of the etcd certificates below which they're rotated.
+	CertificateExpirationThreshold time.Duration
+
+	operator etcdClusterOperator
+}
+
+//+kubebuilder:rbac:groups=core,resources=persistentvolumeclaims,verbs=deletecollection
+
+func (r *DedicatedDataStore) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	logger := log.FromContext(ctx)
+
+	var tcp stewardv1alpha1.TenantControlPlane
+	if err := r.Client.Get(ctx, request.NamespacedName, &tcp); err != nil {
+		if k8serrors.IsNotFound(err) {
+			logger.Info("resource may have been deleted, skipping")
+
+			return reconcile.Result{}, nil
+		}
+
+		logger.Error(err, "cannot retrieve the required resource")
+
+		return reconcile.Result{}, err
+	}
+	// The clean-up upon deletion is performed by the Tenant Control Plane controller, once the data has been removed.
+	if utils.IsPaused(&tcp) || tcp.GetDeletionTimestamp() != nil {
+		return reconcile.Result{}, nil
+	}
+
+	if tcp.Spec.DataStoreMode != stewardv1alpha1.DataStoreModeDedicated && tcp.Status.Storage.DataStoreName != stewardv1alpha1.DedicatedDataStoreName {
+		if tcp.Status.Storage.Dedicated == nil {
+			return reconcile.Result{}, nil
+		}
+
+		logger.Info("the Tenant Control Plane has been migrated to a shared DataStore, removing the dedicated etcd cluster")
+
+		if err := resources.HandleDeletion(ctx, &ds.DedicatedEtcd{Client: r.Client}, &tcp); err != nil {
+			logger.Error(err, "cannot remove the dedicated etcd cluster")
+
+			return reconcile.Result{}, err
+		}
+
+		return reconcile.Result{}, r.updateStatus(ctx, &tcp, nil)
+	}
+
+	status := tcp.Status.Storage.Dedicated.DeepCopy()
+	cluster := etcd.DedicatedCluster(tcp)
+
+	if status == nil {
+		// The cluster is bootstrapped with all the desired members at once.
+		status = &stewardv1alpha1.EtcdClusterStatus{Members: cluster.Members}
+	}
+
+	sts, err := r.operator.deploy(ctx, &tcp, cluster)
+	if err != nil {
+		logger.Error(err, "cannot deploy the dedicated etcd cluster")
+
+		return reconcile.Result{}, r.reportFailure(ctx, &tcp, status, err)
+	}
+
+	status.Failure = ""
+
+	if r.operator.waitForMembers(sts, status) {
+		logger.Info("waiting for the etcd members to be ready", "ready", status.ReadyMembers, "members", status.Members)
+
+		return reconcile.Result{RequeueAfter: etcdClusterRequeue}, r.updateStatus(ctx, &tcp, status)
+	}
+
+	requeueAfter, err := r.operator.operate(ctx, &tcp, datastore.DedicatedDataStore(tcp), cluster, sts, status)
+	if err != nil {
+		logger.Error(err, "cannot operate the dedicated etcd cluster")
+
+		return reconcile.Result{}, r.reportFailure(ctx, &tcp, status, err)
+	}
+
+	if err = r.updateStatus(ctx, &tcp, status); err != nil {
+		logger.Error(err, "cannot update the dedicated etcd cluster status")
+
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// reportFailure records the error in the dedicated etcd cluster status, reported by the Tenant Control Plane conditions:
+// the given error is returned anyway, to retry the operation.
+func (r *DedicatedDataStore) reportFailure(ctx context.Context, tcp *stewardv1alpha1.TenantControlPlane, status *stewardv1alpha1.EtcdClusterStatus, err error) error {
+	status.Failure = err.Error()
+
+	if statusErr := r.updateStatus(ctx, tcp, status); statusErr != nil {
+		log.FromContext(ctx).Error(statusErr, "cannot report the dedicated etcd cluster failure")
+	}
+
+	return err
+}
+
+func (r *DedicatedDataStore) updateStatus(ctx context.Context, tcp *stewardv1alpha1.TenantControlPlane, status *stewardv1alpha1.EtcdClusterStatus) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() (err error) {
+		defer func() {
+			if err != nil {
+				_ = r.Client.Get(ctx, k8stypes.NamespacedName{Name: tcp.Name, Namespace: tcp.Namespace}, tcp)
+			}
+		}()
+
+		if equality.Semantic.DeepEqual(tcp.Status.Storage.Dedicated, status) {
+			return nil
+		}
+
+		tcp.Status.Storage.Dedicated = status
+
+		return r.Client.Status().Update(ctx, tcp)
+	})
+}
+
+func (r *DedicatedDataStore) SetupWithManager(mgr controllerruntime.Manager) error {
+	r.operator = etcdClusterOperator{
+		Client:                         r.Client,
+		Timeout:                        r.Timeout,
+		CertificateExpirationThreshold: r.CertificateExpirationThreshold,
+		recorder:                       mgr.GetEventRecorderFor("dedicated-datastore-controller"),
+	}
+
+	return controllerruntime.NewControllerManagedBy(mgr).
+		Named("dedicated-datastore").
+		For(&stewardv1alpha1.TenantControlPlane{}, builder.WithPredicates(predicate.NewPredicateFuncs(func(object client.Object) bool {
+			tcp := object.(*stewardv1alpha1.TenantControlPlane) //nolint:forcetypeassert
+
+			return tcp.Spec.DataStoreMode == stewardv1alpha1.DataStoreModeDedicated || tcp.Status.Storage.Dedicated != nil
+		}))).
+		Owns(&appsv1.StatefulSet{}).
+		Owns(&corev1.Service{}).
+		Complete(r)
+}
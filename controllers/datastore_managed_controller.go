@@ -0,0 +1,172 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/controllers/utils"
+	"github.com/butlerdotdev/steward/internal/builders/etcd"
+)
+
+// ManagedDataStore deploys, and operates, the etcd clusters backing the DataStores managed by Steward:
+// the certificates are generated and rotated, the members are scaled and restarted one at a time, and periodically defragmented.
+type ManagedDataStore struct {
+	Client client.Client
+	// StewardNamespace is where the etcd clusters are deployed, unless the DataStore specifies otherwise.
+	StewardNamespace string
+	// Timeout bounds the duration of the operations against the etcd cluster.
+	Timeout time.Duration
+	// CertificateExpirationThreshold is the remaining validity of the etcd certificates below which they're rotated.
+	CertificateExpirationThreshold time.Duration
+
+	operator etcdClusterOperator
+}
+
+func (r *ManagedDataStore) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	logger := log.FromContext(ctx)
+
+	var ds stewardv1alpha1.DataStore
+	if err := r.Client.Get(ctx, request.NamespacedName, &ds); err != nil {
+		if k8serrors.IsNotFound(err) {
+			logger.Info("resource may have been deleted, skipping")
+
+			return reconcile.Result{}, nil
+		}
+
+		logger.Error(err, "cannot retrieve the required resource")
+
+		return reconcile.Result{}, err
+	}
+
+	if ds.Spec.Managed == nil || utils.IsPaused(&ds) || ds.GetDeletionTimestamp() != nil {
+		return reconcile.Result{}, nil
+	}
+
+	status := ds.Status.Managed.DeepCopy()
+	if status == nil {
+		// The cluster is bootstrapped with all the desired members at once.
+		status = &stewardv1alpha1.EtcdClusterStatus{Members: ds.Spec.Managed.Replicas}
+	}
+
+	cluster := etcd.ManagedCluster(ds, r.StewardNamespace)
+
+	sts, err := r.operator.deploy(ctx, &ds, cluster)
+	if err != nil {
+		logger.Error(err, "cannot deploy the etcd cluster")
+
+		return reconcile.Result{}, r.reportFailure(ctx, &ds, status, err)
+	}
+
+	status.Failure = ""
+
+	if err = r.updateSpec(ctx, &ds, cluster); err != nil {
+		logger.Error(err, "cannot update the DataStore endpoints and TLS configuration")
+
+		return reconcile.Result{}, err
+	}
+
+	if r.operator.waitForMembers(sts, status) {
+		logger.Info("waiting for the etcd members to be ready", "ready", status.ReadyMembers, "members", status.Members)
+
+		return reconcile.Result{RequeueAfter: etcdClusterRequeue}, r.updateStatus(ctx, &ds, status)
+	}
+
+	requeueAfter, err := r.operator.operate(ctx, &ds, ds, cluster, sts, status)
+	if err != nil {
+		logger.Error(err, "cannot operate the etcd cluster")
+
+		return reconcile.Result{}, r.reportFailure(ctx, &ds, status, err)
+	}
+
+	if err = r.updateStatus(ctx, &ds, status); err != nil {
+		logger.Error(err, "cannot update the managed DataStore status")
+
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// updateSpec fills in the DataStore endpoints, and the TLS configuration, referring to the current etcd members.
+func (r *ManagedDataStore) updateSpec(ctx context.Context, ds *stewardv1alpha1.DataStore, cluster etcd.Cluster) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if err := r.Client.Get(ctx, k8stypes.NamespacedName{Name: ds.GetName()}, ds); err != nil {
+			return err
+		}
+
+		endpoints, tlsConfig := cluster.Endpoints(), cluster.TLSConfig()
+		if equality.Semantic.DeepEqual(ds.Spec.Endpoints, endpoints) && equality.Semantic.DeepEqual(ds.Spec.TLSConfig, tlsConfig) {
+			return nil
+		}
+
+		ds.Spec.Endpoints, ds.Spec.TLSConfig = endpoints, tlsConfig
+
+		return r.Client.Update(ctx, ds)
+	})
+}
+
+// reportFailure records the error in the managed etcd cluster status: the given error is returned anyway, to retry the operation.
+func (r *ManagedDataStore) reportFailure(ctx context.Context, ds *stewardv1alpha1.DataStore, status *stewardv1alpha1.EtcdClusterStatus, err error) error {
+	status.Failure = err.Error()
+
+	if statusErr := r.updateStatus(ctx, ds, status); statusErr != nil {
+		log.FromContext(ctx).Error(statusErr, "cannot report the managed etcd cluster failure")
+	}
+
+	return err
+}
+
+func (r *ManagedDataStore) updateStatus(ctx context.Context, ds *stewardv1alpha1.DataStore, status *stewardv1alpha1.EtcdClusterStatus) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if err := r.Client.Get(ctx, k8stypes.NamespacedName{Name: ds.GetName()}, ds); err != nil {
+			return err
+		}
+
+		if equality.Semantic.DeepEqual(ds.Status.Managed, status) {
+			return nil
+		}
+
+		ds.Status.Managed = status
+
+		return r.Client.Status().Update(ctx, ds)
+	})
+}
+
+func (r *ManagedDataStore) SetupWithManager(mgr controllerruntime.Manager) error {
+	r.operator = etcdClusterOperator{
+		Client:                         r.Client,
+		Timeout:                        r.Timeout,
+		CertificateExpirationThreshold: r.CertificateExpirationThreshold,
+		recorder:                       mgr.GetEventRecorderFor("managed-datastore-controller"),
+	}
+
+	return controllerruntime.NewControllerManagedBy(mgr).
+		Named("managed-datastore").
+		For(&stewardv1alpha1.DataStore{}, builder.WithPredicates(
+			predicate.GenerationChangedPredicate{},
+			predicate.NewPredicateFuncs(func(object client.Object) bool {
+				return object.(*stewardv1alpha1.DataStore).Spec.Managed != nil //nolint:forcetypeassert
+			}),
+		)).
+		Owns(&appsv1.StatefulSet{}).
+		Owns(&corev1.Service{}).
+		Owns(&corev1.Secret{}).
+		Complete(r)
+}
@@ -0,0 +1,248 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/controllers/utils"
+	"github.com/butlerdotdev/steward/internal/builders/etcd"
+	"github.com/butlerdotdev/steward/internal/constants"
+	"github.com/butlerdotdev/steward/internal/datastore"
+	"github.com/butlerdotdev/steward/internal/utilities"
+)
+
+// etcdClusterRequeue is the interval between two checks of the etcd members, while they're not ready.
+const etcdClusterRequeue = 10 * time.Second
+
+// etcdClusterOperator deploys, and operates, the etcd clusters provisioned by Steward, either backing a managed DataStore,
+// or dedicated to a Tenant Control Plane: the owner of the etcd resources receives the Events about the performed operations.
+type etcdClusterOperator struct {
+	Client                         client.Client
+	Timeout                        time.Duration
+	CertificateExpirationThreshold time.Duration
+
+	recorder record.EventRecorder
+}
+
+//+kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch;delete
+
+// waitForMembers returns true when the etcd cluster cannot be operated yet, reporting the ready members in the status:
+// otherwise, the quorum could be lost upon a restart, or a scaling.
+func (r *etcdClusterOperator) waitForMembers(sts *appsv1.StatefulSet, status *stewardv1alpha1.EtcdClusterStatus) bool {
+	status.ReadyMembers = sts.Status.ReadyReplicas
+
+	return sts.Status.ObservedGeneration != sts.Generation || status.ReadyMembers < status.Members
+}
+
+// deploy ensures the certificates, the Service, and the StatefulSet of the etcd cluster, all controlled by the owner.
+func (r *etcdClusterOperator) deploy(ctx context.Context, owner client.Object, cluster etcd.Cluster) (*appsv1.StatefulSet, error) {
+	ca, err := r.ensureSecret(ctx, owner, cluster, cluster.CASecretName(), func(data map[string][]byte) (map[string][]byte, error) {
+		if len(data[etcd.CACertificateKey]) > 0 && len(data[etcd.CAPrivateKeyKey]) > 0 {
+			return data, nil
+		}
+
+		return cluster.GenerateCA()
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot ensure the etcd Certificate Authority")
+	}
+
+	certs, err := r.ensureSecret(ctx, owner, cluster, cluster.CertificatesSecretName(), func(data map[string][]byte) (map[string][]byte, error) {
+		if cluster.IsServerCertificateValid(ca, data, r.CertificateExpirationThreshold) {
+			return data, nil
+		}
+
+		return cluster.GenerateServerCertificate(ca)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot ensure the etcd server certificate")
+	}
+
+	if _, err = r.ensureSecret(ctx, owner, cluster, cluster.ClientSecretName(), func(data map[string][]byte) (map[string][]byte, error) {
+		if cluster.IsClientCertificateValid(ca, data, r.CertificateExpirationThreshold) {
+			return data, nil
+		}
+
+		return cluster.GenerateClientCertificate(ca)
+	}); err != nil {
+		return nil, errors.Wrap(err, "cannot ensure the etcd root client certificate")
+	}
+
+	service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: cluster.Name, Namespace: cluster.Namespace}}
+	if _, err = utilities.CreateOrUpdateWithConflict(ctx, r.Client, service, func() error {
+		cluster.BuildService(service)
+
+		return controllerutil.SetControllerReference(owner, service, r.Client.Scheme())
+	}); err != nil {
+		return nil, errors.Wrap(err, "cannot ensure the etcd Service")
+	}
+
+	sts := &appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: cluster.Name, Namespace: cluster.Namespace}}
+	if _, err = utilities.CreateOrUpdateWithConflict(ctx, r.Client, sts, func() error {
+		cluster.BuildStatefulSet(sts, cluster.PodChecksum(certs))
+
+		return controllerutil.SetControllerReference(owner, sts, r.Client.Scheme())
+	}); err != nil {
+		return nil, errors.Wrap(err, "cannot ensure the etcd StatefulSet")
+	}
+
+	return sts, nil
+}
+
+// ensureSecret creates, or updates, the given Secret with the content returned by the generate function,
+// emitting an Event when a certificate is generated.
+func (r *etcdClusterOperator) ensureSecret(ctx context.Context, owner client.Object, cluster etcd.Cluster, name string, generate func(map[string][]byte) (map[string][]byte, error)) (map[string][]byte, error) {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: cluster.Namespace}}
+
+	result, err := utilities.CreateOrUpdateWithConflict(ctx, r.Client, secret, func() error {
+		data, err := generate(secret.Data)
+		if err != nil {
+			return err
+		}
+
+		secret.Data = data
+		secret.SetLabels(utilities.MergeMaps(secret.GetLabels(), cluster.Labels()))
+
+		return controllerutil.SetControllerReference(owner, secret, r.Client.Scheme())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	switch result {
+	case controllerutil.OperationResultCreated:
+		r.recorder.Eventf(owner, corev1.EventTypeNormal, utils.EventReasonCertificateCreated, "certificate %s has been generated", name)
+	case controllerutil.OperationResultUpdated:
+		r.recorder.Eventf(owner, corev1.EventTypeNormal, utils.EventReasonCertificateRotated, "certificate %s has been rotated", name)
+	}
+
+	return secret.Data, nil
+}
+
+// operate performs at most one disruptive operation on the etcd cluster, returning when it must be checked again:
+// the authentication is enabled upon the bootstrap, then the outdated members are restarted, and the cluster is scaled.
+func (r *etcdClusterOperator) operate(ctx context.Context, owner client.Object, ds stewardv1alpha1.DataStore, cluster etcd.Cluster, sts *appsv1.StatefulSet, status *stewardv1alpha1.EtcdClusterStatus) (time.Duration, error) {
+	ctx, cancelFn := context.WithTimeout(ctx, r.Timeout)
+	defer cancelFn()
+
+	config, err := datastore.NewConnectionConfig(ctx, r.Client, ds)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot build the etcd connection configuration")
+	}
+
+	connection, err := datastore.NewETCDConnection(*config)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot connect to the etcd cluster")
+	}
+	defer connection.Close()
+
+	etcdClient := connection.(*datastore.EtcdClient) //nolint:forcetypeassert
+
+	if !status.Bootstrapped {
+		if err = etcdClient.EnableAuthentication(ctx, etcd.RootUser); err != nil {
+			return 0, err
+		}
+
+		status.Bootstrapped, status.LastDefragmentation = true, &metav1.Time{Time: time.Now()}
+		r.recorder.Eventf(owner, corev1.EventTypeNormal, utils.EventReasonEtcdBootstrapped, "the etcd cluster has been bootstrapped with %d members", status.Members)
+
+		return etcdClusterRequeue, nil
+	}
+
+	restarted, err := r.restartOutdatedMember(ctx, owner, sts)
+	if err != nil || restarted {
+		return etcdClusterRequeue, err
+	}
+
+	switch {
+	case status.Members < cluster.Spec.Replicas:
+		if err = etcdClient.AddMember(ctx, cluster.MemberPeerURL(status.Members)); err != nil {
+			return 0, err
+		}
+
+		r.recorder.Eventf(owner, corev1.EventTypeNormal, utils.EventReasonEtcdMemberAdded, "the etcd member %s has been added", cluster.MemberName(status.Members))
+		status.Members++
+
+		return etcdClusterRequeue, nil
+	case status.Members > cluster.Spec.Replicas:
+		if err = etcdClient.RemoveMember(ctx, cluster.MemberPeerURL(status.Members-1)); err != nil {
+			return 0, err
+		}
+
+		r.recorder.Eventf(owner, corev1.EventTypeNormal, utils.EventReasonEtcdMemberRemoved, "the etcd member %s has been removed", cluster.MemberName(status.Members-1))
+		status.Members--
+
+		return etcdClusterRequeue, nil
+	}
+
+	interval := cluster.Spec.DefragmentationInterval.Duration
+	if interval == 0 {
+		return 0, nil
+	}
+
+	if status.LastDefragmentation != nil {
+		if elapsed := time.Since(status.LastDefragmentation.Time); elapsed < interval {
+			return interval - elapsed, nil
+		}
+	}
+
+	if err = etcdClient.Defragment(ctx); err != nil {
+		return 0, err
+	}
+
+	status.LastDefragmentation = &metav1.Time{Time: time.Now()}
+	r.recorder.Event(owner, corev1.EventTypeNormal, utils.EventReasonEtcdDefragmented, "the etcd members have been defragmented")
+
+	return interval, nil
+}
+
+// restartOutdatedMember deletes the first member Pod not matching the StatefulSet template checksum:
+// the StatefulSet is using the OnDelete strategy, thus the members are restarted one at a time.
+func (r *etcdClusterOperator) restartOutdatedMember(ctx context.Context, owner client.Object, sts *appsv1.StatefulSet) (bool, error) {
+	var podList corev1.PodList
+	if err := r.Client.List(ctx, &podList, client.InNamespace(sts.GetNamespace()), client.MatchingLabels(sts.Spec.Selector.MatchLabels)); err != nil {
+		return false, errors.Wrap(err, "cannot list the etcd members")
+	}
+
+	slices.SortFunc(podList.Items, func(a, b corev1.Pod) int {
+		return strings.Compare(a.GetName(), b.GetName())
+	})
+
+	checksum := sts.Spec.Template.GetAnnotations()[constants.Checksum]
+
+	for _, pod := range podList.Items {
+		if pod.GetDeletionTimestamp() != nil {
+			return true, nil
+		}
+
+		if pod.GetAnnotations()[constants.Checksum] == checksum {
+			continue
+		}
+
+		if err := r.Client.Delete(ctx, &pod); err != nil && !k8serrors.IsNotFound(err) {
+			return false, errors.Wrapf(err, "cannot restart the etcd member %s", pod.GetName())
+		}
+
+		r.recorder.Eventf(owner, corev1.EventTypeNormal, utils.EventReasonEtcdMemberRestarted, "the etcd member %s has been restarted to apply the changes", pod.GetName())
+
+		return true, nil
+	}
+
+	return false, nil
+}
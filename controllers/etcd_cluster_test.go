@@ -0,0 +1,231 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	etcdclient "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/server/v3/embed"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/controllers/utils"
+	"github.com/butlerdotdev/steward/internal/builders/etcd"
+	"github.com/butlerdotdev/steward/internal/constants"
+)
+
+// embeddedEtcd starts a single member etcd cluster for the current spec, returning its client endpoint.
+func embeddedEtcd() string {
+	config := embed.NewConfig()
+	config.Dir = GinkgoT().TempDir()
+	config.LogLevel = "error"
+	// Allowing a member to be announced, despite the quorum would be lost until it joins.
+	config.StrictReconfigCheck = false
+	// Binding random ports, allowing several servers to run at the same time.
+	listen := url.URL{Scheme: "http", Host: "127.0.0.1:0"}
+	config.ListenClientUrls, config.AdvertiseClientUrls = []url.URL{listen}, []url.URL{listen}
+	config.ListenPeerUrls = []url.URL{listen}
+
+	server, err := embed.StartEtcd(config)
+	Expect(err).ToNot(HaveOccurred())
+	DeferCleanup(server.Close)
+
+	Eventually(server.Server.ReadyNotify()).WithTimeout(time.Minute).Should(BeClosed())
+
+	return server.Clients[0].Addr().String()
+}
+
+var _ = Describe("etcdClusterOperator", func() {
+	var (
+		ctx        context.Context
+		ds         stewardv1alpha1.DataStore
+		sts        *appsv1.StatefulSet
+		status     *stewardv1alpha1.EtcdClusterStatus
+		pods       []client.Object
+		recorder   *record.FakeRecorder
+		operator   *etcdClusterOperator
+		etcdClient *etcdclient.Client
+		operate    func() time.Duration
+	)
+
+	member := func(name, checksum string) *corev1.Pod {
+		return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   "steward-system",
+			Labels:      map[string]string{"app": "etcd"},
+			Annotations: map[string]string{constants.Checksum: checksum},
+		}}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		endpoint := embeddedEtcd()
+
+		var err error
+		etcdClient, err = etcdclient.New(etcdclient.Config{Endpoints: []string{endpoint}, DialTimeout: 5 * time.Second})
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(etcdClient.Close)
+
+		ds = stewardv1alpha1.DataStore{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec: stewardv1alpha1.DataStoreSpec{
+				Driver:    stewardv1alpha1.EtcdDriver,
+				Endpoints: stewardv1alpha1.Endpoints{endpoint},
+				Managed: &stewardv1alpha1.ManagedEtcdSpec{
+					EtcdClusterSpec: stewardv1alpha1.EtcdClusterSpec{Replicas: 1},
+				},
+			},
+		}
+
+		sts = &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "default-etcd", Namespace: "steward-system"},
+			Spec: appsv1.StatefulSetSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "etcd"}},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{constants.Checksum: "current"}},
+				},
+			},
+		}
+
+		status = &stewardv1alpha1.EtcdClusterStatus{Members: 1, Bootstrapped: true, LastDefragmentation: &metav1.Time{Time: time.Now()}}
+		pods = []client.Object{member("default-etcd-0", "current")}
+	})
+
+	JustBeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(stewardv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+
+		recorder = record.NewFakeRecorder(10)
+		operator = &etcdClusterOperator{
+			Client:   fake.NewClientBuilder().WithScheme(scheme).WithObjects(append(pods, &ds)...).Build(),
+			Timeout:  time.Minute,
+			recorder: recorder,
+		}
+
+		operate = func() time.Duration {
+			requeueAfter, err := operator.operate(ctx, &ds, ds, etcd.ManagedCluster(ds, "steward-system"), sts, status)
+			Expect(err).ToNot(HaveOccurred())
+
+			return requeueAfter
+		}
+	})
+
+	event := func(reason string) string {
+		var recorded string
+		Expect(recorder.Events).To(Receive(&recorded))
+		Expect(recorded).To(HavePrefix(corev1.EventTypeNormal + " " + reason + " "))
+
+		return recorded
+	}
+
+	It("should enable the authentication upon the bootstrap", func() {
+		status.Bootstrapped, status.LastDefragmentation = false, nil
+
+		Expect(operate()).To(Equal(etcdClusterRequeue))
+		Expect(status.Bootstrapped).To(BeTrue())
+		Expect(status.LastDefragmentation).ToNot(BeNil())
+		Expect(event(utils.EventReasonEtcdBootstrapped)).To(ContainSubstring("with 1 members"))
+
+		// The anonymous requests are rejected once the authentication is enabled.
+		_, err := etcdClient.Put(ctx, "/tenant/key", "value")
+		Expect(err).To(MatchError(rpctypes.ErrUserEmpty))
+	})
+
+	When("the members are outdated", func() {
+		BeforeEach(func() {
+			pods = []client.Object{member("default-etcd-2", "former"), member("default-etcd-0", "current"), member("default-etcd-1", "former")}
+		})
+
+		It("should restart one member at a time", func() {
+			Expect(operate()).To(Equal(etcdClusterRequeue))
+			Expect(event(utils.EventReasonEtcdMemberRestarted)).To(ContainSubstring("default-etcd-1"))
+
+			err := operator.Client.Get(ctx, client.ObjectKey{Namespace: "steward-system", Name: "default-etcd-1"}, &corev1.Pod{})
+			Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+			Expect(operator.Client.Get(ctx, client.ObjectKey{Namespace: "steward-system", Name: "default-etcd-2"}, &corev1.Pod{})).To(Succeed())
+		})
+
+		When("a member is restarting", func() {
+			BeforeEach(func() {
+				restarting := member("default-etcd-0", "current")
+				restarting.SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+				restarting.SetFinalizers([]string{"steward.butlerlabs.dev/test"})
+				pods[1] = restarting
+			})
+
+			It("should wait for the restarting member", func() {
+				Expect(operate()).To(Equal(etcdClusterRequeue))
+				Expect(recorder.Events).To(BeEmpty())
+				Expect(operator.Client.Get(ctx, client.ObjectKey{Namespace: "steward-system", Name: "default-etcd-1"}, &corev1.Pod{})).To(Succeed())
+			})
+		})
+	})
+
+	It("should announce a member at a time when scaling up", func() {
+		ds.Spec.Managed.Replicas = 3
+
+		Expect(operate()).To(Equal(etcdClusterRequeue))
+		Expect(status.Members).To(Equal(int32(2)))
+		Expect(event(utils.EventReasonEtcdMemberAdded)).To(ContainSubstring("default-etcd-1"))
+
+		// The quorum is lost until the announced member joins, thus the local member list is checked.
+		members, err := etcdClient.MemberList(ctx, etcdclient.WithSerializable())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(members.Members).To(HaveLen(2))
+		Expect(members.Members).To(ContainElement(HaveField("PeerURLs", ConsistOf("https://default-etcd-1.default-etcd.steward-system.svc:2380"))))
+	})
+
+	It("should remove the last member when scaling down", func() {
+		status.Members = 2
+
+		Expect(operate()).To(Equal(etcdClusterRequeue))
+		Expect(status.Members).To(Equal(int32(1)))
+		Expect(event(utils.EventReasonEtcdMemberRemoved)).To(ContainSubstring("default-etcd-1"))
+	})
+
+	Describe("defragmentation", func() {
+		BeforeEach(func() {
+			ds.Spec.Managed.DefragmentationInterval = metav1.Duration{Duration: time.Hour}
+		})
+
+		It("should wait for the interval since the last defragmentation", func() {
+			status.LastDefragmentation = &metav1.Time{Time: time.Now().Add(-15 * time.Minute)}
+
+			Expect(operate()).To(BeNumerically("~", 45*time.Minute, time.Minute))
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		It("should defragment the members once the interval elapsed", func() {
+			last := time.Now().Add(-2 * time.Hour)
+			status.LastDefragmentation = &metav1.Time{Time: last}
+
+			Expect(operate()).To(Equal(time.Hour))
+			Expect(status.LastDefragmentation.Time).To(BeTemporally(">", last))
+			event(utils.EventReasonEtcdDefragmented)
+		})
+
+		It("should not defragment when disabled", func() {
+			ds.Spec.Managed.DefragmentationInterval = metav1.Duration{}
+			status.LastDefragmentation = &metav1.Time{Time: time.Now().Add(-48 * time.Hour)}
+
+			Expect(operate()).To(BeZero())
+			Expect(recorder.Events).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,336 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/ptr"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/controllers/utils"
+	"github.com/butlerdotdev/steward/internal/hibernation"
+	"github.com/butlerdotdev/steward/internal/utilities"
+)
+
+// idleCheckInterval is the maximum interval between two observations of the Tenant Control Plane activity.
+const idleCheckInterval = 5 * time.Minute
+
+// Hibernation puts the Tenant Control Planes to sleep, and wakes them up, according to their hibernation spec:
+// sleeping is achieved by scaling the replicas to zero, relying on the Sleeping status of the Tenant Control Plane.
+type Hibernation struct {
+	Client client.Client
+	// APIReader is used to retrieve the Tenant Control Plane Pods without caching all the Pods of the management cluster.
+	APIReader client.Reader
+
+	recorder record.EventRecorder
+	// requests contains the last observed amount of the requests dispatched by each Tenant Control Plane Pod.
+	requests     map[k8stypes.NamespacedName]map[k8stypes.UID]float64
+	requestsLock sync.Mutex
+}
+
+//+kubebuilder:rbac:groups=core,resources=pods,verbs=get;list
+
+func (r *Hibernation) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	logger := log.FromContext(ctx)
+
+	var tcp stewardv1alpha1.TenantControlPlane
+	if err := r.Client.Get(ctx, request.NamespacedName, &tcp); err != nil {
+		if k8serrors.IsNotFound(err) {
+			logger.Info("resource may have been deleted, skipping")
+			r.forget(request.NamespacedName)
+
+			return reconcile.Result{}, nil
+		}
+
+		logger.Error(err, "cannot retrieve the required resource")
+
+		return reconcile.Result{}, err
+	}
+
+	if utils.IsPaused(&tcp) || tcp.GetDeletionTimestamp() != nil {
+		return reconcile.Result{}, nil
+	}
+
+	now := time.Now()
+	status := tcp.Status.Hibernation.DeepCopy()
+	replicas := ptr.Deref(tcp.Spec.ControlPlane.Deployment.Replicas, 2)
+
+	var desiredReplicas *int32
+	var requeueAfter time.Duration
+
+	switch {
+	case tcp.Spec.Hibernation == nil:
+		// Removing the hibernation wakes up the Tenant Control Plane it put to sleep.
+		if status.Hibernated && replicas == 0 {
+			desiredReplicas = status.Replicas
+		}
+
+		r.forget(request.NamespacedName)
+		status = &stewardv1alpha1.HibernationStatus{}
+	case status.Hibernated && replicas > 0:
+		// The Tenant Control Plane has been woken up manually: the schedules will put it to sleep at the next window.
+		logger.Info("Tenant Control Plane has been woken up out of the hibernation schedules")
+
+		r.transition(status, false, "", now)
+		status.LastActivityTime = &metav1.Time{Time: now}
+	default:
+		var err error
+
+		if desiredReplicas, requeueAfter, err = r.evaluate(ctx, &tcp, status, now); err != nil {
+			logger.Error(err, "cannot evaluate the hibernation")
+
+			return reconcile.Result{}, err
+		}
+	}
+
+	// Tracking the replicas of the awake Tenant Control Plane allows restoring them exactly,
+	// even when it has been scaled to zero out of the hibernation, as the activator does.
+	if tcp.Spec.Hibernation != nil && replicas > 0 {
+		status.Replicas = ptr.To(replicas)
+	}
+
+	if err := r.updateStatus(ctx, &tcp, *status); err != nil {
+		logger.Error(err, "cannot update the hibernation status")
+
+		return reconcile.Result{}, err
+	}
+
+	if desiredReplicas != nil && *desiredReplicas != replicas {
+		patch := client.MergeFrom(tcp.DeepCopy())
+		tcp.Spec.ControlPlane.Deployment.Replicas = desiredReplicas
+
+		if err := r.Client.Patch(ctx, &tcp, patch); err != nil {
+			logger.Error(err, "cannot scale the Tenant Control Plane")
+
+			return reconcile.Result{}, err
+		}
+
+		reason, message := utils.EventReasonHibernated, fmt.Sprintf("the Tenant Control Plane has been put to sleep, reason: %s", status.Reason)
+		if *desiredReplicas > 0 {
+			reason, message = utils.EventReasonWokenUp, fmt.Sprintf("the Tenant Control Plane has been woken up with %d replicas", *desiredReplicas)
+		}
+
+		logger.Info(message)
+		r.recorder.Event(&tcp, corev1.EventTypeNormal, reason, message)
+	}
+
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// evaluate applies the schedules and the idle detection to the hibernation status,
+// returning the desired replicas if the Tenant Control Plane must be scaled, and when to evaluate it again.
+func (r *Hibernation) evaluate(ctx context.Context, tcp *stewardv1alpha1.TenantControlPlane, status *stewardv1alpha1.HibernationStatus, now time.Time) (*int32, time.Duration, error) {
+	spec, replicas := tcp.Spec.Hibernation, ptr.Deref(tcp.Spec.ControlPlane.Deployment.Replicas, 2)
+
+	var requeueAfter time.Duration
+
+	if len(spec.Schedules) > 0 {
+		schedules := make([]*hibernation.Schedule, 0, len(spec.Schedules))
+
+		for _, item := range spec.Schedules {
+			schedule, err := hibernation.ParseSchedule(item)
+			if err != nil {
+				return nil, 0, errors.Wrap(err, "cannot parse the hibernation schedule")
+			}
+
+			schedules = append(schedules, schedule)
+		}
+		// The first evaluation is used as the baseline: the schedules act only upon the window boundaries.
+		from := now
+		if status.LastScheduleTime != nil {
+			from = status.LastScheduleTime.Time
+		}
+
+		action, next := hibernation.Evaluate(schedules, from, now)
+		status.LastScheduleTime, requeueAfter = &metav1.Time{Time: now}, next.Sub(now)
+
+		switch {
+		case action == hibernation.ActionSleep && replicas > 0:
+			return r.sleep(status, replicas, stewardv1alpha1.HibernationReasonSchedule, now), requeueAfter, nil
+		case action == hibernation.ActionWake && status.Hibernated:
+			r.transition(status, false, "", now)
+			status.LastActivityTime = &metav1.Time{Time: now}
+
+			return status.Replicas, requeueAfter, nil
+		}
+	}
+
+	if spec.Idle == nil || replicas == 0 {
+		return nil, requeueAfter, nil
+	}
+
+	if requeueAfter == 0 || requeueAfter > idleCheckInterval {
+		requeueAfter = idleCheckInterval
+	}
+
+	// A Tenant Control Plane which is not ready, such as the one being woken up, cannot be idle.
+	if ptr.Deref(tcp.Status.Kubernetes.Version.Status, stewardv1alpha1.VersionUnknown) != stewardv1alpha1.VersionReady {
+		status.LastActivityTime = &metav1.Time{Time: now}
+
+		return nil, requeueAfter, nil
+	}
+
+	active, err := r.observeActivity(ctx, tcp, sets.New(spec.Idle.FlowSchemas...))
+	if err != nil {
+		log.FromContext(ctx).Error(err, "cannot observe the Tenant Control Plane activity")
+
+		return nil, requeueAfter, nil
+	}
+
+	if active || status.LastActivityTime == nil {
+		status.LastActivityTime = &metav1.Time{Time: now}
+	}
+
+	if now.Sub(status.LastActivityTime.Time) < spec.Idle.After.Duration {
+		return nil, requeueAfter, nil
+	}
+
+	return r.sleep(status, replicas, stewardv1alpha1.HibernationReasonIdle, now), requeueAfter, nil
+}
+
+func (r *Hibernation) sleep(status *stewardv1alpha1.HibernationStatus, replicas int32, reason stewardv1alpha1.HibernationReason, now time.Time) *int32 {
+	r.transition(status, true, reason, now)
+	status.Replicas = ptr.To(replicas)
+
+	return ptr.To(int32(0))
+}
+
+func (r *Hibernation) transition(status *stewardv1alpha1.HibernationStatus, hibernated bool, reason stewardv1alpha1.HibernationReason, now time.Time) {
+	status.Hibernated, status.Reason, status.LastTransitionTime = hibernated, reason, &metav1.Time{Time: now}
+}
+
+// observeActivity returns true if any Tenant Control Plane Pod dispatched new requests for the given FlowSchemas:
+// the metrics are scraped from each Pod since the counters are not shared across the API Server instances.
+func (r *Hibernation) observeActivity(ctx context.Context, tcp *stewardv1alpha1.TenantControlPlane, flowSchemas sets.Set[string]) (bool, error) {
+	selector, err := labels.Parse(tcp.Status.Kubernetes.Deployment.Selector)
+	if err != nil {
+		return false, errors.Wrap(err, "cannot parse the Tenant Control Plane Pods selector")
+	}
+
+	var pods corev1.PodList
+	if err = r.APIReader.List(ctx, &pods, client.InNamespace(tcp.GetNamespace()), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return false, errors.Wrap(err, "cannot list the Tenant Control Plane Pods")
+	}
+
+	config, err := utilities.GetRESTClientConfig(ctx, r.Client, tcp)
+	if err != nil {
+		return false, errors.Wrap(err, "cannot generate the Tenant Control Plane client configuration")
+	}
+	// The API Server certificate is valid for the Service name used by the default configuration.
+	config.ServerName = fmt.Sprintf("%s.%s.svc", tcp.GetName(), tcp.GetNamespace())
+
+	key := k8stypes.NamespacedName{Namespace: tcp.GetNamespace(), Name: tcp.GetName()}
+	current := make(map[k8stypes.UID]float64, len(pods.Items))
+
+	var active bool
+
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodRunning || pod.Status.PodIP == "" {
+			continue
+		}
+
+		podConfig := *config
+		podConfig.Host = fmt.Sprintf("https://%s:%d", pod.Status.PodIP, tcp.Spec.NetworkProfile.Port)
+
+		clientSet, csErr := clientset.NewForConfig(&podConfig)
+		if csErr != nil {
+			return false, errors.Wrap(csErr, "cannot create the Tenant Control Plane client")
+		}
+
+		metrics, mErr := clientSet.RESTClient().Get().AbsPath("/metrics").DoRaw(ctx)
+		if mErr != nil {
+			return false, errors.Wrapf(mErr, "cannot retrieve the metrics of the Pod %s", pod.GetName())
+		}
+
+		count, cErr := hibernation.CountRequests(bytes.NewReader(metrics), flowSchemas)
+		if cErr != nil {
+			return false, cErr
+		}
+
+		current[pod.GetUID()] = count
+		// Pods with no previous observation are used as baseline.
+		if previous, ok := r.previousRequests(key, pod.GetUID()); ok && count != previous {
+			active = true
+		}
+	}
+
+	r.requestsLock.Lock()
+	r.requests[key] = current
+	r.requestsLock.Unlock()
+
+	return active, nil
+}
+
+func (r *Hibernation) previousRequests(key k8stypes.NamespacedName, uid k8stypes.UID) (float64, bool) {
+	r.requestsLock.Lock()
+	defer r.requestsLock.Unlock()
+
+	count, ok := r.requests[key][uid]
+
+	return count, ok
+}
+
+func (r *Hibernation) forget(key k8stypes.NamespacedName) {
+	r.requestsLock.Lock()
+	defer r.requestsLock.Unlock()
+
+	delete(r.requests, key)
+}
+
+func (r *Hibernation) updateStatus(ctx context.Context, tcp *stewardv1alpha1.TenantControlPlane, status stewardv1alpha1.HibernationStatus) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() (err error) {
+		defer func() {
+			if err != nil {
+				_ = r.Client.Get(ctx, k8stypes.NamespacedName{Name: tcp.Name, Namespace: tcp.Namespace}, tcp)
+			}
+		}()
+
+		if equality.Semantic.DeepEqual(tcp.Status.Hibernation, status) {
+			return nil
+		}
+
+		tcp.Status.Hibernation = status
+
+		return r.Client.Status().Update(ctx, tcp)
+	})
+}
+
+func (r *Hibernation) SetupWithManager(mgr controllerruntime.Manager) error {
+	r.recorder = mgr.GetEventRecorderFor("hibernation-controller")
+	r.requests = make(map[k8stypes.NamespacedName]map[k8stypes.UID]float64)
+
+	return controllerruntime.NewControllerManagedBy(mgr).
+		Named("hibernation").
+		For(&stewardv1alpha1.TenantControlPlane{}, builder.WithPredicates(
+			predicate.GenerationChangedPredicate{},
+			predicate.NewPredicateFuncs(func(object client.Object) bool {
+				tcp := object.(*stewardv1alpha1.TenantControlPlane) //nolint:forcetypeassert
+
+				return tcp.Spec.Hibernation != nil || tcp.Status.Hibernation.Hibernated || tcp.Status.Hibernation.LastScheduleTime != nil || tcp.Status.Hibernation.LastActivityTime != nil
+			}),
+		)).
+		Complete(r)
+}
@@ -41,6 +41,7 @@ type GroupResourceBuilderConfiguration struct {
 	StewardService                string
 	StewardMigrateImage           string
 	DiscoveryClient               discovery.DiscoveryInterface
+	activatorPorts                *resources.ActivatorPorts
 }
 
 type GroupDeletableResourceBuilderConfiguration struct {
@@ -59,13 +60,18 @@ func GetResources(ctx context.Context, config GroupResourceBuilderConfiguration)
 	resources := []resources.Resource{}
 
 	resources = append(resources, getDataStoreMigratingResources(config.client, config.StewardNamespace, config.StewardMigrateImage, config.StewardServiceAccount, config.StewardService)...)
+	resources = append(resources, getDataStoreRestoringResources(config.client, config.StewardNamespace, config.StewardMigrateImage, config.StewardServiceAccount)...)
 	resources = append(resources, getUpgradeResources(config.client)...)
-	resources = append(resources, getKubernetesServiceResources(config.client)...)
+	resources = append(resources, getKubernetesServiceResources(config.client, config.tcpReconcilerConfig, config.StewardNamespace, config.activatorPorts)...)
 	resources = append(resources, getKubeadmConfigResources(config.client, getTmpDirectory(config.tcpReconcilerConfig.TmpBaseDirectory, config.tenantControlPlane), config.DataStore)...)
 	resources = append(resources, getKubernetesCertificatesResources(config.client, config.tcpReconcilerConfig, config.tenantControlPlane)...)
 	resources = append(resources, getKubeconfigResources(config.client, config.tcpReconcilerConfig, config.tenantControlPlane)...)
 	resources = append(resources, getKubernetesStorageResources(config.client, config.Connection, config.DataStore, config.ExpirationThreshold)...)
 	resources = append(resources, getKubernetesAdditionalStorageResources(config.client, config.DataStoreOverriedsConnections, config.DataStoreOverrides, config.ExpirationThreshold)...)
+	resources = append(resources, getEncryptionConfigurationResources(config.client)...)
+	resources = append(resources, getAuditPolicyResources(config.client)...)
+	resources = append(resources, getAuthenticationConfigurationResources(config.client)...)
+	resources = append(resources, getAuthorizationConfigurationResources(config.client)...)
 	resources = append(resources, getKonnectivityServerRequirementsResources(config.client, config.ExpirationThreshold)...)
 	// Worker bootstrap pre-deployment: credentials Secret must exist before Deployment creates trustd sidecar (volume mount)
 	resources = append(resources, workerbootstrap.GetPreDeploymentResources(config.tenantControlPlane.Spec.Addons.WorkerBootstrap, config.client)...)
@@ -75,6 +81,11 @@ func GetResources(ctx context.Context, config GroupResourceBuilderConfiguration)
 	resources = append(resources, workerbootstrap.GetPostDeploymentResources(config.tenantControlPlane.Spec.Addons.WorkerBootstrap, config.client, &config.tenantControlPlane)...)
 	resources = append(resources, getDataStoreMigratingCleanup(config.client, config.StewardNamespace)...)
 	resources = append(resources, getKubernetesIngressResources(config.client, &config.tenantControlPlane)...)
+	// The dedicated etcd cluster is operated by its own controller: its failures are reported once the other resources
+	// have been reconciled, since a failed operation, such as a defragmentation, doesn't prevent serving the tenant.
+	if config.tenantControlPlane.Spec.DataStoreMode == stewardv1alpha1.DataStoreModeDedicated {
+		resources = append(resources, &ds.DedicatedEtcd{Client: config.client})
+	}
 
 	// Conditionally add Gateway resources
 	if utilities.AreGatewayResourcesAvailable(ctx, config.client, config.DiscoveryClient) {
@@ -104,6 +115,12 @@ func GetDeletableResources(tcp *stewardv1alpha1.TenantControlPlane, config Group
 			DataStore:  config.dataStore,
 		})
 	}
+	// The dedicated etcd cluster is removed once the Tenant Control Plane data has been cleaned up.
+	if tcp.Spec.DataStoreMode == stewardv1alpha1.DataStoreModeDedicated || tcp.Status.Storage.DataStoreName == stewardv1alpha1.DedicatedDataStoreName {
+		res = append(res, &ds.DedicatedEtcd{
+			Client: config.client,
+		})
+	}
 
 	return res
 }
@@ -130,6 +147,17 @@ func getDataStoreMigratingResources(c client.Client, stewardNamespace, migrateIm
 	}
 }
 
+func getDataStoreRestoringResources(c client.Client, stewardNamespace, restoreImage, stewardServiceAccount string) []resources.Resource {
+	return []resources.Resource{
+		&ds.Restore{
+			Client:                c,
+			RestoreImage:          restoreImage,
+			StewardNamespace:      stewardNamespace,
+			StewardServiceAccount: stewardServiceAccount,
+		},
+	}
+}
+
 func getUpgradeResources(c client.Client) []resources.Resource {
 	return []resources.Resource{
 		&resources.KubernetesUpgrade{
@@ -138,8 +166,14 @@ func getUpgradeResources(c client.Client) []resources.Resource {
 	}
 }
 
-func getKubernetesServiceResources(c client.Client) []resources.Resource {
+func getKubernetesServiceResources(c client.Client, config TenantControlPlaneReconcilerConfig, stewardNamespace string, activatorPorts *resources.ActivatorPorts) []resources.Resource {
 	return []resources.Resource{
+		&resources.KubernetesActivatorResource{
+			Client:    c,
+			Namespace: stewardNamespace,
+			Service:   config.ActivatorService,
+			Ports:     activatorPorts,
+		},
 		&resources.KubernetesServiceResource{
 			Client: c,
 		},
@@ -268,6 +302,10 @@ func getKubernetesStorageResources(c client.Client, dbConnection datastore.Conne
 			DataStore:               datastore,
 			CertExpirationThreshold: threshold,
 		},
+		&ds.SQLiteVolume{
+			Client:    c,
+			DataStore: datastore,
+		},
 	}
 }
 
@@ -300,6 +338,38 @@ func getKubernetesAdditionalStorageResources(c client.Client, dbConnections map[
 	return res
 }
 
+func getEncryptionConfigurationResources(c client.Client) []resources.Resource {
+	return []resources.Resource{
+		&resources.EncryptionConfiguration{
+			Client: c,
+		},
+	}
+}
+
+func getAuditPolicyResources(c client.Client) []resources.Resource {
+	return []resources.Resource{
+		&resources.AuditPolicy{
+			Client: c,
+		},
+	}
+}
+
+func getAuthenticationConfigurationResources(c client.Client) []resources.Resource {
+	return []resources.Resource{
+		&resources.AuthenticationConfiguration{
+			Client: c,
+		},
+	}
+}
+
+func getAuthorizationConfigurationResources(c client.Client) []resources.Resource {
+	return []resources.Resource{
+		&resources.AuthorizationConfiguration{
+			Client: c,
+		},
+	}
+}
+
 func getKubernetesDeploymentResources(c client.Client, tcpReconcilerConfig TenantControlPlaneReconcilerConfig, dataStore stewardv1alpha1.DataStore, dataStoreOverrides []builder.DataStoreOverrides) []resources.Resource {
 	return []resources.Resource{
 		&resources.KubernetesDeploymentResource{
@@ -11,6 +11,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -36,6 +37,7 @@ type CoreDNS struct {
 	AdminClient               client.Client
 	GetTenantControlPlaneFunc utils.TenantControlPlaneRetrievalFn
 	TriggerChannel            chan event.GenericEvent
+	EventRecorder             record.EventRecorder
 	ControllerName            string
 }
 
@@ -58,6 +60,7 @@ func (c *CoreDNS) Reconcile(ctx context.Context, _ reconcile.Request) (reconcile
 	result, handlingErr := resources.Handle(ctx, resource, tcp)
 	if handlingErr != nil {
 		c.Logger.Error(handlingErr, "resource process failed", "resource", resource.GetName())
+		utils.RecordFailureEvent(c.EventRecorder, tcp, resource, handlingErr)
 
 		return reconcile.Result{}, handlingErr
 	}
@@ -70,10 +73,13 @@ func (c *CoreDNS) Reconcile(ctx context.Context, _ reconcile.Request) (reconcile
 
 	if err = utils.UpdateStatus(ctx, c.AdminClient, tcp, resource); err != nil {
 		c.Logger.Error(err, "update status failed", "resource", resource.GetName())
+		utils.RecordFailureEvent(c.EventRecorder, tcp, resource, err)
 
 		return reconcile.Result{}, err
 	}
 
+	utils.RecordResourceEvent(c.EventRecorder, tcp, resource, result)
+
 	c.Logger.Info("reconciliation processed")
 
 	return reconcile.Result{}, nil
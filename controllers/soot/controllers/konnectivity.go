@@ -12,6 +12,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -37,6 +38,7 @@ type KonnectivityAgent struct {
 	AdminClient               client.Client
 	GetTenantControlPlaneFunc utils.TenantControlPlaneRetrievalFn
 	TriggerChannel            chan event.GenericEvent
+	EventRecorder             record.EventRecorder
 	ControllerName            string
 }
 
@@ -64,6 +66,7 @@ func (k *KonnectivityAgent) Reconcile(ctx context.Context, _ reconcile.Request)
 		result, handlingErr := resources.Handle(ctx, resource, tcp)
 		if handlingErr != nil {
 			k.Logger.Error(handlingErr, "resource process failed", "resource", resource.GetName())
+			utils.RecordFailureEvent(k.EventRecorder, tcp, resource, handlingErr)
 
 			return reconcile.Result{}, handlingErr
 		}
@@ -76,9 +79,12 @@ func (k *KonnectivityAgent) Reconcile(ctx context.Context, _ reconcile.Request)
 
 		if err = utils.UpdateStatus(ctx, k.AdminClient, tcp, resource); err != nil {
 			k.Logger.Error(err, "update status failed", "resource", resource.GetName())
+			utils.RecordFailureEvent(k.EventRecorder, tcp, resource, err)
 
 			return reconcile.Result{}, err
 		}
+
+		utils.RecordResourceEvent(k.EventRecorder, tcp, resource, result)
 	}
 
 	k.Logger.Info("reconciliation completed")
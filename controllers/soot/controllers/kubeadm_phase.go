@@ -8,6 +8,7 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -29,6 +30,7 @@ type KubeadmPhase struct {
 	GetTenantControlPlaneFunc utils.TenantControlPlaneRetrievalFn
 	TriggerChannel            chan event.GenericEvent
 	Phase                     resources.KubeadmPhaseResource
+	EventRecorder             record.EventRecorder
 	ControllerName            string
 
 	logger logr.Logger
@@ -51,6 +53,7 @@ func (k *KubeadmPhase) Reconcile(ctx context.Context, _ reconcile.Request) (reco
 	result, handlingErr := resources.Handle(ctx, k.Phase, tcp)
 	if handlingErr != nil {
 		k.logger.Error(handlingErr, "resource process failed")
+		utils.RecordFailureEvent(k.EventRecorder, tcp, k.Phase, handlingErr)
 
 		return reconcile.Result{}, handlingErr
 	}
@@ -63,10 +66,13 @@ func (k *KubeadmPhase) Reconcile(ctx context.Context, _ reconcile.Request) (reco
 
 	if err = utils.UpdateStatus(ctx, k.Phase.GetClient(), tcp, k.Phase); err != nil {
 		k.logger.Error(err, "update status failed")
+		utils.RecordFailureEvent(k.EventRecorder, tcp, k.Phase, err)
 
 		return reconcile.Result{}, err
 	}
 
+	utils.RecordResourceEvent(k.EventRecorder, tcp, k.Phase, result)
+
 	k.logger.Info("reconciliation processed")
 
 	return reconcile.Result{}, nil
@@ -11,6 +11,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -36,6 +37,7 @@ type KubeProxy struct {
 	AdminClient               client.Client
 	GetTenantControlPlaneFunc utils.TenantControlPlaneRetrievalFn
 	TriggerChannel            chan event.GenericEvent
+	EventRecorder             record.EventRecorder
 	ControllerName            string
 }
 
@@ -60,6 +62,7 @@ func (k *KubeProxy) Reconcile(ctx context.Context, _ reconcile.Request) (reconci
 	result, handlingErr := resources.Handle(ctx, resource, tcp)
 	if handlingErr != nil {
 		k.Logger.Error(handlingErr, "resource process failed", "resource", resource.GetName())
+		utils.RecordFailureEvent(k.EventRecorder, tcp, resource, handlingErr)
 
 		return reconcile.Result{}, handlingErr
 	}
@@ -72,10 +75,13 @@ func (k *KubeProxy) Reconcile(ctx context.Context, _ reconcile.Request) (reconci
 
 	if err = utils.UpdateStatus(ctx, k.AdminClient, tcp, resource); err != nil {
 		k.Logger.Error(err, "update status failed")
+		utils.RecordFailureEvent(k.EventRecorder, tcp, resource, err)
 
 		return reconcile.Result{}, err
 	}
 
+	utils.RecordResourceEvent(k.EventRecorder, tcp, resource, result)
+
 	k.Logger.Info("reconciliation processed")
 
 	return reconcile.Result{}, nil
@@ -31,6 +31,10 @@ import (
 	"github.com/butlerdotdev/steward/internal/utilities"
 )
 
+// FreezeWebhookConfigurationName is the name of the ValidatingWebhookConfiguration blocking the tenant writes
+// while the Tenant Control Plane is migrated, or restored.
+const FreezeWebhookConfigurationName = "steward-freeze"
+
 type Migrate struct {
 	Client                    client.Client
 	Logger                    logr.Logger
@@ -59,7 +63,7 @@ func (m *Migrate) Reconcile(ctx context.Context, _ reconcile.Request) (reconcile
 	}
 
 	switch *tcp.Status.Kubernetes.Version.Status {
-	case v1alpha1.VersionMigrating:
+	case v1alpha1.VersionMigrating, v1alpha1.VersionRestoring:
 		err = m.createOrUpdate(ctx)
 	case v1alpha1.VersionReady:
 		err = m.cleanup(ctx)
@@ -204,7 +208,7 @@ func (m *Migrate) SetupWithManager(mgr manager.Manager) error {
 func (m *Migrate) object() *admissionregistrationv1.ValidatingWebhookConfiguration {
 	return &admissionregistrationv1.ValidatingWebhookConfiguration{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: "steward-freeze",
+			Name: FreezeWebhookConfigurationName,
 		},
 	}
 }
@@ -0,0 +1,93 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	sooterrors "github.com/butlerdotdev/steward/controllers/soot/controllers/errors"
+	"github.com/butlerdotdev/steward/controllers/utils"
+	"github.com/butlerdotdev/steward/internal/resources"
+)
+
+// StorageVersionMigration migrates the tenant cluster objects to their current storage version once an upgrade is completed,
+// or upon request: it's triggered by the Tenant Control Plane changes, and requeued until all the resources are migrated.
+type StorageVersionMigration struct {
+	Logger                    logr.Logger
+	AdminClient               client.Client
+	GetTenantControlPlaneFunc utils.TenantControlPlaneRetrievalFn
+	TriggerChannel            chan event.GenericEvent
+	EventRecorder             record.EventRecorder
+	ControllerName            string
+}
+
+func (s *StorageVersionMigration) Reconcile(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+	tcp, err := s.GetTenantControlPlaneFunc()
+	if err != nil {
+		if errors.Is(err, sooterrors.ErrPausedReconciliation) {
+			s.Logger.Info(err.Error())
+
+			return reconcile.Result{}, nil
+		}
+
+		s.Logger.Error(err, "cannot retrieve TenantControlPlane")
+
+		return reconcile.Result{}, err
+	}
+
+	resource := &resources.StorageVersionMigration{Client: s.AdminClient}
+
+	result, handlingErr := resources.Handle(ctx, resource, tcp)
+	if handlingErr != nil {
+		s.Logger.Error(handlingErr, "resource process failed", "resource", resource.GetName())
+		utils.RecordFailureEvent(s.EventRecorder, tcp, resource, handlingErr)
+
+		return reconcile.Result{}, handlingErr
+	}
+
+	if result == controllerutil.OperationResultNone {
+		return reconcile.Result{}, nil
+	}
+
+	if err = utils.UpdateStatus(ctx, s.AdminClient, tcp, resource); err != nil {
+		s.Logger.Error(err, "update status failed")
+		utils.RecordFailureEvent(s.EventRecorder, tcp, resource, err)
+
+		return reconcile.Result{}, err
+	}
+
+	utils.RecordResourceEvent(s.EventRecorder, tcp, resource, result)
+
+	if migration := tcp.Status.StorageVersionMigration; migration != nil && migration.Phase == stewardv1alpha1.StorageVersionMigrationPhaseMigrating {
+		s.Logger.Info("storage version migration in progress", "migrated", len(migration.MigratedResources), "resources", len(migration.Resources))
+
+		return reconcile.Result{RequeueAfter: time.Second}, nil
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (s *StorageVersionMigration) SetupWithManager(mgr manager.Manager) error {
+	return controllerruntime.NewControllerManagedBy(mgr).
+		Named(s.ControllerName).
+		WithOptions(controller.TypedOptions[reconcile.Request]{SkipNameValidation: ptr.To(true)}).
+		WatchesRawSource(source.Channel(s.TriggerChannel, &handler.EnqueueRequestForObject{})).
+		Complete(s)
+}
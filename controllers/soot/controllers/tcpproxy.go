@@ -12,6 +12,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -40,6 +41,7 @@ type TCPProxy struct {
 	AdminClient               client.Client
 	GetTenantControlPlaneFunc utils.TenantControlPlaneRetrievalFn
 	TriggerChannel            chan event.GenericEvent
+	EventRecorder             record.EventRecorder
 	ControllerName            string
 }
 
@@ -70,6 +72,7 @@ func (t *TCPProxy) Reconcile(ctx context.Context, _ reconcile.Request) (reconcil
 		result, handlingErr := resources.Handle(ctx, resource, tcp)
 		if handlingErr != nil {
 			t.Logger.Error(handlingErr, "resource process failed", "resource", resource.GetName())
+			utils.RecordFailureEvent(t.EventRecorder, tcp, resource, handlingErr)
 
 			return reconcile.Result{}, handlingErr
 		}
@@ -82,9 +85,12 @@ func (t *TCPProxy) Reconcile(ctx context.Context, _ reconcile.Request) (reconcil
 
 		if err = utils.UpdateStatus(ctx, t.AdminClient, tcp, resource); err != nil {
 			t.Logger.Error(err, "update status failed", "resource", resource.GetName())
+			utils.RecordFailureEvent(t.EventRecorder, tcp, resource, err)
 
 			return reconcile.Result{}, err
 		}
+
+		utils.RecordResourceEvent(t.EventRecorder, tcp, resource, result)
 	}
 
 	t.Logger.Info("reconciliation completed")
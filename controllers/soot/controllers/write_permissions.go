@@ -58,10 +58,12 @@ func (r *WritePermissions) Reconcile(ctx context.Context, _ reconcile.Request) (
 		return reconcile.Result{RequeueAfter: time.Second}, nil
 	}
 
+	permissions := tcp.GetWritePermissions()
+
 	switch {
 	case ptr.Deref(tcp.Status.Kubernetes.Version.Status, stewardv1alpha1.VersionUnknown) == stewardv1alpha1.VersionWriteLimited &&
-		tcp.Spec.WritePermissions.HasAnyLimitation():
-		err = r.createOrUpdate(ctx, tcp.Spec.WritePermissions)
+		permissions.HasAnyLimitation():
+		err = r.createOrUpdate(ctx, permissions)
 	default:
 		err = r.cleanup(ctx)
 	}
@@ -10,6 +10,7 @@ import (
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
 	"k8s.io/utils/ptr"
 	controllerruntime "sigs.k8s.io/controller-runtime"
@@ -58,6 +59,9 @@ type Manager struct {
 	MigrateServiceName      string
 	MigrateServiceNamespace string
 	AdminClient             client.Client
+	// EventRecorder emits the Events on the Tenant Control Plane in the admin cluster,
+	// since the soot managers are only able to record them in the tenant cluster.
+	EventRecorder record.EventRecorder
 }
 
 // retrieveTenantControlPlane is the function used to let an underlying controller of the soot manager
@@ -287,6 +291,7 @@ func (m *Manager) Reconcile(ctx context.Context, request reconcile.Request) (res
 		AdminClient:               m.AdminClient,
 		GetTenantControlPlaneFunc: m.retrieveTenantControlPlane(tcpCtx, request),
 		Logger:                    mgr.GetLogger().WithName("konnectivity_agent"),
+		EventRecorder:             m.EventRecorder,
 		TriggerChannel:            make(chan event.GenericEvent),
 		ControllerName:            fmt.Sprintf("%s-konnectivity", controllerNamePrefix),
 	}
@@ -298,6 +303,7 @@ func (m *Manager) Reconcile(ctx context.Context, request reconcile.Request) (res
 		AdminClient:               m.AdminClient,
 		GetTenantControlPlaneFunc: m.retrieveTenantControlPlane(tcpCtx, request),
 		Logger:                    mgr.GetLogger().WithName("tcp_proxy"),
+		EventRecorder:             m.EventRecorder,
 		TriggerChannel:            make(chan event.GenericEvent),
 		ControllerName:            fmt.Sprintf("%s-tcpproxy", controllerNamePrefix),
 	}
@@ -309,6 +315,7 @@ func (m *Manager) Reconcile(ctx context.Context, request reconcile.Request) (res
 		AdminClient:               m.AdminClient,
 		GetTenantControlPlaneFunc: m.retrieveTenantControlPlane(tcpCtx, request),
 		Logger:                    mgr.GetLogger().WithName("kube_proxy"),
+		EventRecorder:             m.EventRecorder,
 		TriggerChannel:            make(chan event.GenericEvent),
 		ControllerName:            fmt.Sprintf("%s-kubeproxy", controllerNamePrefix),
 	}
@@ -320,6 +327,7 @@ func (m *Manager) Reconcile(ctx context.Context, request reconcile.Request) (res
 		AdminClient:               m.AdminClient,
 		GetTenantControlPlaneFunc: m.retrieveTenantControlPlane(tcpCtx, request),
 		Logger:                    mgr.GetLogger().WithName("coredns"),
+		EventRecorder:             m.EventRecorder,
 		TriggerChannel:            make(chan event.GenericEvent),
 		ControllerName:            fmt.Sprintf("%s-coredns", controllerNamePrefix),
 	}
@@ -333,6 +341,7 @@ func (m *Manager) Reconcile(ctx context.Context, request reconcile.Request) (res
 			Client: m.AdminClient,
 			Phase:  resources.PhaseUploadConfigKubeadm,
 		},
+		EventRecorder:  m.EventRecorder,
 		TriggerChannel: make(chan event.GenericEvent),
 		ControllerName: fmt.Sprintf("%s-kubeadmconfig", controllerNamePrefix),
 	}
@@ -346,6 +355,7 @@ func (m *Manager) Reconcile(ctx context.Context, request reconcile.Request) (res
 			Client: m.AdminClient,
 			Phase:  resources.PhaseUploadConfigKubelet,
 		},
+		EventRecorder:  m.EventRecorder,
 		TriggerChannel: make(chan event.GenericEvent),
 		ControllerName: fmt.Sprintf("%s-kubeletconfig", controllerNamePrefix),
 	}
@@ -359,6 +369,7 @@ func (m *Manager) Reconcile(ctx context.Context, request reconcile.Request) (res
 			Client: m.AdminClient,
 			Phase:  resources.PhaseBootstrapToken,
 		},
+		EventRecorder:  m.EventRecorder,
 		TriggerChannel: make(chan event.GenericEvent),
 		ControllerName: fmt.Sprintf("%s-bootstraptoken", controllerNamePrefix),
 	}
@@ -372,6 +383,7 @@ func (m *Manager) Reconcile(ctx context.Context, request reconcile.Request) (res
 			Client: m.AdminClient,
 			Phase:  resources.PhaseClusterAdminRBAC,
 		},
+		EventRecorder:  m.EventRecorder,
 		TriggerChannel: make(chan event.GenericEvent),
 		ControllerName: fmt.Sprintf("%s-kubeadmrbac", controllerNamePrefix),
 	}
@@ -399,6 +411,18 @@ func (m *Manager) Reconcile(ctx context.Context, request reconcile.Request) (res
 		return reconcile.Result{}, err
 	}
 
+	storageVersionMigration := &controllers.StorageVersionMigration{
+		AdminClient:               m.AdminClient,
+		GetTenantControlPlaneFunc: m.retrieveTenantControlPlane(tcpCtx, request),
+		Logger:                    mgr.GetLogger().WithName("storage_version_migration"),
+		EventRecorder:             m.EventRecorder,
+		TriggerChannel:            make(chan event.GenericEvent),
+		ControllerName:            fmt.Sprintf("%s-storagemigration", controllerNamePrefix),
+	}
+	if err = storageVersionMigration.SetupWithManager(mgr); err != nil {
+		return reconcile.Result{}, err
+	}
+
 	completedCh := make(chan struct{})
 	// Starting the manager
 	go func() {
@@ -438,6 +462,7 @@ func (m *Manager) Reconcile(ctx context.Context, request reconcile.Request) (res
 			bootstrapToken.TriggerChannel,
 			csrApproval.TriggerChannel,
 			workerRBAC.TriggerChannel,
+			storageVersionMigration.TriggerChannel,
 		},
 		cancelFn:    tcpCancelFn,
 		completedCh: completedCh,
@@ -0,0 +1,162 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/controllers/utils"
+	"github.com/butlerdotdev/steward/internal/datastore"
+)
+
+// StorageUsage measures the storage used by the Tenant Control Planes in their DataStore, enforcing the storage quota:
+// the writes are blocked by the effective write permissions of the Tenant Control Plane, leaving the declared ones untouched.
+type StorageUsage struct {
+	Client client.Client
+	// Interval is the interval between two measurements of the Tenant Control Plane usage.
+	Interval time.Duration
+	// Timeout bounds the duration of the measurement.
+	Timeout time.Duration
+
+	recorder record.EventRecorder
+	// measureFn retrieves the Tenant Control Plane usage, defaulting to the one of its DataStore.
+	measureFn func(ctx context.Context, tcp stewardv1alpha1.TenantControlPlane) (*datastore.Usage, error)
+}
+
+func (r *StorageUsage) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	logger := log.FromContext(ctx)
+
+	var tcp stewardv1alpha1.TenantControlPlane
+	if err := r.Client.Get(ctx, request.NamespacedName, &tcp); err != nil {
+		if k8serrors.IsNotFound(err) {
+			logger.Info("resource may have been deleted, skipping")
+
+			return reconcile.Result{}, nil
+		}
+
+		logger.Error(err, "cannot retrieve the required resource")
+
+		return reconcile.Result{}, err
+	}
+
+	if utils.IsPaused(&tcp) || tcp.GetDeletionTimestamp() != nil {
+		return reconcile.Result{}, nil
+	}
+	// The storage has not been set up yet.
+	if tcp.Status.Storage.DataStoreName == "" || tcp.Status.Storage.Setup.Schema == "" {
+		return reconcile.Result{RequeueAfter: r.Interval}, nil
+	}
+	// The embedded SQLite database cannot be reached.
+	if tcp.Status.Storage.Driver == string(stewardv1alpha1.KineSQLiteDriver) {
+		return reconcile.Result{}, nil
+	}
+
+	now := metav1.Now()
+	status := tcp.Status.Storage.Usage.DeepCopy()
+	requeueAfter := r.Interval
+	// The quota could have been changed in the meanwhile: the latest measurement is evaluated again, if recent.
+	if status == nil || now.Sub(status.LastUpdate.Time) >= r.Interval {
+		usage, err := r.measureFn(ctx, tcp)
+		if err != nil {
+			logger.Error(err, "cannot measure the storage usage")
+
+			return reconcile.Result{}, err
+		}
+
+		status = &stewardv1alpha1.DataStoreUsageStatus{
+			Keys:       usage.Keys,
+			Size:       *resource.NewQuantity(usage.Bytes, resource.BinarySI),
+			LastUpdate: now,
+		}
+	} else {
+		requeueAfter -= now.Sub(status.LastUpdate.Time)
+	}
+
+	exceeded := tcp.Spec.StorageQuota != nil && status.Size.Cmp(*tcp.Spec.StorageQuota) > 0
+	changed := exceeded != (tcp.Status.Storage.Usage != nil && tcp.Status.Storage.Usage.QuotaExceeded)
+	status.QuotaExceeded = exceeded
+
+	if err := r.updateStatus(ctx, &tcp, status); err != nil {
+		logger.Error(err, "cannot update the storage usage status")
+
+		return reconcile.Result{}, err
+	}
+
+	if changed {
+		eventType, reason, message := corev1.EventTypeWarning, utils.EventReasonStorageQuotaExceeded, fmt.Sprintf("the storage usage %s exceeds the %s quota, creations and updates are blocked", status.Size.String(), tcp.Spec.StorageQuota.String())
+		if !exceeded {
+			eventType, reason, message = corev1.EventTypeNormal, utils.EventReasonStorageQuotaRestored, fmt.Sprintf("the storage usage %s is within the quota, creations and updates are allowed", status.Size.String())
+		}
+
+		logger.Info(message)
+		r.recorder.Event(&tcp, eventType, reason, message)
+	}
+
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// measure retrieves the storage used by the Tenant Control Plane in its current DataStore.
+func (r *StorageUsage) measure(ctx context.Context, tcp stewardv1alpha1.TenantControlPlane) (*datastore.Usage, error) {
+	ctx, cancelFn := context.WithTimeout(ctx, r.Timeout)
+	defer cancelFn()
+
+	ds, err := datastore.GetDataStore(ctx, r.Client, tcp, tcp.Status.Storage.DataStoreName)
+	if err != nil {
+		return nil, err
+	}
+
+	connection, err := datastore.NewStorageConnection(ctx, r.Client, *ds)
+	if err != nil {
+		return nil, err
+	}
+	defer connection.Close()
+
+	return connection.Usage(ctx, tcp.Status.Storage.Setup.Schema)
+}
+
+func (r *StorageUsage) updateStatus(ctx context.Context, tcp *stewardv1alpha1.TenantControlPlane, status *stewardv1alpha1.DataStoreUsageStatus) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() (err error) {
+		defer func() {
+			if err != nil {
+				_ = r.Client.Get(ctx, k8stypes.NamespacedName{Name: tcp.Name, Namespace: tcp.Namespace}, tcp)
+			}
+		}()
+
+		if equality.Semantic.DeepEqual(tcp.Status.Storage.Usage, status) {
+			return nil
+		}
+
+		tcp.Status.Storage.Usage = status
+
+		return r.Client.Status().Update(ctx, tcp)
+	})
+}
+
+func (r *StorageUsage) SetupWithManager(mgr controllerruntime.Manager) error {
+	r.recorder = mgr.GetEventRecorderFor("storage-usage-controller")
+	r.measureFn = r.measure
+
+	return controllerruntime.NewControllerManagedBy(mgr).
+		Named("storage-usage").
+		For(&stewardv1alpha1.TenantControlPlane{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
@@ -0,0 +1,124 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/internal/datastore"
+)
+
+var _ = Describe("StorageUsage", func() {
+	var (
+		ctx         context.Context
+		tcp         *stewardv1alpha1.TenantControlPlane
+		usage       *datastore.Usage
+		measured    int
+		recorder    *record.FakeRecorder
+		reconciler  *StorageUsage
+		reconcileFn func() reconcile.Result
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		tcp = &stewardv1alpha1.TenantControlPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "tenant", Namespace: "default"},
+			Spec:       stewardv1alpha1.TenantControlPlaneSpec{StorageQuota: ptr.To(resource.MustParse("1Ki"))},
+		}
+		tcp.Status.Storage.DataStoreName = "default"
+		tcp.Status.Storage.Driver = string(stewardv1alpha1.EtcdDriver)
+		tcp.Status.Storage.Setup.Schema = "default_tenant"
+
+		usage, measured = &datastore.Usage{Keys: 10, Bytes: 512}, 0
+		recorder = record.NewFakeRecorder(10)
+	})
+
+	JustBeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(stewardv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		reconciler = &StorageUsage{
+			Client:   fake.NewClientBuilder().WithScheme(scheme).WithObjects(tcp).WithStatusSubresource(tcp).Build(),
+			Interval: time.Minute,
+			Timeout:  time.Second,
+			recorder: recorder,
+			measureFn: func(context.Context, stewardv1alpha1.TenantControlPlane) (*datastore.Usage, error) {
+				measured++
+
+				return usage, nil
+			},
+		}
+
+		reconcileFn = func() reconcile.Result {
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(tcp)})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reconciler.Client.Get(ctx, client.ObjectKeyFromObject(tcp), tcp)).To(Succeed())
+
+			return result
+		}
+	})
+
+	It("should wait for the storage to be set up", func() {
+		Expect(reconciler.Client.Get(ctx, client.ObjectKeyFromObject(tcp), tcp)).To(Succeed())
+		tcp.Status.Storage.Setup.Schema = ""
+		Expect(reconciler.Client.Status().Update(ctx, tcp)).To(Succeed())
+
+		Expect(reconcileFn()).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		Expect(measured).To(BeZero())
+		Expect(tcp.Status.Storage.Usage).To(BeNil())
+	})
+
+	It("should report the storage usage", func() {
+		Expect(reconcileFn()).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		Expect(tcp.Status.Storage.Usage).ToNot(BeNil())
+		Expect(tcp.Status.Storage.Usage.Keys).To(Equal(int64(10)))
+		Expect(tcp.Status.Storage.Usage.Size.Value()).To(Equal(int64(512)))
+		Expect(tcp.Status.Storage.Usage.QuotaExceeded).To(BeFalse())
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should report the exceeded quota, and its restoration", func() {
+		usage.Bytes = 2048
+
+		reconcileFn()
+		Expect(tcp.Status.Storage.Usage.QuotaExceeded).To(BeTrue())
+		Expect(recorder.Events).To(Receive(HavePrefix("Warning StorageQuotaExceeded")))
+		// Once the keys have been deleted, the usage falls back within the quota.
+		usage.Bytes = 256
+		tcp.Status.Storage.Usage.LastUpdate = metav1.NewTime(time.Now().Add(-time.Hour))
+		Expect(reconciler.Client.Status().Update(ctx, tcp)).To(Succeed())
+
+		reconcileFn()
+		Expect(tcp.Status.Storage.Usage.QuotaExceeded).To(BeFalse())
+		Expect(recorder.Events).To(Receive(HavePrefix("Normal StorageQuotaRestored")))
+	})
+
+	It("should evaluate the quota against a recent measurement", func() {
+		reconcileFn()
+		Expect(measured).To(Equal(1))
+		// Lowering the quota doesn't require a new measurement.
+		tcp.Spec.StorageQuota = ptr.To(resource.MustParse("256"))
+		Expect(reconciler.Client.Update(ctx, tcp)).To(Succeed())
+
+		result := reconcileFn()
+		Expect(measured).To(Equal(1))
+		Expect(result.RequeueAfter).To(BeNumerically("<", time.Minute))
+		Expect(tcp.Status.Storage.Usage.QuotaExceeded).To(BeTrue())
+		Expect(recorder.Events).To(Receive(HavePrefix("Warning StorageQuotaExceeded")))
+	})
+})
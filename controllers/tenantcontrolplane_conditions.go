@@ -0,0 +1,295 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/internal/resources"
+	ds "github.com/butlerdotdev/steward/internal/resources/datastore"
+	"github.com/butlerdotdev/steward/internal/resources/konnectivity"
+	wb "github.com/butlerdotdev/steward/internal/resources/workerbootstrap"
+	"github.com/butlerdotdev/steward/internal/upgrade"
+)
+
+// conditionTypes is the ordered list of the areas reported by the Tenant Control Plane conditions,
+// the first one not being true determines the reason of the aggregated Ready condition.
+var conditionTypes = []string{
+	stewardv1alpha1.DataStoreReadyCondition,
+	stewardv1alpha1.CertificatesReadyCondition,
+	stewardv1alpha1.KubeconfigsReadyCondition,
+	stewardv1alpha1.DeploymentAvailableCondition,
+	stewardv1alpha1.AddonsReadyCondition,
+	stewardv1alpha1.EndpointReachableCondition,
+}
+
+// resourceConditionType returns the condition reporting the reconciliation of the given resource,
+// and false for the resources not belonging to any area: their failures are reported by the Ready condition only.
+func resourceConditionType(resource resources.Resource) (string, bool) {
+	switch resource.(type) {
+	case *ds.DedicatedEtcd, *ds.Migrate, *ds.Restore, *ds.MultiTenancy, *ds.Config, *ds.Setup, *ds.Certificate, *ds.SQLiteVolume:
+		return stewardv1alpha1.DataStoreReadyCondition, true
+	case *resources.KubeadmConfigResource,
+		*resources.CACertificate,
+		*resources.FrontProxyCACertificate,
+		*resources.SACertificate,
+		*resources.APIServerCertificate,
+		*resources.APIServerKubeletClientCertificate,
+		*resources.FrontProxyClientCertificate:
+		return stewardv1alpha1.CertificatesReadyCondition, true
+	case *resources.KubeconfigResource:
+		return stewardv1alpha1.KubeconfigsReadyCondition, true
+	// The upgrade and the API Server configuration files are inputs of the control plane Deployment.
+	case *resources.KubernetesUpgrade,
+		*resources.EncryptionConfiguration,
+		*resources.AuditPolicy,
+		*resources.AuthenticationConfiguration,
+		*resources.AuthorizationConfiguration,
+		*resources.KubernetesDeploymentResource:
+		return stewardv1alpha1.DeploymentAvailableCondition, true
+	case *konnectivity.EgressSelectorConfigurationResource,
+		*konnectivity.CertificateResource,
+		*konnectivity.KubeconfigResource,
+		*konnectivity.KubernetesDeploymentResource,
+		*konnectivity.ServiceResource,
+		*konnectivity.KubernetesKonnectivityGatewayResource,
+		*konnectivity.Agent,
+		*konnectivity.ServiceAccountResource,
+		*konnectivity.ClusterRoleBindingResource,
+		*wb.TalosCredentialsResource,
+		*wb.TalosDeploymentResource,
+		*wb.TalosServiceResource,
+		*wb.TalosTraefikIngressRouteTCPResource,
+		*wb.TalosGatewayResource:
+		return stewardv1alpha1.AddonsReadyCondition, true
+	case *resources.KubernetesServiceResource,
+		*resources.KubernetesActivatorResource,
+		*resources.KubernetesIngressResource,
+		*resources.TraefikIngressRouteTCPResource,
+		*resources.KubernetesGatewayResource:
+		return stewardv1alpha1.EndpointReachableCondition, true
+	default:
+		return "", false
+	}
+}
+
+// isResourceConditionType returns whether the given resource is reported by the given condition.
+func isResourceConditionType(resource resources.Resource, conditionType string) bool {
+	resourceType, ok := resourceConditionType(resource)
+
+	return ok && resourceType == conditionType
+}
+
+// reconciliationConditions keeps track of the resources still to be handled for each condition area,
+// an area is evaluated only once all its resources have been handled in the current reconciliation.
+type reconciliationConditions struct {
+	pending map[string]int
+	// dataStore overrides the DataStore condition, when the DataStore is known to be down.
+	dataStore *metav1.Condition
+}
+
+func newReconciliationConditions(registered []resources.Resource) *reconciliationConditions {
+	pending := make(map[string]int, len(conditionTypes))
+	for _, resource := range registered {
+		if conditionType, ok := resourceConditionType(resource); ok {
+			pending[conditionType]++
+		}
+	}
+
+	return &reconciliationConditions{pending: pending}
+}
+
+// newUnavailableDataStoreConditions reports the DataStore is known to be down by its probe:
+// no resource is handled, and the other areas retain their previous observation.
+func newUnavailableDataStoreConditions(ds stewardv1alpha1.DataStore, ready metav1.Condition) *reconciliationConditions {
+	pending := make(map[string]int, len(conditionTypes))
+	for _, conditionType := range conditionTypes {
+		pending[conditionType]++
+	}
+
+	return &reconciliationConditions{
+		pending: pending,
+		dataStore: &metav1.Condition{
+			Status:  metav1.ConditionFalse,
+			Reason:  stewardv1alpha1.DataStoreUnavailableReason,
+			Message: fmt.Sprintf("the DataStore %s is not ready: %s", ds.GetName(), ready.Message),
+		},
+	}
+}
+
+func (c *reconciliationConditions) handled(resource resources.Resource) {
+	if conditionType, ok := resourceConditionType(resource); ok {
+		c.pending[conditionType]--
+	}
+}
+
+// update persists the Tenant Control Plane conditions, the failed resource along with its error
+// are reported in the condition of its area: the status is updated only if any condition changed.
+func (c *reconciliationConditions) update(ctx context.Context, cl client.Client, tcp *stewardv1alpha1.TenantControlPlane, failed resources.Resource, failure error) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() (err error) {
+		defer func() {
+			if err != nil {
+				_ = cl.Get(ctx, k8stypes.NamespacedName{Name: tcp.Name, Namespace: tcp.Namespace}, tcp)
+			}
+		}()
+
+		if !c.apply(tcp, failed, failure) {
+			return nil
+		}
+
+		if err = cl.Status().Update(ctx, tcp); err != nil {
+			return fmt.Errorf("error updating tenantControlPlane conditions: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (c *reconciliationConditions) apply(tcp *stewardv1alpha1.TenantControlPlane, failed resources.Resource, failure error) bool {
+	var changed bool
+
+	for _, conditionType := range conditionTypes {
+		condition, ok := c.evaluate(tcp, conditionType, failed, failure)
+		if !ok {
+			continue
+		}
+
+		condition.Type, condition.ObservedGeneration = conditionType, tcp.Generation
+		changed = meta.SetStatusCondition(&tcp.Status.Conditions, condition) || changed
+	}
+
+	changed = applyUpgradePreflightsCondition(tcp, failed, failure) || changed
+
+	ready := metav1.Condition{
+		Type:               stewardv1alpha1.ReadyCondition,
+		Status:             metav1.ConditionTrue,
+		Reason:             stewardv1alpha1.ReconciledReason,
+		Message:            "the Tenant Control Plane is ready",
+		ObservedGeneration: tcp.Generation,
+	}
+
+	for _, conditionType := range conditionTypes {
+		condition := meta.FindStatusCondition(tcp.Status.Conditions, conditionType)
+		if condition.Status == metav1.ConditionTrue {
+			continue
+		}
+
+		ready.Status, ready.Reason, ready.Message = metav1.ConditionFalse, condition.Reason, fmt.Sprintf("%s: %s", conditionType, condition.Message)
+
+		break
+	}
+	// A failure is always reported, even when the failed resource doesn't belong to any area.
+	if failed != nil {
+		ready.Status, ready.Reason, ready.Message = metav1.ConditionFalse, stewardv1alpha1.ResourceFailedReason, resourceFailedMessage(failed, failure)
+	}
+
+	return meta.SetStatusCondition(&tcp.Status.Conditions, ready) || changed
+}
+
+// applyUpgradePreflightsCondition reports the failed preflight checks blocking the Kubernetes upgrade,
+// the condition is set by the upgrade resource once they passed: a stale failure is removed when no upgrade is required.
+func applyUpgradePreflightsCondition(tcp *stewardv1alpha1.TenantControlPlane, failed resources.Resource, failure error) bool {
+	var preflightErr *upgrade.PreflightError
+	if _, ok := failed.(*resources.KubernetesUpgrade); ok && errors.As(failure, &preflightErr) {
+		return meta.SetStatusCondition(&tcp.Status.Conditions, metav1.Condition{
+			Type:               stewardv1alpha1.UpgradePreflightsPassedCondition,
+			Status:             metav1.ConditionFalse,
+			Reason:             stewardv1alpha1.PreflightsFailedReason,
+			Message:            preflightErr.Error(),
+			ObservedGeneration: tcp.Generation,
+		})
+	}
+
+	if tcp.Spec.Kubernetes.Version != tcp.Status.Kubernetes.Version.Version {
+		return false
+	}
+
+	if meta.IsStatusConditionFalse(tcp.Status.Conditions, stewardv1alpha1.UpgradePreflightsPassedCondition) {
+		return meta.RemoveStatusCondition(&tcp.Status.Conditions, stewardv1alpha1.UpgradePreflightsPassedCondition)
+	}
+
+	return false
+}
+
+// evaluate returns the desired condition for the given area, and false when the current one must be retained:
+// this happens when the area resources have not been handled yet, and a previous observation is available.
+func (c *reconciliationConditions) evaluate(tcp *stewardv1alpha1.TenantControlPlane, conditionType string, failed resources.Resource, failure error) (metav1.Condition, bool) {
+	switch {
+	case c.dataStore != nil && conditionType == stewardv1alpha1.DataStoreReadyCondition:
+		return *c.dataStore, true
+	case failed != nil && isResourceConditionType(failed, conditionType):
+		return metav1.Condition{
+			Status:  metav1.ConditionFalse,
+			Reason:  stewardv1alpha1.ResourceFailedReason,
+			Message: resourceFailedMessage(failed, failure),
+		}, true
+	case c.pending[conditionType] > 0:
+		if meta.FindStatusCondition(tcp.Status.Conditions, conditionType) != nil {
+			return metav1.Condition{}, false
+		}
+
+		return metav1.Condition{
+			Status:  metav1.ConditionUnknown,
+			Reason:  stewardv1alpha1.ReconcilingReason,
+			Message: "resources are being reconciled",
+		}, true
+	case conditionType == stewardv1alpha1.DeploymentAvailableCondition:
+		return deploymentCondition(tcp), true
+	case conditionType == stewardv1alpha1.EndpointReachableCondition && tcp.Status.ControlPlaneEndpoint == "":
+		return metav1.Condition{
+			Status:  metav1.ConditionFalse,
+			Reason:  stewardv1alpha1.EndpointNotAssignedReason,
+			Message: "the Tenant Control Plane endpoint has not been assigned yet",
+		}, true
+	default:
+		return metav1.Condition{
+			Status:  metav1.ConditionTrue,
+			Reason:  stewardv1alpha1.ReconciledReason,
+			Message: "resources have been reconciled",
+		}, true
+	}
+}
+
+func resourceFailedMessage(failed resources.Resource, failure error) string {
+	return fmt.Sprintf("handling of resource %s failed: %s", failed.GetName(), failure.Error())
+}
+
+func deploymentCondition(tcp *stewardv1alpha1.TenantControlPlane) metav1.Condition {
+	if ptr.Deref(tcp.Spec.ControlPlane.Deployment.Replicas, 2) == 0 {
+		return metav1.Condition{
+			Status:  metav1.ConditionFalse,
+			Reason:  stewardv1alpha1.SleepingReason,
+			Message: "the control plane Deployment has been scaled to zero",
+		}
+	}
+
+	deployment := tcp.Status.Kubernetes.Deployment
+	for _, condition := range deployment.Conditions {
+		if condition.Type == appsv1.DeploymentAvailable && condition.Status == corev1.ConditionTrue {
+			return metav1.Condition{
+				Status:  metav1.ConditionTrue,
+				Reason:  stewardv1alpha1.ReconciledReason,
+				Message: fmt.Sprintf("the control plane Deployment has %d available replicas", deployment.AvailableReplicas),
+			}
+		}
+	}
+
+	return metav1.Condition{
+		Status:  metav1.ConditionFalse,
+		Reason:  stewardv1alpha1.DeploymentNotAvailableReason,
+		Message: fmt.Sprintf("the control plane Deployment has %d out of %d available replicas", deployment.AvailableReplicas, deployment.Replicas),
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/internal/resources"
+	ds "github.com/butlerdotdev/steward/internal/resources/datastore"
+	"github.com/butlerdotdev/steward/internal/resources/konnectivity"
+	wb "github.com/butlerdotdev/steward/internal/resources/workerbootstrap"
+)
+
+// unknownResource is a resource not belonging to any condition area.
+type unknownResource struct {
+	resources.Resource
+}
+
+func (unknownResource) GetName() string {
+	return "unknown"
+}
+
+var _ = Describe("TenantControlPlane conditions", func() {
+	DescribeTable("resourceConditionType",
+		func(resource resources.Resource, expected string, expectedOk bool) {
+			conditionType, ok := resourceConditionType(resource)
+			Expect(ok).To(Equal(expectedOk))
+			Expect(conditionType).To(Equal(expected))
+		},
+		Entry("dedicated DataStore", &ds.DedicatedEtcd{}, stewardv1alpha1.DataStoreReadyCondition, true),
+		Entry("DataStore setup", &ds.Setup{}, stewardv1alpha1.DataStoreReadyCondition, true),
+		Entry("DataStore migration", &ds.Migrate{}, stewardv1alpha1.DataStoreReadyCondition, true),
+		Entry("DataStore restore", &ds.Restore{}, stewardv1alpha1.DataStoreReadyCondition, true),
+		Entry("certificate", &resources.APIServerCertificate{}, stewardv1alpha1.CertificatesReadyCondition, true),
+		Entry("kubeconfig", &resources.KubeconfigResource{}, stewardv1alpha1.KubeconfigsReadyCondition, true),
+		Entry("upgrade", &resources.KubernetesUpgrade{}, stewardv1alpha1.DeploymentAvailableCondition, true),
+		Entry("API Server configuration", &resources.AuditPolicy{}, stewardv1alpha1.DeploymentAvailableCondition, true),
+		Entry("Deployment", &resources.KubernetesDeploymentResource{}, stewardv1alpha1.DeploymentAvailableCondition, true),
+		Entry("konnectivity", &konnectivity.ServiceResource{}, stewardv1alpha1.AddonsReadyCondition, true),
+		Entry("konnectivity agent", &konnectivity.Agent{}, stewardv1alpha1.AddonsReadyCondition, true),
+		Entry("konnectivity agent ServiceAccount", &konnectivity.ServiceAccountResource{}, stewardv1alpha1.AddonsReadyCondition, true),
+		Entry("konnectivity agent ClusterRoleBinding", &konnectivity.ClusterRoleBindingResource{}, stewardv1alpha1.AddonsReadyCondition, true),
+		Entry("worker bootstrap", &wb.TalosServiceResource{}, stewardv1alpha1.AddonsReadyCondition, true),
+		Entry("Service", &resources.KubernetesServiceResource{}, stewardv1alpha1.EndpointReachableCondition, true),
+		Entry("unknown", unknownResource{}, "", false),
+	)
+
+	It("should report the failure of the unknown resources in the Ready condition", func() {
+		tcp := &stewardv1alpha1.TenantControlPlane{}
+		tcp.Status.ControlPlaneEndpoint = "10.0.0.1:6443"
+
+		conditions := newReconciliationConditions([]resources.Resource{&resources.KubernetesDeploymentResource{}, unknownResource{}})
+		conditions.handled(&resources.KubernetesDeploymentResource{})
+
+		Expect(conditions.apply(tcp, unknownResource{}, context.DeadlineExceeded)).To(BeTrue())
+
+		for _, conditionType := range conditionTypes {
+			Expect(tcp.Status.Conditions).To(ContainElement(HaveField("Type", conditionType)))
+		}
+
+		ready := meta.FindStatusCondition(tcp.Status.Conditions, stewardv1alpha1.ReadyCondition)
+		Expect(ready).ToNot(BeNil())
+		Expect(ready.Status).To(Equal(metav1.ConditionFalse))
+		Expect(ready.Reason).To(Equal(stewardv1alpha1.ResourceFailedReason))
+		Expect(ready.Message).To(ContainSubstring(context.DeadlineExceeded.Error()))
+	})
+})
@@ -14,10 +14,15 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8stypes "k8s.io/apimachinery/pkg/types"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
 	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -44,6 +49,10 @@ import (
 	"github.com/butlerdotdev/steward/internal/utilities"
 )
 
+// dataStoreUnavailableRequeue is the interval between two reconciliations of a Tenant Control Plane whose DataStore is not ready:
+// the DataStore controller triggers them as soon as it's ready again.
+const dataStoreUnavailableRequeue = time.Minute
+
 // TenantControlPlaneReconciler reconciles a TenantControlPlane object.
 type TenantControlPlaneReconciler struct {
 	Client                  client.Client
@@ -62,7 +71,10 @@ type TenantControlPlaneReconciler struct {
 	// once the validity threshold for the given certificate is reached.
 	CertificateChan chan event.GenericEvent
 
-	clock mutex.Clock
+	clock    mutex.Clock
+	recorder record.EventRecorder
+	// activatorPorts is shared by the reconciliations, allocating the activator ports to the sleeping Tenant Control Planes.
+	activatorPorts *resources.ActivatorPorts
 }
 
 // TenantControlPlaneReconcilerConfig gives the necessary configuration for TenantControlPlaneReconciler.
@@ -71,14 +83,21 @@ type TenantControlPlaneReconcilerConfig struct {
 	KineContainerImage      string
 	TmpBaseDirectory        string
 	CertExpirationThreshold time.Duration
+	// ActivatorService is the name of the Service of the Steward activator Pods, enabling the wake on request:
+	// the activator ports are allocated to the sleeping Tenant Control Planes from the ActivatorPorts range.
+	ActivatorService string
+	ActivatorPorts   utilnet.PortRange
 }
 
 //+kubebuilder:rbac:groups=steward.butlerlabs.dev,resources=tenantcontrolplanes,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=steward.butlerlabs.dev,resources=tenantcontrolplanes/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=steward.butlerlabs.dev,resources=tenantcontrolplanes/finalizers,verbs=update
+//+kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+//+kubebuilder:rbac:groups=discovery.k8s.io,resources=endpointslices,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=core,resources=persistentvolumeclaims,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;delete
@@ -149,6 +168,14 @@ func (r *TenantControlPlaneReconciler) Reconcile(ctx context.Context, req ctrl.R
 
 		return ctrl.Result{}, err
 	}
+	// Reaching a DataStore known to be down would time out: the reconciliation is resumed once it's ready again.
+	// The clean-up is attempted anyway, a deletion must not be held by the DataStore probe.
+	if ready := meta.FindStatusCondition(ds.Status.Conditions, stewardv1alpha1.ReadyCondition); !markedToBeDeleted && ready != nil && ready.Status == metav1.ConditionFalse {
+		log.Info("the DataStore is not ready, skipping", "dataStore", ds.GetName(), "reason", ready.Reason)
+		r.updateConditions(ctx, newUnavailableDataStoreConditions(*ds, *ready), tenantControlPlane, nil, nil)
+
+		return ctrl.Result{RequeueAfter: dataStoreUnavailableRequeue}, nil
+	}
 
 	dsConnection, err := datastore.NewStorageConnection(ctx, r.Client, *ds)
 	if err != nil {
@@ -216,8 +243,10 @@ func (r *TenantControlPlaneReconciler) Reconcile(ctx context.Context, req ctrl.R
 		StewardService:                r.StewardService,
 		StewardMigrateImage:           r.StewardMigrateImage,
 		DiscoveryClient:               r.DiscoveryClient,
+		activatorPorts:                r.activatorPorts,
 	}
 	registeredResources := GetResources(ctx, groupResourceBuilderConfiguration)
+	conditions := newReconciliationConditions(registeredResources)
 
 	for _, resource := range registeredResources {
 		result, err := resources.Handle(ctx, resource, tenantControlPlane)
@@ -229,11 +258,15 @@ func (r *TenantControlPlaneReconciler) Reconcile(ctx context.Context, req ctrl.R
 			}
 
 			log.Error(err, "handling of resource failed", "resource", resource.GetName())
+			utils.RecordFailureEvent(r.recorder, tenantControlPlane, resource, err)
+			r.updateConditions(ctx, conditions, tenantControlPlane, resource, err)
 
 			return ctrl.Result{}, err
 		}
 
 		if result == controllerutil.OperationResultNone {
+			conditions.handled(resource)
+
 			continue
 		}
 
@@ -245,24 +278,39 @@ func (r *TenantControlPlaneReconciler) Reconcile(ctx context.Context, req ctrl.R
 			}
 
 			log.Error(err, "update of the resource failed", "resource", resource.GetName())
+			utils.RecordFailureEvent(r.recorder, tenantControlPlane, resource, err)
+			r.updateConditions(ctx, conditions, tenantControlPlane, resource, err)
 
 			return ctrl.Result{}, err
 		}
 
 		log.Info(fmt.Sprintf("%s has been configured", resource.GetName()))
+		utils.RecordResourceEvent(r.recorder, tenantControlPlane, resource, result)
+		conditions.handled(resource)
 
 		if result == resources.OperationResultEnqueueBack {
 			log.Info("requested enqueuing back", "resources", resource.GetName())
+			r.updateConditions(ctx, conditions, tenantControlPlane, nil, nil)
 
 			return ctrl.Result{RequeueAfter: time.Second}, nil
 		}
 	}
 
+	r.updateConditions(ctx, conditions, tenantControlPlane, nil, nil)
+
 	log.Info(fmt.Sprintf("%s has been reconciled", tenantControlPlane.GetName()))
 
 	return ctrl.Result{}, nil
 }
 
+// updateConditions reports the reconciliation outcome in the Tenant Control Plane conditions:
+// failing to persist them is not blocking since they're computed again at the next reconciliation.
+func (r *TenantControlPlaneReconciler) updateConditions(ctx context.Context, conditions *reconciliationConditions, tcp *stewardv1alpha1.TenantControlPlane, failed resources.Resource, failure error) {
+	if err := conditions.update(ctx, r.Client, tcp, failed, failure); err != nil {
+		log.FromContext(ctx).Error(err, "cannot update the Tenant Control Plane conditions")
+	}
+}
+
 func (r *TenantControlPlaneReconciler) mutexSpec(obj client.Object) mutex.Spec {
 	return mutex.Spec{
 		Name:    strings.ReplaceAll(fmt.Sprintf("steward%s", obj.GetUID()), "-", ""),
@@ -276,6 +324,8 @@ func (r *TenantControlPlaneReconciler) mutexSpec(obj client.Object) mutex.Spec {
 // SetupWithManager sets up the controller with the Manager.
 func (r *TenantControlPlaneReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
 	r.clock = clock.RealClock{}
+	r.recorder = mgr.GetEventRecorderFor("tenantcontrolplane-controller")
+	r.activatorPorts = &resources.ActivatorPorts{Range: r.Config.ActivatorPorts}
 
 	controllerBuilder := ctrl.NewControllerManagedBy(mgr).
 		WatchesRawSource(source.Channel(r.CertificateChan, handler.Funcs{GenericFunc: func(_ context.Context, genericEvent event.TypedGenericEvent[client.Object], w workqueue.TypedRateLimitingInterface[reconcile.Request]) {
@@ -299,6 +349,7 @@ func (r *TenantControlPlaneReconciler) SetupWithManager(ctx context.Context, mgr
 		Owns(&corev1.ConfigMap{}).
 		Owns(&appsv1.Deployment{}).
 		Owns(&corev1.Service{}).
+		Owns(&corev1.PersistentVolumeClaim{}).
 		Owns(&networkingv1.Ingress{}).
 		Watches(&batchv1.Job{}, handler.EnqueueRequestsFromMapFunc(func(_ context.Context, object client.Object) []reconcile.Request {
 			labels := object.GetLabels()
@@ -326,9 +377,58 @@ func (r *TenantControlPlaneReconciler) SetupWithManager(ctx context.Context, mgr
 
 			v, ok := labels["steward.butlerlabs.dev/component"]
 
-			return ok && v == "migrate"
+			return ok && (v == "migrate" || v == "restore")
 		})))
 
+	// The restores are watched to run the restore Job as soon as they enter the Restoring phase.
+	controllerBuilder = controllerBuilder.
+		Watches(&stewardv1alpha1.TenantControlPlaneRestore{}, handler.EnqueueRequestsFromMapFunc(func(_ context.Context, object client.Object) []reconcile.Request {
+			restore, ok := object.(*stewardv1alpha1.TenantControlPlaneRestore)
+			if !ok || restore.Status.Phase != stewardv1alpha1.RestorePhaseRestoring {
+				return nil
+			}
+
+			return []reconcile.Request{
+				{
+					NamespacedName: k8stypes.NamespacedName{
+						Namespace: restore.GetNamespace(),
+						Name:      restore.Spec.TenantControlPlane,
+					},
+				},
+			}
+		}))
+
+	// The activator Pods are watched to keep the endpoints of the Tenant Control Planes routed to them up to date:
+	// the EndpointSlices are filtered, since only the activator ones are relevant.
+	if len(r.Config.ActivatorService) > 0 {
+		controllerBuilder = controllerBuilder.
+			Owns(&discoveryv1.EndpointSlice{}, builder.WithPredicates(predicate.NewPredicateFuncs(func(object client.Object) bool {
+				return object.GetLabels()[discoveryv1.LabelManagedBy] == resources.ActivatorManagedBy
+			}))).
+			Watches(&discoveryv1.EndpointSlice{}, handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, _ client.Object) []reconcile.Request {
+				var tcpList stewardv1alpha1.TenantControlPlaneList
+				if err := r.Client.List(ctx, &tcpList); err != nil {
+					log.FromContext(ctx).Error(err, "cannot list the Tenant Control Planes routed to the activator")
+
+					return nil
+				}
+
+				var requests []reconcile.Request
+
+				for _, tcp := range tcpList.Items {
+					if tcp.Status.Kubernetes.Service.ActivatorPort == 0 {
+						continue
+					}
+
+					requests = append(requests, reconcile.Request{NamespacedName: k8stypes.NamespacedName{Namespace: tcp.GetNamespace(), Name: tcp.GetName()}})
+				}
+
+				return requests
+			}), builder.WithPredicates(predicate.NewPredicateFuncs(func(object client.Object) bool {
+				return object.GetNamespace() == r.StewardNamespace && object.GetLabels()[discoveryv1.LabelServiceName] == r.Config.ActivatorService
+			})))
+	}
+
 	// Conditionally add Gateway API ownership if available
 	if utilities.AreGatewayResourcesAvailable(ctx, r.Client, r.DiscoveryClient) {
 		controllerBuilder = controllerBuilder.
@@ -369,6 +469,10 @@ var ErrMissingDataStore = errors.New("the Tenant Control Plane doesn't have a Da
 // dataStore retrieves the override DataStore for the given Tenant Control Plane if specified,
 // otherwise fallback to the default one specified in the Steward setup.
 func (r *TenantControlPlaneReconciler) dataStore(ctx context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) (*stewardv1alpha1.DataStore, error) {
+	if tenantControlPlane.Spec.DataStoreMode == stewardv1alpha1.DataStoreModeDedicated {
+		return datastore.GetDataStore(ctx, r.Client, *tenantControlPlane, stewardv1alpha1.DedicatedDataStoreName)
+	}
+
 	if tenantControlPlane.Spec.DataStore == "" && r.Config.DefaultDataStoreName == "" {
 		return nil, ErrMissingDataStore
 	}
@@ -377,12 +481,7 @@ func (r *TenantControlPlaneReconciler) dataStore(ctx context.Context, tenantCont
 		tenantControlPlane.Spec.DataStore = r.Config.DefaultDataStoreName
 	}
 
-	var ds stewardv1alpha1.DataStore
-	if err := r.Client.Get(ctx, k8stypes.NamespacedName{Name: tenantControlPlane.Spec.DataStore}, &ds); err != nil {
-		return nil, errors.Wrap(err, "cannot retrieve *stewardv1alpha.DataStore object")
-	}
-
-	return &ds, nil
+	return datastore.GetDataStore(ctx, r.Client, *tenantControlPlane, tenantControlPlane.Spec.DataStore)
 }
 
 func (r *TenantControlPlaneReconciler) dataStoreOverride(ctx context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) ([]controlplanebuilder.DataStoreOverrides, error) {
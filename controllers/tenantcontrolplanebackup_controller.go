@@ -0,0 +1,241 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/controllers/utils"
+	"github.com/butlerdotdev/steward/internal/backup"
+)
+
+const (
+	backupNameLabel      = "tcpbackup.steward.butlerlabs.dev/name"
+	backupNamespaceLabel = "tcpbackup.steward.butlerlabs.dev/namespace"
+	backupComponent      = "backup"
+	// backupJobTTL is how long the finished backup Jobs are retained, allowing to inspect their logs.
+	backupJobTTL = 24 * time.Hour
+	// backupPendingRequeue is the interval between two checks of a Tenant Control Plane with no storage yet.
+	backupPendingRequeue = 30 * time.Second
+)
+
+// TenantControlPlaneBackup runs a Job exporting the keyspace of the Tenant Control Plane for each backup:
+// the Job is running in the Steward Namespace, since it requires the DataStore credentials,
+// and it's in charge of recording the archive details in the backup status.
+type TenantControlPlaneBackup struct {
+	Client                client.Client
+	StewardNamespace      string
+	StewardServiceAccount string
+	BackupImage           string
+}
+
+//+kubebuilder:rbac:groups=steward.butlerlabs.dev,resources=tenantcontrolplanebackups,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=steward.butlerlabs.dev,resources=tenantcontrolplanebackups/status,verbs=get;update;patch
+
+func (r *TenantControlPlaneBackup) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	logger := log.FromContext(ctx)
+
+	var tcpBackup stewardv1alpha1.TenantControlPlaneBackup
+	if err := r.Client.Get(ctx, request.NamespacedName, &tcpBackup); err != nil {
+		if k8serrors.IsNotFound(err) {
+			logger.Info("resource may have been deleted, skipping")
+
+			return reconcile.Result{}, nil
+		}
+
+		logger.Error(err, "cannot retrieve the required resource")
+
+		return reconcile.Result{}, err
+	}
+
+	if utils.IsPaused(&tcpBackup) {
+		logger.Info("paused reconciliation, no further actions")
+
+		return reconcile.Result{}, nil
+	}
+
+	if phase := tcpBackup.Status.Phase; phase == stewardv1alpha1.BackupPhaseCompleted || phase == stewardv1alpha1.BackupPhaseFailed {
+		return reconcile.Result{}, nil
+	}
+
+	status := tcpBackup.Status.DeepCopy()
+
+	job := &batchv1.Job{}
+	err := r.Client.Get(ctx, k8stypes.NamespacedName{Namespace: r.StewardNamespace, Name: r.jobName(tcpBackup)}, job)
+
+	var requeueAfter time.Duration
+
+	switch {
+	case err != nil && !k8serrors.IsNotFound(err):
+		logger.Error(err, "cannot retrieve the backup Job")
+
+		return reconcile.Result{}, err
+	case err != nil && status.Phase == stewardv1alpha1.BackupPhaseRunning:
+		r.fail(status, "the backup Job has been deleted before completion")
+	case err != nil:
+		var tcp stewardv1alpha1.TenantControlPlane
+		if tcpErr := r.Client.Get(ctx, k8stypes.NamespacedName{Namespace: tcpBackup.GetNamespace(), Name: tcpBackup.Spec.TenantControlPlane}, &tcp); tcpErr != nil {
+			if !k8serrors.IsNotFound(tcpErr) {
+				logger.Error(tcpErr, "cannot retrieve the Tenant Control Plane")
+
+				return reconcile.Result{}, tcpErr
+			}
+
+			r.fail(status, fmt.Sprintf("the Tenant Control Plane %s does not exist", tcpBackup.Spec.TenantControlPlane))
+
+			break
+		}
+
+		if tcp.Status.Storage.DataStoreName == "" {
+			status.Phase, status.Message = stewardv1alpha1.BackupPhasePending, "waiting for the Tenant Control Plane storage to be set up"
+			requeueAfter = backupPendingRequeue
+
+			break
+		}
+
+		if err = r.Client.Create(ctx, r.job(tcpBackup)); err != nil && !k8serrors.IsAlreadyExists(err) {
+			logger.Error(err, "cannot create the backup Job")
+
+			return reconcile.Result{}, err
+		}
+
+		logger.Info("backup Job has been created")
+
+		status.Phase, status.Message, status.StartTime = stewardv1alpha1.BackupPhaseRunning, "", &metav1.Time{Time: time.Now()}
+	default:
+		// Note: job.Status.Conditions can contain more than one condition on Kubernetes versions greater than v1.30
+		for _, condition := range job.Status.Conditions {
+			if condition.Type == batchv1.JobFailed && condition.Status == corev1.ConditionTrue {
+				r.fail(status, fmt.Sprintf("the backup Job failed: %s", condition.Message))
+			}
+		}
+
+		if status.Phase == "" || status.Phase == stewardv1alpha1.BackupPhasePending {
+			status.Phase, status.Message, status.StartTime = stewardv1alpha1.BackupPhaseRunning, "", &job.CreationTimestamp
+		}
+	}
+
+	if !equality.Semantic.DeepEqual(tcpBackup.Status, *status) {
+		tcpBackup.Status = *status
+
+		if err = r.Client.Status().Update(ctx, &tcpBackup); err != nil {
+			logger.Error(err, "cannot update resource status")
+
+			return reconcile.Result{}, err
+		}
+	}
+
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+}
+
+func (r *TenantControlPlaneBackup) fail(status *stewardv1alpha1.TenantControlPlaneBackupStatus, message string) {
+	status.Phase, status.Message, status.CompletionTime = stewardv1alpha1.BackupPhaseFailed, message, &metav1.Time{Time: time.Now()}
+}
+
+func (r *TenantControlPlaneBackup) jobName(tcpBackup stewardv1alpha1.TenantControlPlaneBackup) string {
+	return fmt.Sprintf("backup-%s", tcpBackup.GetUID())
+}
+
+func (r *TenantControlPlaneBackup) job(tcpBackup stewardv1alpha1.TenantControlPlaneBackup) *batchv1.Job {
+	labels := map[string]string{
+		backupNameLabel:                    tcpBackup.GetName(),
+		backupNamespaceLabel:               tcpBackup.GetNamespace(),
+		"steward.butlerlabs.dev/component": backupComponent,
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      r.jobName(tcpBackup),
+			Namespace: r.StewardNamespace,
+			Labels:    labels,
+		},
+		Spec: batchv1.JobSpec{
+			// The backup command records the failure in the backup status: retrying would overwrite it.
+			BackoffLimit:            ptr.To(int32(0)),
+			TTLSecondsAfterFinished: ptr.To(int32(backupJobTTL.Seconds())),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					ServiceAccountName: r.StewardServiceAccount,
+					RestartPolicy:      corev1.RestartPolicyNever,
+					Containers: []corev1.Container{
+						{
+							Name:  "backup",
+							Image: r.BackupImage,
+							Args: []string{
+								"backup",
+								fmt.Sprintf("--tenant-control-plane-backup=%s/%s", tcpBackup.GetNamespace(), tcpBackup.GetName()),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if pvc := tcpBackup.Spec.Storage.PersistentVolumeClaim; pvc != nil {
+		job.Spec.Template.Spec.Volumes = []corev1.Volume{
+			{
+				Name: "backups",
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: pvc.ClaimName},
+				},
+			},
+		}
+		job.Spec.Template.Spec.Containers[0].VolumeMounts = []corev1.VolumeMount{
+			{
+				Name:      "backups",
+				MountPath: backup.VolumeMountPath,
+			},
+		}
+	}
+
+	return job
+}
+
+func (r *TenantControlPlaneBackup) SetupWithManager(mgr controllerruntime.Manager) error {
+	return controllerruntime.NewControllerManagedBy(mgr).
+		For(&stewardv1alpha1.TenantControlPlaneBackup{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&batchv1.Job{}, handler.EnqueueRequestsFromMapFunc(func(_ context.Context, object client.Object) []reconcile.Request {
+			labels := object.GetLabels()
+
+			return []reconcile.Request{
+				{
+					NamespacedName: k8stypes.NamespacedName{
+						Namespace: labels[backupNamespaceLabel],
+						Name:      labels[backupNameLabel],
+					},
+				},
+			}
+		}), builder.WithPredicates(predicate.NewPredicateFuncs(func(object client.Object) bool {
+			if object.GetNamespace() != r.StewardNamespace {
+				return false
+			}
+
+			labels := object.GetLabels()
+
+			return labels["steward.butlerlabs.dev/component"] == backupComponent && labels[backupNameLabel] != ""
+		}))).
+		Complete(r)
+}
@@ -0,0 +1,174 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/controllers/utils"
+	"github.com/butlerdotdev/steward/internal/backup"
+)
+
+const backupScheduleLabel = "tcpbackupschedule.steward.butlerlabs.dev/name"
+
+// TenantControlPlaneBackupSchedule creates the TenantControlPlaneBackup objects according to the cron schedule,
+// pruning the backups exceeding the history limits.
+type TenantControlPlaneBackupSchedule struct {
+	Client client.Client
+}
+
+//+kubebuilder:rbac:groups=steward.butlerlabs.dev,resources=tenantcontrolplanebackupschedules,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=steward.butlerlabs.dev,resources=tenantcontrolplanebackupschedules/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=steward.butlerlabs.dev,resources=tenantcontrolplanebackupschedules/finalizers,verbs=update
+
+func (r *TenantControlPlaneBackupSchedule) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	logger := log.FromContext(ctx)
+
+	var schedule stewardv1alpha1.TenantControlPlaneBackupSchedule
+	if err := r.Client.Get(ctx, request.NamespacedName, &schedule); err != nil {
+		if k8serrors.IsNotFound(err) {
+			logger.Info("resource may have been deleted, skipping")
+
+			return reconcile.Result{}, nil
+		}
+
+		logger.Error(err, "cannot retrieve the required resource")
+
+		return reconcile.Result{}, err
+	}
+
+	if utils.IsPaused(&schedule) || schedule.GetDeletionTimestamp() != nil {
+		return reconcile.Result{}, nil
+	}
+
+	status := schedule.Status.DeepCopy()
+	status.Message = ""
+
+	requeueAfter, err := r.handle(ctx, &schedule, status)
+	if err != nil {
+		logger.Error(err, "cannot handle the backup schedule")
+
+		return reconcile.Result{}, err
+	}
+
+	if !equality.Semantic.DeepEqual(schedule.Status, *status) {
+		schedule.Status = *status
+
+		if err = r.Client.Status().Update(ctx, &schedule); err != nil {
+			logger.Error(err, "cannot update resource status")
+
+			return reconcile.Result{}, err
+		}
+	}
+
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+}
+
+func (r *TenantControlPlaneBackupSchedule) handle(ctx context.Context, schedule *stewardv1alpha1.TenantControlPlaneBackupSchedule, status *stewardv1alpha1.TenantControlPlaneBackupScheduleStatus) (time.Duration, error) {
+	var backups stewardv1alpha1.TenantControlPlaneBackupList
+	if err := r.Client.List(ctx, &backups, client.InNamespace(schedule.GetNamespace()), client.MatchingLabels{backupScheduleLabel: schedule.GetName()}); err != nil {
+		return 0, fmt.Errorf("cannot list the scheduled backups: %w", err)
+	}
+
+	pruned := backup.Prune(backups.Items, ptr.Deref(schedule.Spec.SuccessfulBackupsHistoryLimit, 7), ptr.Deref(schedule.Spec.FailedBackupsHistoryLimit, 1))
+	for i := range pruned {
+		if err := r.Client.Delete(ctx, &pruned[i]); err != nil && !k8serrors.IsNotFound(err) {
+			return 0, fmt.Errorf("cannot prune the backup %s: %w", pruned[i].GetName(), err)
+		}
+
+		log.FromContext(ctx).Info("backup exceeding the history limit has been pruned", "backup", pruned[i].GetName())
+	}
+
+	var lastSuccessful *stewardv1alpha1.TenantControlPlaneBackup
+
+	for i, item := range backups.Items {
+		if item.Status.Phase == stewardv1alpha1.BackupPhaseCompleted && (lastSuccessful == nil || lastSuccessful.CreationTimestamp.Before(&item.CreationTimestamp)) {
+			lastSuccessful = &backups.Items[i]
+		}
+	}
+
+	if lastSuccessful != nil {
+		status.LastSuccessfulBackup = lastSuccessful.GetName()
+	}
+
+	parsed, err := backup.ParseSchedule(schedule.Spec.Schedule, schedule.Spec.TimeZone)
+	if err != nil {
+		// An invalid schedule cannot be fixed by retrying: the spec update will trigger a new reconciliation.
+		status.Message = err.Error()
+
+		return 0, nil
+	}
+
+	if schedule.Spec.Suspend {
+		return 0, nil
+	}
+
+	now := time.Now()
+
+	from := schedule.GetCreationTimestamp().Time
+	if status.LastScheduleTime != nil {
+		from = status.LastScheduleTime.Time
+	}
+
+	if at := parsed.Last(from, now); !at.IsZero() {
+		if err = r.create(ctx, schedule, at); err != nil {
+			return 0, err
+		}
+
+		status.LastScheduleTime = &metav1.Time{Time: at}
+	}
+
+	return parsed.Next(now).Sub(now), nil
+}
+
+func (r *TenantControlPlaneBackupSchedule) create(ctx context.Context, schedule *stewardv1alpha1.TenantControlPlaneBackupSchedule, at time.Time) error {
+	// The name is derived from the scheduled time, as the CronJob does for its Jobs:
+	// a backup is never created twice for the same schedule.
+	tcpBackup := &stewardv1alpha1.TenantControlPlaneBackup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%d", schedule.GetName(), at.Unix()/60),
+			Namespace: schedule.GetNamespace(),
+			Labels: map[string]string{
+				backupScheduleLabel: schedule.GetName(),
+			},
+		},
+		Spec: schedule.Spec.Template,
+	}
+
+	if err := controllerutil.SetControllerReference(schedule, tcpBackup, r.Client.Scheme()); err != nil {
+		return fmt.Errorf("cannot set the backup owner reference: %w", err)
+	}
+
+	if err := r.Client.Create(ctx, tcpBackup); err != nil {
+		if k8serrors.IsAlreadyExists(err) {
+			return nil
+		}
+
+		return fmt.Errorf("cannot create the scheduled backup: %w", err)
+	}
+
+	log.FromContext(ctx).Info("scheduled backup has been created", "backup", tcpBackup.GetName())
+
+	return nil
+}
+
+func (r *TenantControlPlaneBackupSchedule) SetupWithManager(mgr controllerruntime.Manager) error {
+	return controllerruntime.NewControllerManagedBy(mgr).
+		For(&stewardv1alpha1.TenantControlPlaneBackupSchedule{}).
+		Owns(&stewardv1alpha1.TenantControlPlaneBackup{}).
+		Complete(r)
+}
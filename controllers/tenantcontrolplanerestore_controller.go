@@ -0,0 +1,278 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/controllers/utils"
+)
+
+// restorePendingRequeue is the interval between two checks of a restore waiting for its requirements.
+const restorePendingRequeue = 30 * time.Second
+
+// TenantControlPlaneRestore blocks the writes of the Tenant Control Plane before moving the restore to the Restoring phase:
+// the restore Job is run by the Tenant Control Plane reconciliation, as it happens for the DataStore migrations.
+// Once the keyspace has been restored, the writes are blocked until the operator confirms the restore.
+type TenantControlPlaneRestore struct {
+	Client client.Client
+}
+
+//+kubebuilder:rbac:groups=steward.butlerlabs.dev,resources=tenantcontrolplanerestores,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=steward.butlerlabs.dev,resources=tenantcontrolplanerestores/status,verbs=get;update;patch
+
+func (r *TenantControlPlaneRestore) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	logger := log.FromContext(ctx)
+
+	var restore stewardv1alpha1.TenantControlPlaneRestore
+	if err := r.Client.Get(ctx, request.NamespacedName, &restore); err != nil {
+		if k8serrors.IsNotFound(err) {
+			logger.Info("resource may have been deleted, skipping")
+
+			return reconcile.Result{}, nil
+		}
+
+		logger.Error(err, "cannot retrieve the required resource")
+
+		return reconcile.Result{}, err
+	}
+
+	if utils.IsPaused(&restore) {
+		logger.Info("paused reconciliation, no further actions")
+
+		return reconcile.Result{}, nil
+	}
+
+	var tcp stewardv1alpha1.TenantControlPlane
+	if err := r.Client.Get(ctx, k8stypes.NamespacedName{Namespace: restore.GetNamespace(), Name: restore.Spec.TenantControlPlane}, &tcp); err != nil {
+		if !k8serrors.IsNotFound(err) {
+			logger.Error(err, "cannot retrieve the Tenant Control Plane")
+
+			return reconcile.Result{}, err
+		}
+
+		if restore.Status.Phase == stewardv1alpha1.RestorePhaseCompleted || restore.Status.Phase == stewardv1alpha1.RestorePhaseFailed {
+			return reconcile.Result{}, nil
+		}
+
+		return reconcile.Result{}, r.updateStatus(ctx, &restore, func(status *stewardv1alpha1.TenantControlPlaneRestoreStatus) {
+			r.fail(status, fmt.Sprintf("the Tenant Control Plane %s does not exist", restore.Spec.TenantControlPlane))
+		})
+	}
+
+	switch restore.Status.Phase {
+	case "", stewardv1alpha1.RestorePhasePending:
+		return r.start(ctx, &restore, &tcp)
+	case stewardv1alpha1.RestorePhaseRestoring:
+		return reconcile.Result{}, r.blockWrites(ctx, &tcp)
+	case stewardv1alpha1.RestorePhaseAwaitingConfirmation, stewardv1alpha1.RestorePhaseFailed:
+		// A failed restore could have replaced the keyspace partially:
+		// the writes are kept blocked until the operator confirms it as well.
+		if !restore.Spec.Confirmed || restore.Status.WritePermissions == nil {
+			return reconcile.Result{}, nil
+		}
+
+		if err := r.release(ctx, &restore, &tcp, *restore.Status.WritePermissions); err != nil {
+			logger.Error(err, "cannot restore the Tenant Control Plane write permissions")
+
+			return reconcile.Result{}, err
+		}
+
+		logger.Info("restore has been confirmed, write permissions have been restored")
+
+		return reconcile.Result{}, r.updateStatus(ctx, &restore, func(status *stewardv1alpha1.TenantControlPlaneRestoreStatus) {
+			status.WritePermissions = nil
+
+			if status.Phase == stewardv1alpha1.RestorePhaseAwaitingConfirmation {
+				status.Phase, status.CompletionTime = stewardv1alpha1.RestorePhaseCompleted, &metav1.Time{Time: time.Now()}
+			}
+		})
+	default:
+		return reconcile.Result{}, nil
+	}
+}
+
+// start moves the restore to the Restoring phase once its requirements are met,
+// recording the Tenant Control Plane write permissions before blocking them.
+func (r *TenantControlPlaneRestore) start(ctx context.Context, restore *stewardv1alpha1.TenantControlPlaneRestore, tcp *stewardv1alpha1.TenantControlPlane) (reconcile.Result, error) {
+	logger := log.FromContext(ctx)
+
+	pending, failure, err := r.checkRequirements(ctx, restore, tcp)
+	if err != nil {
+		logger.Error(err, "cannot check the restore requirements")
+
+		return reconcile.Result{}, err
+	}
+
+	switch {
+	case failure != "":
+		return reconcile.Result{}, r.updateStatus(ctx, restore, func(status *stewardv1alpha1.TenantControlPlaneRestoreStatus) {
+			r.fail(status, failure)
+		})
+	case pending != "":
+		return reconcile.Result{RequeueAfter: restorePendingRequeue}, r.updateStatus(ctx, restore, func(status *stewardv1alpha1.TenantControlPlaneRestoreStatus) {
+			status.Phase, status.Message = stewardv1alpha1.RestorePhasePending, pending
+		})
+	}
+
+	if err = r.acquire(ctx, restore, tcp); err != nil {
+		logger.Error(err, "cannot take the ownership of the Tenant Control Plane")
+
+		return reconcile.Result{}, err
+	}
+	// The write permissions are recorded before blocking them:
+	// in case of failure, the Restoring phase ensures the writes are blocked at the next reconciliation.
+	if err = r.updateStatus(ctx, restore, func(status *stewardv1alpha1.TenantControlPlaneRestoreStatus) {
+		status.Phase, status.Message, status.StartTime = stewardv1alpha1.RestorePhaseRestoring, "", &metav1.Time{Time: time.Now()}
+		status.WritePermissions = tcp.Spec.WritePermissions.DeepCopy()
+	}); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	logger.Info("restore has been started, blocking the Tenant Control Plane writes")
+
+	return reconcile.Result{}, r.blockWrites(ctx, tcp)
+}
+
+// checkRequirements returns the reason the restore must wait, or fail.
+func (r *TenantControlPlaneRestore) checkRequirements(ctx context.Context, restore *stewardv1alpha1.TenantControlPlaneRestore, tcp *stewardv1alpha1.TenantControlPlane) (pending string, failure string, err error) {
+	if name := restore.Spec.Source.Backup; name != "" {
+		var tcpBackup stewardv1alpha1.TenantControlPlaneBackup
+		if err = r.Client.Get(ctx, k8stypes.NamespacedName{Namespace: restore.GetNamespace(), Name: name}, &tcpBackup); err != nil {
+			if k8serrors.IsNotFound(err) {
+				return "", fmt.Sprintf("the TenantControlPlaneBackup %s does not exist", name), nil
+			}
+
+			return "", "", err
+		}
+
+		switch tcpBackup.Status.Phase {
+		case stewardv1alpha1.BackupPhaseCompleted:
+		case stewardv1alpha1.BackupPhaseFailed:
+			return "", fmt.Sprintf("the TenantControlPlaneBackup %s failed", name), nil
+		default:
+			return fmt.Sprintf("waiting for the TenantControlPlaneBackup %s to be completed", name), "", nil
+		}
+	}
+
+	if tcp.Status.Storage.DataStoreName == "" {
+		return "waiting for the Tenant Control Plane storage to be set up", "", nil
+	}
+
+	owner := tcp.GetAnnotations()[stewardv1alpha1.RestoreOwnerAnnotation]
+	if owner == "" || owner == restore.GetName() {
+		return "", "", nil
+	}
+
+	var ownerRestore stewardv1alpha1.TenantControlPlaneRestore
+	if err = r.Client.Get(ctx, k8stypes.NamespacedName{Namespace: restore.GetNamespace(), Name: owner}, &ownerRestore); err != nil {
+		// The ownership of a deleted restore is taken over.
+		if k8serrors.IsNotFound(err) {
+			return "", "", nil
+		}
+
+		return "", "", err
+	}
+	// A restore holds the Tenant Control Plane until its write permissions are restored.
+	if ownerRestore.Status.Phase == stewardv1alpha1.RestorePhaseRestoring || ownerRestore.Status.WritePermissions != nil {
+		return fmt.Sprintf("waiting for the TenantControlPlaneRestore %s to be confirmed", owner), "", nil
+	}
+
+	return "", "", nil
+}
+
+// acquire records the restore as the owner of the Tenant Control Plane: the patch is rejected with a conflict
+// when a concurrent restore took the ownership first, retrying the requirements check with the updated Tenant Control Plane.
+func (r *TenantControlPlaneRestore) acquire(ctx context.Context, restore *stewardv1alpha1.TenantControlPlaneRestore, tcp *stewardv1alpha1.TenantControlPlane) error {
+	if tcp.GetAnnotations()[stewardv1alpha1.RestoreOwnerAnnotation] == restore.GetName() {
+		return nil
+	}
+
+	patch := client.MergeFromWithOptions(tcp.DeepCopy(), client.MergeFromWithOptimisticLock{})
+
+	annotations := tcp.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	annotations[stewardv1alpha1.RestoreOwnerAnnotation] = restore.GetName()
+	tcp.SetAnnotations(annotations)
+
+	return r.Client.Patch(ctx, tcp, patch)
+}
+
+// release applies back the recorded write permissions, and removes the ownership of the restore.
+func (r *TenantControlPlaneRestore) release(ctx context.Context, restore *stewardv1alpha1.TenantControlPlaneRestore, tcp *stewardv1alpha1.TenantControlPlane, permissions stewardv1alpha1.Permissions) error {
+	owned := tcp.GetAnnotations()[stewardv1alpha1.RestoreOwnerAnnotation] == restore.GetName()
+	if tcp.Spec.WritePermissions == permissions && !owned {
+		return nil
+	}
+
+	patch := client.MergeFrom(tcp.DeepCopy())
+	tcp.Spec.WritePermissions = permissions
+
+	if owned {
+		delete(tcp.Annotations, stewardv1alpha1.RestoreOwnerAnnotation)
+	}
+
+	return r.Client.Patch(ctx, tcp, patch)
+}
+
+func (r *TenantControlPlaneRestore) blockWrites(ctx context.Context, tcp *stewardv1alpha1.TenantControlPlane) error {
+	return r.restoreWrites(ctx, tcp, stewardv1alpha1.Permissions{BlockCreate: true, BlockUpdate: true, BlockDelete: true})
+}
+
+func (r *TenantControlPlaneRestore) restoreWrites(ctx context.Context, tcp *stewardv1alpha1.TenantControlPlane, permissions stewardv1alpha1.Permissions) error {
+	if tcp.Spec.WritePermissions == permissions {
+		return nil
+	}
+
+	patch := client.MergeFrom(tcp.DeepCopy())
+	tcp.Spec.WritePermissions = permissions
+
+	return r.Client.Patch(ctx, tcp, patch)
+}
+
+func (r *TenantControlPlaneRestore) updateStatus(ctx context.Context, restore *stewardv1alpha1.TenantControlPlaneRestore, mutateFn func(status *stewardv1alpha1.TenantControlPlaneRestoreStatus)) error {
+	status := restore.Status.DeepCopy()
+	mutateFn(status)
+
+	if equality.Semantic.DeepEqual(restore.Status, *status) {
+		return nil
+	}
+
+	restore.Status = *status
+
+	if err := r.Client.Status().Update(ctx, restore); err != nil {
+		log.FromContext(ctx).Error(err, "cannot update resource status")
+
+		return err
+	}
+
+	return nil
+}
+
+func (r *TenantControlPlaneRestore) fail(status *stewardv1alpha1.TenantControlPlaneRestoreStatus, message string) {
+	status.Phase, status.Message, status.CompletionTime = stewardv1alpha1.RestorePhaseFailed, message, &metav1.Time{Time: time.Now()}
+}
+
+func (r *TenantControlPlaneRestore) SetupWithManager(mgr controllerruntime.Manager) error {
+	return controllerruntime.NewControllerManagedBy(mgr).
+		For(&stewardv1alpha1.TenantControlPlaneRestore{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
@@ -0,0 +1,120 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+)
+
+var _ = Describe("TenantControlPlaneRestore", func() {
+	var (
+		ctx        context.Context
+		tcp        *stewardv1alpha1.TenantControlPlane
+		first      *stewardv1alpha1.TenantControlPlaneRestore
+		second     *stewardv1alpha1.TenantControlPlaneRestore
+		reconciler *TenantControlPlaneRestore
+	)
+
+	newRestore := func(name string) *stewardv1alpha1.TenantControlPlaneRestore {
+		return &stewardv1alpha1.TenantControlPlaneRestore{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       stewardv1alpha1.TenantControlPlaneRestoreSpec{TenantControlPlane: "tcp"},
+		}
+	}
+
+	get := func(object client.Object) {
+		Expect(reconciler.Client.Get(ctx, client.ObjectKeyFromObject(object), object)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		tcp = &stewardv1alpha1.TenantControlPlane{ObjectMeta: metav1.ObjectMeta{Name: "tcp", Namespace: "default"}}
+		tcp.Spec.WritePermissions = stewardv1alpha1.Permissions{BlockDelete: true}
+		tcp.Status.Storage.DataStoreName = "default"
+
+		first, second = newRestore("first"), newRestore("second")
+
+		scheme := runtime.NewScheme()
+		Expect(stewardv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		reconciler = &TenantControlPlaneRestore{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(tcp, first, second).WithStatusSubresource(tcp, first, second).Build(),
+		}
+	})
+
+	It("should let a single restore hold the Tenant Control Plane", func() {
+		get(tcp)
+		stale := tcp.DeepCopy()
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(first)})
+		Expect(err).ToNot(HaveOccurred())
+		// The concurrent restore checked its requirements against the Tenant Control Plane before the ownership was taken.
+		get(second)
+		_, err = reconciler.start(ctx, second, stale)
+		Expect(err).To(HaveOccurred())
+
+		_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(second)})
+		Expect(err).ToNot(HaveOccurred())
+
+		get(first)
+		Expect(first.Status.Phase).To(Equal(stewardv1alpha1.RestorePhaseRestoring))
+		Expect(first.Status.WritePermissions).To(Equal(&stewardv1alpha1.Permissions{BlockDelete: true}))
+
+		get(second)
+		Expect(second.Status.Phase).To(Equal(stewardv1alpha1.RestorePhasePending))
+		Expect(second.Status.WritePermissions).To(BeNil())
+
+		get(tcp)
+		Expect(tcp.GetAnnotations()).To(HaveKeyWithValue(stewardv1alpha1.RestoreOwnerAnnotation, "first"))
+		Expect(tcp.Spec.WritePermissions).To(Equal(stewardv1alpha1.Permissions{BlockCreate: true, BlockUpdate: true, BlockDelete: true}))
+	})
+
+	It("should release the Tenant Control Plane once the restore is confirmed", func() {
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(first)})
+		Expect(err).ToNot(HaveOccurred())
+
+		get(first)
+		first.Status.Phase = stewardv1alpha1.RestorePhaseAwaitingConfirmation
+		Expect(reconciler.Client.Status().Update(ctx, first)).To(Succeed())
+
+		first.Spec.Confirmed = true
+		Expect(reconciler.Client.Update(ctx, first)).To(Succeed())
+
+		_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(first)})
+		Expect(err).ToNot(HaveOccurred())
+
+		get(tcp)
+		Expect(tcp.GetAnnotations()).ToNot(HaveKey(stewardv1alpha1.RestoreOwnerAnnotation))
+		Expect(tcp.Spec.WritePermissions).To(Equal(stewardv1alpha1.Permissions{BlockDelete: true}))
+
+		_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(second)})
+		Expect(err).ToNot(HaveOccurred())
+
+		get(second)
+		Expect(second.Status.Phase).To(Equal(stewardv1alpha1.RestorePhaseRestoring))
+		Expect(second.Status.WritePermissions).To(Equal(&stewardv1alpha1.Permissions{BlockDelete: true}))
+	})
+
+	It("should take over the ownership of a deleted restore", func() {
+		tcp.SetAnnotations(map[string]string{stewardv1alpha1.RestoreOwnerAnnotation: "deleted"})
+		Expect(reconciler.Client.Update(ctx, tcp)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(first)})
+		Expect(err).ToNot(HaveOccurred())
+
+		get(tcp)
+		Expect(tcp.GetAnnotations()).To(HaveKeyWithValue(stewardv1alpha1.RestoreOwnerAnnotation, "first"))
+	})
+})
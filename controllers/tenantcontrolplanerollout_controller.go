@@ -0,0 +1,158 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/controllers/utils"
+	"github.com/butlerdotdev/steward/internal/rollout"
+)
+
+// TenantControlPlaneRollout upgrades the selected Tenant Control Planes in batches,
+// by setting their desired version: the upgrade is performed by the Tenant Control Plane reconciliation.
+type TenantControlPlaneRollout struct {
+	Client client.Client
+}
+
+//+kubebuilder:rbac:groups=steward.butlerlabs.dev,resources=tenantcontrolplanerollouts,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=steward.butlerlabs.dev,resources=tenantcontrolplanerollouts/status,verbs=get;update;patch
+
+func (r *TenantControlPlaneRollout) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	logger := log.FromContext(ctx)
+
+	var tcpRollout stewardv1alpha1.TenantControlPlaneRollout
+	if err := r.Client.Get(ctx, request.NamespacedName, &tcpRollout); err != nil {
+		if k8serrors.IsNotFound(err) {
+			logger.Info("resource may have been deleted, skipping")
+
+			return reconcile.Result{}, nil
+		}
+
+		logger.Error(err, "cannot retrieve the required resource")
+
+		return reconcile.Result{}, err
+	}
+
+	if utils.IsPaused(&tcpRollout) || tcpRollout.GetDeletionTimestamp() != nil {
+		return reconcile.Result{}, nil
+	}
+
+	tcps, err := r.selected(ctx, tcpRollout)
+	if err != nil {
+		logger.Error(err, "cannot list the selected Tenant Control Planes")
+
+		return reconcile.Result{}, err
+	}
+
+	status, starts, requeueAfter := rollout.Plan(tcpRollout, tcps, time.Now())
+
+	for _, i := range starts {
+		item := &status.TenantControlPlanes[i]
+
+		if err = r.start(ctx, tcpRollout.Spec, tcps[i]); err != nil {
+			// A rejected upgrade, such as a multi-minor one with the Direct strategy, is reported as failed.
+			rollout.Reject(item, tcps[i], err)
+
+			continue
+		}
+
+		logger.Info("Tenant Control Plane upgrade has been started", "tenantControlPlane", item.Namespace+"/"+item.Name, "version", tcpRollout.Spec.Version, "batch", *item.Batch)
+	}
+
+	rollout.Summarize(tcpRollout.Spec, &status)
+
+	if !equality.Semantic.DeepEqual(tcpRollout.Status, status) {
+		tcpRollout.Status = status
+
+		if err = r.Client.Status().Update(ctx, &tcpRollout); err != nil {
+			logger.Error(err, "cannot update resource status")
+
+			return reconcile.Result{}, err
+		}
+	}
+
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// selected returns the Tenant Control Planes matching the rollout selector, sorted by namespace and name.
+func (r *TenantControlPlaneRollout) selected(ctx context.Context, tcpRollout stewardv1alpha1.TenantControlPlaneRollout) ([]stewardv1alpha1.TenantControlPlane, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&tcpRollout.Spec.TenantControlPlaneSelector)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse the Tenant Control Plane selector: %w", err)
+	}
+
+	var tcpList stewardv1alpha1.TenantControlPlaneList
+	if err = r.Client.List(ctx, &tcpList, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, fmt.Errorf("cannot list the Tenant Control Planes: %w", err)
+	}
+
+	sort.Slice(tcpList.Items, func(i, j int) bool {
+		if tcpList.Items[i].GetNamespace() != tcpList.Items[j].GetNamespace() {
+			return tcpList.Items[i].GetNamespace() < tcpList.Items[j].GetNamespace()
+		}
+
+		return tcpList.Items[i].GetName() < tcpList.Items[j].GetName()
+	})
+
+	return tcpList.Items, nil
+}
+
+// start sets the rollout version, and addon image tags, to the Tenant Control Plane:
+// the admission webhooks validate the upgrade as for any other change.
+func (r *TenantControlPlaneRollout) start(ctx context.Context, spec stewardv1alpha1.TenantControlPlaneRolloutSpec, tcp stewardv1alpha1.TenantControlPlane) error {
+	original := tcp.DeepCopy()
+	rollout.Apply(spec, &tcp)
+
+	if err := r.Client.Patch(ctx, &tcp, client.MergeFrom(original)); err != nil {
+		return fmt.Errorf("cannot set the Tenant Control Plane version: %w", err)
+	}
+
+	return nil
+}
+
+func (r *TenantControlPlaneRollout) SetupWithManager(mgr controllerruntime.Manager) error {
+	return controllerruntime.NewControllerManagedBy(mgr).
+		// The status updates are not triggering a reconciliation, to avoid retrying a rejected upgrade in a loop.
+		For(&stewardv1alpha1.TenantControlPlaneRollout{}, builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.AnnotationChangedPredicate{}))).
+		Watches(&stewardv1alpha1.TenantControlPlane{}, handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, object client.Object) []reconcile.Request {
+			var rollouts stewardv1alpha1.TenantControlPlaneRolloutList
+			if err := mgr.GetClient().List(ctx, &rollouts); err != nil {
+				log.FromContext(ctx).Error(err, "cannot list the TenantControlPlaneRollouts")
+
+				return nil
+			}
+
+			var requests []reconcile.Request
+
+			for _, item := range rollouts.Items {
+				selector, err := metav1.LabelSelectorAsSelector(&item.Spec.TenantControlPlaneSelector)
+				if err != nil || !selector.Matches(labels.Set(object.GetLabels())) {
+					continue
+				}
+
+				requests = append(requests, reconcile.Request{NamespacedName: k8stypes.NamespacedName{Name: item.GetName()}})
+			}
+
+			return requests
+		})).
+		Complete(r)
+}
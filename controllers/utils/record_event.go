@@ -0,0 +1,151 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/internal/resources"
+	"github.com/butlerdotdev/steward/internal/resources/addons"
+	ds "github.com/butlerdotdev/steward/internal/resources/datastore"
+	"github.com/butlerdotdev/steward/internal/resources/konnectivity"
+	"github.com/butlerdotdev/steward/internal/resources/tcpproxy"
+)
+
+const (
+	EventReasonReconcileFailed           = "ReconcileFailed"
+	EventReasonResourceCreated           = "ResourceCreated"
+	EventReasonResourceUpdated           = "ResourceUpdated"
+	EventReasonCertificateCreated        = "CertificateCreated"
+	EventReasonCertificateRotated        = "CertificateRotated"
+	EventReasonCertificateExpiring       = "CertificateExpiring"
+	EventReasonKubeconfigCreated         = "KubeconfigCreated"
+	EventReasonKubeconfigRegenerated     = "KubeconfigRegenerated"
+	EventReasonDataStoreMigrationStarted = "DataStoreMigrationStarted"
+	EventReasonAddonInstalled            = "AddonInstalled"
+	EventReasonAddonUpdated              = "AddonUpdated"
+	EventReasonKubeadmPhaseCompleted     = "KubeadmPhaseCompleted"
+	EventReasonHibernated                = "Hibernated"
+	EventReasonWokenUp                   = "WokenUp"
+	EventReasonStorageQuotaExceeded      = "StorageQuotaExceeded"
+	EventReasonStorageQuotaRestored      = "StorageQuotaRestored"
+	EventReasonEtcdBootstrapped          = "EtcdBootstrapped"
+	EventReasonEtcdMemberAdded           = "EtcdMemberAdded"
+	EventReasonEtcdMemberRemoved         = "EtcdMemberRemoved"
+	EventReasonEtcdMemberRestarted       = "EtcdMemberRestarted"
+	EventReasonEtcdDefragmented          = "EtcdDefragmented"
+	EventReasonUpgradeRolledBack         = "UpgradeRolledBack"
+	EventReasonStorageVersionMigrated    = "StorageVersionMigrated"
+)
+
+// RecordResourceEvent emits a Normal Event on the Tenant Control Plane for the created or updated resource:
+// status-only changes are not reported to avoid flooding the Tenant Control Plane with Events.
+func RecordResourceEvent(recorder record.EventRecorder, tcp *stewardv1alpha1.TenantControlPlane, resource resources.Resource, result controllerutil.OperationResult) {
+	if recorder == nil {
+		return
+	}
+
+	reason, message := resourceEventReason(tcp, resource, result)
+	if reason == "" {
+		return
+	}
+
+	eventType := corev1.EventTypeNormal
+	if reason == EventReasonUpgradeRolledBack {
+		eventType = corev1.EventTypeWarning
+	}
+
+	recorder.Event(tcp, eventType, reason, message)
+}
+
+// RecordFailureEvent emits a Warning Event on the Tenant Control Plane reporting the resource which failed its handling.
+func RecordFailureEvent(recorder record.EventRecorder, tcp *stewardv1alpha1.TenantControlPlane, resource resources.Resource, err error) {
+	if recorder == nil {
+		return
+	}
+
+	recorder.Eventf(tcp, corev1.EventTypeWarning, EventReasonReconcileFailed, "handling of resource %s failed: %s", resource.GetName(), err.Error())
+}
+
+func resourceEventReason(tcp *stewardv1alpha1.TenantControlPlane, resource resources.Resource, result controllerutil.OperationResult) (string, string) {
+	created := result == controllerutil.OperationResultCreated
+
+	switch result {
+	case controllerutil.OperationResultCreated, controllerutil.OperationResultUpdated, resources.OperationResultEnqueueBack:
+	default:
+		return "", ""
+	}
+
+	switch typed := resource.(type) {
+	case *resources.CACertificate,
+		*resources.FrontProxyCACertificate,
+		*resources.SACertificate,
+		*resources.APIServerCertificate,
+		*resources.APIServerKubeletClientCertificate,
+		*resources.FrontProxyClientCertificate,
+		*ds.Certificate,
+		*konnectivity.CertificateResource:
+		if created {
+			return EventReasonCertificateCreated, fmt.Sprintf("certificate %s has been generated", resource.GetName())
+		}
+
+		return EventReasonCertificateRotated, fmt.Sprintf("certificate %s has been rotated", resource.GetName())
+	case *resources.KubeconfigResource, *konnectivity.KubeconfigResource:
+		if created {
+			return EventReasonKubeconfigCreated, fmt.Sprintf("kubeconfig %s has been generated", resource.GetName())
+		}
+
+		return EventReasonKubeconfigRegenerated, fmt.Sprintf("kubeconfig %s has been regenerated", resource.GetName())
+	case *ds.Migrate:
+		// The migration Job is updated, and the reconciliation enqueued back, several times along the migration.
+		if !typed.Started() {
+			return "", ""
+		}
+
+		return EventReasonDataStoreMigrationStarted, fmt.Sprintf("the migration to the DataStore %s has started, the writes are blocked", typed.DataStore())
+	case *addons.CoreDNS,
+		*addons.KubeProxy,
+		*konnectivity.Agent,
+		*konnectivity.ServiceAccountResource,
+		*konnectivity.ClusterRoleBindingResource,
+		*tcpproxy.ServiceAccountResource,
+		*tcpproxy.ClusterRoleResource,
+		*tcpproxy.ClusterRoleBindingResource,
+		*tcpproxy.ServiceResource,
+		*tcpproxy.Agent:
+		if created {
+			return EventReasonAddonInstalled, fmt.Sprintf("addon resource %s has been installed", resource.GetName())
+		}
+
+		return EventReasonAddonUpdated, fmt.Sprintf("addon resource %s has been updated", resource.GetName())
+	case *resources.KubernetesUpgrade:
+		rollback := tcp.Status.Kubernetes.Version.RolledBack
+		if rollback == nil {
+			break
+		}
+
+		return EventReasonUpgradeRolledBack, fmt.Sprintf("the upgrade to %s did not progress within the rollback deadline, reverted to %s", rollback.FailedVersion, rollback.Version)
+	case *resources.StorageVersionMigration:
+		// The progress of the migration is reported by the status, one resource at a time.
+		migration := tcp.Status.StorageVersionMigration
+		if migration == nil || migration.Phase != stewardv1alpha1.StorageVersionMigrationPhaseCompleted {
+			return "", ""
+		}
+
+		return EventReasonStorageVersionMigrated, fmt.Sprintf("the storage version migration of %d resources has been completed", len(migration.Resources))
+	case *resources.KubeadmPhase:
+		return EventReasonKubeadmPhaseCompleted, fmt.Sprintf("kubeadm phase %s has been completed", resource.GetName())
+	}
+
+	if created {
+		return EventReasonResourceCreated, fmt.Sprintf("resource %s has been created", resource.GetName())
+	}
+
+	return EventReasonResourceUpdated, fmt.Sprintf("resource %s has been updated", resource.GetName())
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package utils_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/controllers/utils"
+	"github.com/butlerdotdev/steward/internal/resources"
+	ds "github.com/butlerdotdev/steward/internal/resources/datastore"
+)
+
+var _ = Describe("RecordResourceEvent", func() {
+	var (
+		tcp      *stewardv1alpha1.TenantControlPlane
+		recorder *record.FakeRecorder
+	)
+
+	BeforeEach(func() {
+		tcp = &stewardv1alpha1.TenantControlPlane{ObjectMeta: metav1.ObjectMeta{Name: "tcp", Namespace: "default", UID: "tcp-uid"}}
+		recorder = record.NewFakeRecorder(10)
+	})
+
+	It("should report the created and updated resources only", func() {
+		utils.RecordResourceEvent(recorder, tcp, &resources.APIServerCertificate{}, controllerutil.OperationResultCreated)
+		utils.RecordResourceEvent(recorder, tcp, &resources.APIServerCertificate{}, controllerutil.OperationResultNone)
+		utils.RecordResourceEvent(recorder, tcp, &resources.APIServerCertificate{}, controllerutil.OperationResultUpdatedStatusOnly)
+
+		Expect(recorder.Events).To(HaveLen(1))
+		Expect(recorder.Events).To(Receive(HavePrefix("Normal CertificateCreated")))
+	})
+
+	Describe("the DataStore migration", func() {
+		var migrate func() *ds.Migrate
+
+		BeforeEach(func() {
+			scheme := runtime.NewScheme()
+			Expect(stewardv1alpha1.AddToScheme(scheme)).To(Succeed())
+			Expect(batchv1.AddToScheme(scheme)).To(Succeed())
+
+			tcp.Spec.DataStore = "target"
+			tcp.Status.Storage.DataStoreName = "origin"
+			tcp.Status.Kubernetes.Version.Status = &stewardv1alpha1.VersionReady
+
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				&stewardv1alpha1.DataStore{ObjectMeta: metav1.ObjectMeta{Name: "origin"}, Spec: stewardv1alpha1.DataStoreSpec{Driver: stewardv1alpha1.EtcdDriver}},
+				&stewardv1alpha1.DataStore{ObjectMeta: metav1.ObjectMeta{Name: "target"}, Spec: stewardv1alpha1.DataStoreSpec{Driver: stewardv1alpha1.EtcdDriver}},
+				// The Job is already running, the fake client doesn't assign the UID upon creation.
+				&batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "migrate-tcp-uid", Namespace: "steward-system", UID: "job-uid"}},
+				tcp,
+			).WithStatusSubresource(tcp).Build()
+			// migrate handles the migration resource as the Tenant Control Plane reconciliation does, reporting its event.
+			migrate = func() *ds.Migrate {
+				ctx := context.Background()
+				resource := &ds.Migrate{Client: c, StewardNamespace: "steward-system", MigrateImage: "steward:latest"}
+
+				result, err := resources.Handle(ctx, resource, tcp)
+				if err != nil || result == controllerutil.OperationResultNone {
+					return resource
+				}
+
+				Expect(utils.UpdateStatus(ctx, c, tcp, resource)).To(Succeed())
+				utils.RecordResourceEvent(recorder, tcp, resource, result)
+
+				return resource
+			}
+		})
+
+		It("should report the migration once the writes are blocked", func() {
+			migrate()
+			Expect(recorder.Events).To(Receive(Equal("Normal DataStoreMigrationStarted the migration to the DataStore target has started, the writes are blocked")))
+			// The Job being reconciled back doesn't report the migration again.
+			for range 3 {
+				migrate()
+			}
+
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		It("should report the live migration once it blocks the writes", func() {
+			tcp.SetAnnotations(map[string]string{stewardv1alpha1.MigrationModeAnnotation: string(stewardv1alpha1.DataStoreMigrationModeLive)})
+			// The keyspace is copied while the writes continue.
+			migrate()
+			Expect(recorder.Events).To(BeEmpty())
+
+			tcp.Status.Storage.Migration = &stewardv1alpha1.DataStoreMigrationStatus{DataStore: "target", Phase: stewardv1alpha1.DataStoreMigrationPhaseFreezing, JobUID: "job-uid"}
+
+			migrate()
+			Expect(recorder.Events).To(Receive(HavePrefix("Normal DataStoreMigrationStarted")))
+
+			migrate()
+			Expect(recorder.Events).To(BeEmpty())
+		})
+	})
+})
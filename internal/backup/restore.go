@@ -0,0 +1,42 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package backup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+)
+
+// RestoreSource is the archive a restore is reading from.
+type RestoreSource struct {
+	Storage stewardv1alpha1.BackupStorage
+	Name    string
+	// Checksum is the expected checksum of the archive, empty if unknown.
+	Checksum string
+}
+
+// ResolveRestoreSource returns the archive of the given restore:
+// when it refers to a backup, the latter must be completed.
+func ResolveRestoreSource(ctx context.Context, c client.Client, restore stewardv1alpha1.TenantControlPlaneRestore) (*RestoreSource, error) {
+	if archive := restore.Spec.Source.Archive; archive != nil {
+		return &RestoreSource{Storage: archive.Storage, Name: archive.Name, Checksum: archive.Checksum}, nil
+	}
+
+	var tcpBackup stewardv1alpha1.TenantControlPlaneBackup
+	if err := c.Get(ctx, k8stypes.NamespacedName{Namespace: restore.GetNamespace(), Name: restore.Spec.Source.Backup}, &tcpBackup); err != nil {
+		return nil, errors.Wrap(err, "cannot retrieve the TenantControlPlaneBackup")
+	}
+
+	if tcpBackup.Status.Phase != stewardv1alpha1.BackupPhaseCompleted {
+		return nil, fmt.Errorf("the TenantControlPlaneBackup %s is not completed", tcpBackup.GetName())
+	}
+
+	return &RestoreSource{Storage: tcpBackup.Spec.Storage, Name: ArchiveName(tcpBackup), Checksum: tcpBackup.Status.Checksum}, nil
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package backup
+
+import (
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/robfig/cron/v3"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+)
+
+// maxMissedSchedules limits the evaluation of the missed schedules,
+// such as when Steward has been stopped for a long period.
+const maxMissedSchedules = 1000
+
+type Schedule struct {
+	schedule cron.Schedule
+	location *time.Location
+}
+
+// ParseSchedule validates the cron expression and the time zone of a backup schedule.
+func ParseSchedule(schedule, timeZone string) (*Schedule, error) {
+	if timeZone == "" {
+		timeZone = "UTC"
+	}
+
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot load the time zone")
+	}
+
+	parsed, err := cron.ParseStandard(schedule)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot parse the cron expression")
+	}
+
+	return &Schedule{schedule: parsed, location: location}, nil
+}
+
+// Last returns the latest scheduled time in the (from, to] interval, or the zero time if none:
+// the missed schedules are not recovered, since only the latest keyspace is worth a backup.
+func (s *Schedule) Last(from, to time.Time) time.Time {
+	var last time.Time
+
+	next := s.schedule.Next(from.In(s.location))
+	for i := 0; i < maxMissedSchedules && !next.IsZero() && !next.After(to); i++ {
+		last, next = next, s.schedule.Next(next)
+	}
+
+	return last
+}
+
+// Next returns the first scheduled time after the given one.
+func (s *Schedule) Next(after time.Time) time.Time {
+	return s.schedule.Next(after.In(s.location))
+}
+
+// Prune returns the backups exceeding the history limits, retaining the most recent ones:
+// the pending and running backups are always retained.
+func Prune(backups []stewardv1alpha1.TenantControlPlaneBackup, successfulLimit, failedLimit int32) []stewardv1alpha1.TenantControlPlaneBackup {
+	sorted := make([]stewardv1alpha1.TenantControlPlaneBackup, len(backups))
+	copy(sorted, backups)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[j].CreationTimestamp.Before(&sorted[i].CreationTimestamp)
+	})
+
+	var successful, failed int32
+	var pruned []stewardv1alpha1.TenantControlPlaneBackup
+
+	for _, item := range sorted {
+		switch item.Status.Phase {
+		case stewardv1alpha1.BackupPhaseCompleted:
+			if successful++; successful > successfulLimit {
+				pruned = append(pruned, item)
+			}
+		case stewardv1alpha1.BackupPhaseFailed:
+			if failed++; failed > failedLimit {
+				pruned = append(pruned, item)
+			}
+		}
+	}
+
+	return pruned
+}
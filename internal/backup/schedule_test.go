@@ -0,0 +1,82 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package backup_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/internal/backup"
+)
+
+var _ = Describe("Backup schedule", func() {
+	It("should reject invalid cron expressions and time zones", func() {
+		_, err := backup.ParseSchedule("every night", "UTC")
+		Expect(err).To(HaveOccurred())
+
+		_, err = backup.ParseSchedule("0 2 * * *", "Mars/Olympus")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should return the latest scheduled time only", func() {
+		nightly, err := backup.ParseSchedule("0 2 * * *", "Europe/Rome")
+		Expect(err).ToNot(HaveOccurred())
+		// Three nights have been missed: 02:00 in Rome is 00:00 UTC, during summer time.
+		from, to := time.Date(2026, time.June, 1, 12, 0, 0, 0, time.UTC), time.Date(2026, time.June, 4, 12, 0, 0, 0, time.UTC)
+
+		Expect(nightly.Last(from, to).UTC()).To(Equal(time.Date(2026, time.June, 4, 0, 0, 0, 0, time.UTC)))
+		Expect(nightly.Next(to).UTC()).To(Equal(time.Date(2026, time.June, 5, 0, 0, 0, 0, time.UTC)))
+	})
+
+	It("should not schedule when no time has been crossed", func() {
+		nightly, err := backup.ParseSchedule("0 2 * * *", "")
+		Expect(err).ToNot(HaveOccurred())
+
+		from, to := time.Date(2026, time.June, 1, 3, 0, 0, 0, time.UTC), time.Date(2026, time.June, 1, 23, 0, 0, 0, time.UTC)
+		Expect(nightly.Last(from, to).IsZero()).To(BeTrue())
+	})
+})
+
+var _ = Describe("Backup history", func() {
+	newBackup := func(name string, phase stewardv1alpha1.BackupPhase, hour int) stewardv1alpha1.TenantControlPlaneBackup {
+		return stewardv1alpha1.TenantControlPlaneBackup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(time.Date(2026, time.June, 1, hour, 0, 0, 0, time.UTC)),
+			},
+			Status: stewardv1alpha1.TenantControlPlaneBackupStatus{Phase: phase},
+		}
+	}
+
+	It("should prune the oldest backups exceeding the limits", func() {
+		backups := []stewardv1alpha1.TenantControlPlaneBackup{
+			newBackup("completed-1", stewardv1alpha1.BackupPhaseCompleted, 1),
+			newBackup("failed-2", stewardv1alpha1.BackupPhaseFailed, 2),
+			newBackup("completed-3", stewardv1alpha1.BackupPhaseCompleted, 3),
+			newBackup("failed-4", stewardv1alpha1.BackupPhaseFailed, 4),
+			newBackup("completed-5", stewardv1alpha1.BackupPhaseCompleted, 5),
+			newBackup("running-6", stewardv1alpha1.BackupPhaseRunning, 6),
+		}
+
+		var names []string
+		for _, item := range backup.Prune(backups, 2, 1) {
+			names = append(names, item.GetName())
+		}
+
+		Expect(names).To(ConsistOf("completed-1", "failed-2"))
+	})
+
+	It("should retain the pending and running backups regardless of the limits", func() {
+		backups := []stewardv1alpha1.TenantControlPlaneBackup{
+			newBackup("pending-1", stewardv1alpha1.BackupPhasePending, 1),
+			newBackup("running-2", stewardv1alpha1.BackupPhaseRunning, 2),
+		}
+
+		Expect(backup.Prune(backups, 0, 0)).To(BeEmpty())
+	})
+})
@@ -0,0 +1,218 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/internal/datastore"
+)
+
+const (
+	// VolumeMountPath is where the backup Jobs mount the PersistentVolumeClaim storing the archives.
+	VolumeMountPath = "/var/lib/steward/backups"
+	// RestoreWorkDir is where the restore Jobs mount the scratch volume storing the verified copy of the archive.
+	RestoreWorkDir = "/var/lib/steward/restore"
+
+	S3AccessKeyIDKey     = "accessKeyID"
+	S3SecretAccessKeyKey = "secretAccessKey"
+)
+
+// Upload is an archive being written to the storage:
+// it is persisted by Close, or discarded by Abort.
+type Upload interface {
+	io.Writer
+	Close() error
+	Abort()
+}
+
+type Storage interface {
+	// Create starts the upload of the archive with the given name.
+	Create(ctx context.Context, name string) (Upload, error)
+	// Open returns the content of the archive with the given name.
+	Open(ctx context.Context, name string) (io.ReadCloser, error)
+	// Location returns the URL of the archive with the given name.
+	Location(name string) string
+}
+
+// ArchiveName returns the name of the archive of the given backup, relative to the storage root.
+func ArchiveName(backup stewardv1alpha1.TenantControlPlaneBackup) string {
+	var prefix string
+	if s3 := backup.Spec.Storage.S3; s3 != nil {
+		prefix = s3.Prefix
+	}
+
+	return path.Join(prefix, backup.GetNamespace(), backup.Spec.TenantControlPlane, backup.GetName()+datastore.ArchiveExtension)
+}
+
+// NewStorage returns the Storage declared by the backup spec:
+// the S3 credentials are retrieved from the given Namespace.
+func NewStorage(ctx context.Context, client client.Client, namespace string, spec stewardv1alpha1.BackupStorage) (Storage, error) {
+	switch {
+	case spec.PersistentVolumeClaim != nil:
+		return &VolumeStorage{
+			Root:      filepath.Join(VolumeMountPath, spec.PersistentVolumeClaim.Path),
+			ClaimName: spec.PersistentVolumeClaim.ClaimName,
+			Path:      spec.PersistentVolumeClaim.Path,
+		}, nil
+	case spec.S3 != nil:
+		var secret corev1.Secret
+		if err := client.Get(ctx, k8stypes.NamespacedName{Namespace: namespace, Name: spec.S3.CredentialsSecret.Name}, &secret); err != nil {
+			return nil, errors.Wrap(err, "cannot retrieve the object storage credentials")
+		}
+
+		s3Client, err := minio.New(spec.S3.Endpoint, &minio.Options{
+			Creds:  credentials.NewStaticV4(string(secret.Data[S3AccessKeyIDKey]), string(secret.Data[S3SecretAccessKeyKey]), ""),
+			Secure: !spec.S3.Insecure,
+			Region: spec.S3.Region,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot create the object storage client")
+		}
+
+		return &S3Storage{Client: s3Client, Bucket: spec.S3.Bucket}, nil
+	default:
+		return nil, fmt.Errorf("no backup storage has been specified")
+	}
+}
+
+// VolumeStorage stores the archives in the directory where the PersistentVolumeClaim is mounted.
+type VolumeStorage struct {
+	Root      string
+	ClaimName string
+	Path      string
+}
+
+func (v *VolumeStorage) Create(_ context.Context, name string) (Upload, error) {
+	filename := filepath.Join(v.Root, filepath.FromSlash(name))
+
+	if err := os.MkdirAll(filepath.Dir(filename), 0o750); err != nil {
+		return nil, errors.Wrap(err, "cannot create the archive directory")
+	}
+	// The archive is written to a temporary file, renamed upon completion:
+	// a failed backup doesn't leave a truncated archive behind.
+	file, err := os.CreateTemp(filepath.Dir(filename), "."+filepath.Base(filename)+".*")
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create the archive file")
+	}
+
+	return &volumeUpload{File: file, filename: filename}, nil
+}
+
+func (v *VolumeStorage) Open(_ context.Context, name string) (io.ReadCloser, error) {
+	file, err := os.Open(filepath.Join(v.Root, filepath.FromSlash(name)))
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot open the archive file")
+	}
+
+	return file, nil
+}
+
+func (v *VolumeStorage) Location(name string) string {
+	return fmt.Sprintf("pvc://%s/%s", v.ClaimName, path.Join(v.Path, name))
+}
+
+type volumeUpload struct {
+	*os.File
+
+	filename string
+}
+
+func (u *volumeUpload) Close() error {
+	if err := u.File.Sync(); err != nil {
+		u.Abort()
+
+		return errors.Wrap(err, "cannot sync the archive file")
+	}
+
+	if err := u.File.Close(); err != nil {
+		_ = os.Remove(u.File.Name())
+
+		return errors.Wrap(err, "cannot close the archive file")
+	}
+
+	return os.Rename(u.File.Name(), u.filename)
+}
+
+func (u *volumeUpload) Abort() {
+	_ = u.File.Close()
+	_ = os.Remove(u.File.Name())
+}
+
+// S3Storage stores the archives in a bucket of an S3-compatible object storage.
+type S3Storage struct {
+	Client *minio.Client
+	Bucket string
+}
+
+func (s *S3Storage) Create(ctx context.Context, name string) (Upload, error) {
+	ctx, cancelFn := context.WithCancel(ctx)
+	reader, writer := io.Pipe()
+	// The archive is streamed as a multipart upload, since its size is not known in advance:
+	// the object is not created if the upload is aborted.
+	upload := &s3Upload{PipeWriter: writer, cancelFn: cancelFn, done: make(chan error, 1)}
+
+	go func() {
+		_, err := s.Client.PutObject(ctx, s.Bucket, name, reader, -1, minio.PutObjectOptions{ContentType: "application/gzip"})
+		_ = reader.CloseWithError(err)
+
+		upload.done <- err
+	}()
+
+	return upload, nil
+}
+
+func (s *S3Storage) Open(ctx context.Context, name string) (io.ReadCloser, error) {
+	object, err := s.Client.GetObject(ctx, s.Bucket, name, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot download the archive")
+	}
+
+	return object, nil
+}
+
+func (s *S3Storage) Location(name string) string {
+	return fmt.Sprintf("s3://%s/%s", s.Bucket, name)
+}
+
+type s3Upload struct {
+	*io.PipeWriter
+
+	cancelFn context.CancelFunc
+	done     chan error
+}
+
+func (u *s3Upload) Close() error {
+	defer u.cancelFn()
+
+	if err := u.PipeWriter.Close(); err != nil {
+		return err
+	}
+
+	if err := <-u.done; err != nil {
+		return errors.Wrap(err, "cannot upload the archive")
+	}
+
+	return nil
+}
+
+func (u *s3Upload) Abort() {
+	u.cancelFn()
+	_ = u.PipeWriter.CloseWithError(fmt.Errorf("the upload has been aborted"))
+
+	<-u.done
+}
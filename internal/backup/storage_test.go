@@ -0,0 +1,181 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package backup_test
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/internal/backup"
+	"github.com/butlerdotdev/steward/internal/datastore"
+)
+
+var _ = Describe("Backup storage", func() {
+	var (
+		ctx     context.Context
+		storage *backup.VolumeStorage
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		storage = &backup.VolumeStorage{Root: GinkgoT().TempDir(), ClaimName: "backups", Path: "steward"}
+	})
+
+	It("should name the archives after the Namespace, the Tenant Control Plane, and the backup", func() {
+		tcpBackup := stewardv1alpha1.TenantControlPlaneBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: "nightly-1", Namespace: "default"},
+			Spec: stewardv1alpha1.TenantControlPlaneBackupSpec{
+				TenantControlPlane: "dev",
+				Storage: stewardv1alpha1.BackupStorage{
+					S3: &stewardv1alpha1.BackupS3Storage{Bucket: "backups", Prefix: "clusters"},
+				},
+			},
+		}
+
+		Expect(backup.ArchiveName(tcpBackup)).To(Equal("clusters/default/dev/nightly-1.jsonl.gz"))
+		Expect(storage.Location("default/dev/nightly-1.jsonl.gz")).To(Equal("pvc://backups/steward/default/dev/nightly-1.jsonl.gz"))
+	})
+
+	It("should store the archive with the recorded size and checksum", func() {
+		upload, err := storage.Create(ctx, "default/dev/nightly-1.jsonl.gz")
+		Expect(err).ToNot(HaveOccurred())
+
+		archive, err := datastore.NewArchiveWriter(upload, datastore.ArchiveHeader{Driver: "etcd", TenantControlPlane: "dev", Schema: "default_dev"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(archive.Write("/registry/namespaces/default", []byte("default"))).To(Succeed())
+		Expect(archive.Write("/registry/namespaces/kube-system", []byte("kube-system"))).To(Succeed())
+		archive.SetRevision(42)
+		Expect(archive.Close()).To(Succeed())
+		Expect(upload.Close()).To(Succeed())
+
+		content, err := os.ReadFile(filepath.Join(storage.Root, "default", "dev", "nightly-1.jsonl.gz"))
+		Expect(err).ToNot(HaveOccurred())
+
+		sum := sha256.Sum256(content)
+		Expect(archive.Checksum()).To(Equal("sha256:" + hex.EncodeToString(sum[:])))
+		Expect(archive.Size()).To(Equal(int64(len(content))))
+		Expect(archive.Keys()).To(Equal(int64(2)))
+		Expect(archive.Revision()).To(Equal(int64(42)))
+
+		file, err := os.Open(filepath.Join(storage.Root, "default", "dev", "nightly-1.jsonl.gz"))
+		Expect(err).ToNot(HaveOccurred())
+		defer file.Close()
+
+		reader, err := gzip.NewReader(file)
+		Expect(err).ToNot(HaveOccurred())
+
+		scanner := bufio.NewScanner(reader)
+		Expect(scanner.Scan()).To(BeTrue())
+
+		var header datastore.ArchiveHeader
+		Expect(json.Unmarshal(scanner.Bytes(), &header)).To(Succeed())
+		Expect(header.Version).To(Equal(datastore.ArchiveVersion))
+		Expect(header.Schema).To(Equal("default_dev"))
+
+		var keys []string
+		for scanner.Scan() {
+			var record datastore.ArchiveRecord
+			Expect(json.Unmarshal(scanner.Bytes(), &record)).To(Succeed())
+
+			keys = append(keys, record.Key)
+		}
+
+		Expect(keys).To(Equal([]string{"/registry/namespaces/default", "/registry/namespaces/kube-system"}))
+	})
+
+	It("should read back the archive, verifying its checksum", func() {
+		upload, err := storage.Create(ctx, "default/dev/nightly-1.jsonl.gz")
+		Expect(err).ToNot(HaveOccurred())
+
+		writer, err := datastore.NewArchiveWriter(upload, datastore.ArchiveHeader{Driver: "MySQL", TenantControlPlane: "dev", Schema: "default_dev"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(writer.Write("/registry/namespaces/default", []byte("default"))).To(Succeed())
+		Expect(writer.Write("/registry/namespaces/kube-system", []byte("kube-system"))).To(Succeed())
+		Expect(writer.Close()).To(Succeed())
+		Expect(upload.Close()).To(Succeed())
+
+		content, err := storage.Open(ctx, "default/dev/nightly-1.jsonl.gz")
+		Expect(err).ToNot(HaveOccurred())
+		defer content.Close()
+
+		reader, err := datastore.NewArchiveReader(content)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reader.Header().Driver).To(Equal("MySQL"))
+		Expect(reader.Header().Schema).To(Equal("default_dev"))
+
+		values := map[string]string{}
+		for {
+			record, nErr := reader.Next()
+			if errors.Is(nErr, io.EOF) {
+				break
+			}
+
+			Expect(nErr).ToNot(HaveOccurred())
+
+			values[record.Key] = string(record.Value)
+		}
+
+		Expect(values).To(Equal(map[string]string{"/registry/namespaces/default": "default", "/registry/namespaces/kube-system": "kube-system"}))
+		Expect(reader.Keys()).To(Equal(writer.Keys()))
+		Expect(reader.Size()).To(Equal(writer.Size()))
+		Expect(reader.Checksum()).To(Equal(writer.Checksum()))
+	})
+
+	It("should reject a truncated archive", func() {
+		upload, err := storage.Create(ctx, "default/dev/nightly-1.jsonl.gz")
+		Expect(err).ToNot(HaveOccurred())
+
+		writer, err := datastore.NewArchiveWriter(upload, datastore.ArchiveHeader{Driver: "etcd", TenantControlPlane: "dev", Schema: "default_dev"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(writer.Write("/registry/namespaces/default", []byte("default"))).To(Succeed())
+		Expect(writer.Close()).To(Succeed())
+		Expect(upload.Close()).To(Succeed())
+
+		filename := filepath.Join(storage.Root, "default", "dev", "nightly-1.jsonl.gz")
+		content, err := os.ReadFile(filename)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(os.WriteFile(filename, content[:len(content)-8], 0o600)).To(Succeed())
+
+		file, err := storage.Open(ctx, "default/dev/nightly-1.jsonl.gz")
+		Expect(err).ToNot(HaveOccurred())
+		defer file.Close()
+
+		reader, err := datastore.NewArchiveReader(file)
+		Expect(err).ToNot(HaveOccurred())
+
+		for {
+			if _, err = reader.Next(); err != nil {
+				break
+			}
+		}
+
+		Expect(err).ToNot(MatchError(io.EOF))
+	})
+
+	It("should not leave any file behind an aborted upload", func() {
+		upload, err := storage.Create(ctx, "default/dev/nightly-1.jsonl.gz")
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = upload.Write([]byte("partial"))
+		Expect(err).ToNot(HaveOccurred())
+		upload.Abort()
+
+		entries, err := os.ReadDir(filepath.Join(storage.Root, "default", "dev"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entries).To(BeEmpty())
+	})
+})
@@ -40,6 +40,44 @@ const (
 	kineUDSPath                           = kineUDSFolder + "/kine"
 	dataStoreCertsVolumeName              = "kine-config"
 	kineVolumeCertName                    = "kine-certs"
+	kineSQLiteVolumeName                  = "kine-sqlite"
+	kineSQLiteFolder                      = "/var/lib/kine"
+	encryptionConfigurationVolumeName     = "encryption-configuration"
+	kmsPluginSocketVolumeName             = "kms-plugin-socket"
+	auditPolicyVolumeName                 = "audit-policy"
+	auditLogsVolumeName                   = "audit-logs"
+	auditWebhookVolumeName                = "audit-webhook-kubeconfig"
+	authenticationConfigurationVolumeName = "authentication-configuration"
+	authorizationConfigurationVolumeName  = "authorization-configuration"
+)
+
+const (
+	// EncryptionConfigurationFileName is the Secret key containing the API Server EncryptionConfiguration.
+	EncryptionConfigurationFileName = "encryption-configuration.yaml"
+	// EncryptionConfigurationTemplateLabel contains the checksum of the EncryptionConfiguration used by the API Server:
+	// it triggers a rollout upon changes, and it's used to detect the completion of the key rotation phases.
+	EncryptionConfigurationTemplateLabel = "component.steward.butlerlabs.dev/encryption-configuration"
+
+	// KMSPluginEndpoint is the gRPC endpoint the KMS plugin sidecar must listen to.
+	KMSPluginEndpoint = "unix://" + kmsPluginSocketFolder + "/socket.sock"
+
+	// ServiceAccountIssuer is the issuer of the Tenant Control Plane service account tokens.
+	ServiceAccountIssuer = "https://kubernetes.default.svc.cluster.local"
+	// AuditPolicyFileName is the ConfigMap key containing the API Server audit Policy.
+	AuditPolicyFileName = "audit-policy.yaml"
+	// AuthenticationConfigurationFileName is the ConfigMap key containing the API Server AuthenticationConfiguration.
+	AuthenticationConfigurationFileName = "authentication-configuration.yaml"
+	// AuthorizationConfigurationFileName is the ConfigMap key containing the API Server AuthorizationConfiguration.
+	AuthorizationConfigurationFileName = "authorization-configuration.yaml"
+
+	encryptionConfigurationFolder = "/etc/kubernetes/encryption"
+	kmsPluginSocketFolder         = "/var/run/kmsplugin"
+	auditPolicyFolder             = "/etc/kubernetes/audit"
+	auditLogsFolder               = "/var/log/kubernetes/audit"
+	auditWebhookFolder            = "/etc/kubernetes/audit-webhook"
+	auditWebhookFileName          = "webhook.kubeconfig"
+	authenticationFolder          = "/etc/kubernetes/authentication"
+	authorizationFolder           = "/etc/kubernetes/authorization"
 )
 
 const (
@@ -50,6 +88,10 @@ const (
 	schedulerContainerName    = "kube-scheduler"
 	kineContainerName         = "kine"
 	kineInitContainerName     = "chmod"
+	kmsPluginContainerName    = "kms-plugin"
+	auditLogShipperContainer  = "audit-log-shipper"
+	// defaultProgressDeadlineSeconds is the Kubernetes default progress deadline, used with no rollback deadline.
+	defaultProgressDeadlineSeconds = 600
 )
 
 type DataStoreOverrides struct {
@@ -82,6 +124,7 @@ func (d Deployment) Build(ctx context.Context, deployment *appsv1.Deployment, te
 	d.setTopologySpreadConstraints(&deployment.Spec, tenantControlPlane.Spec.ControlPlane.Deployment.TopologySpreadConstraints)
 	d.setRuntimeClass(&deployment.Spec.Template.Spec, tenantControlPlane)
 	d.setReplicas(&deployment.Spec, tenantControlPlane)
+	d.setProgressDeadline(&deployment.Spec, tenantControlPlane)
 	d.resetKubeAPIServerFlags(deployment, tenantControlPlane)
 	d.setInitContainers(&deployment.Spec.Template.Spec, tenantControlPlane)
 	d.setAdditionalContainers(&deployment.Spec.Template.Spec, tenantControlPlane)
@@ -97,6 +140,8 @@ func (d Deployment) setContainers(podSpec *corev1.PodSpec, tcp stewardv1alpha1.T
 	d.buildScheduler(podSpec, tcp)
 	d.buildControllerManager(podSpec, tcp)
 	d.buildKine(podSpec, tcp)
+	d.buildKMSPlugin(podSpec, tcp)
+	d.buildAuditLogShipper(podSpec, tcp)
 }
 
 // setInitContainers allows adding extra init containers from the user-space:
@@ -130,6 +175,16 @@ func (d Deployment) setAdditionalContainers(podSpec *corev1.PodSpec, tcp steward
 }
 
 func (d Deployment) setStrategy(deployment *appsv1.DeploymentSpec, tcp stewardv1alpha1.TenantControlPlane) {
+	// The SQLite database is stored on a ReadWriteOnce volume, and it must be opened by a single Kine instance:
+	// the old Pod must be terminated before the new one is started.
+	if d.DataStore.Spec.Driver == stewardv1alpha1.KineSQLiteDriver {
+		deployment.Strategy = appsv1.DeploymentStrategy{
+			Type: appsv1.RecreateDeploymentStrategyType,
+		}
+
+		return
+	}
+
 	deployment.Strategy = appsv1.DeploymentStrategy{
 		Type: tcp.Spec.ControlPlane.Deployment.Strategy.Type,
 	}
@@ -175,6 +230,11 @@ func (d Deployment) setVolumes(podSpec *corev1.PodSpec, tcp stewardv1alpha1.Tena
 		d.buildSchedulerVolume,
 		d.buildControllerManagerVolume,
 		d.buildKineVolume,
+		d.buildEncryptionConfigurationVolume,
+		d.buildKMSPluginVolume,
+		d.buildAuditVolumes,
+		d.buildAuthenticationConfigurationVolume,
+		d.buildAuthorizationConfigurationVolume,
 	} {
 		fn(podSpec, tcp)
 	}
@@ -356,7 +416,7 @@ func (d Deployment) buildScheduler(podSpec *corev1.PodSpec, tenantControlPlane s
 	args["--leader-elect"] = "true"
 
 	podSpec.Containers[index].Name = schedulerContainerName
-	podSpec.Containers[index].Image = tenantControlPlane.Spec.ControlPlane.Deployment.RegistrySettings.KubeSchedulerImage(tenantControlPlane.Spec.Kubernetes.Version)
+	podSpec.Containers[index].Image = tenantControlPlane.Spec.ControlPlane.Deployment.RegistrySettings.KubeSchedulerImage(tenantControlPlane.GetKubernetesVersion())
 	podSpec.Containers[index].Command = []string{"kube-scheduler"}
 	podSpec.Containers[index].Args = utilities.ArgsFromMapToSlice(args)
 	podSpec.Containers[index].LivenessProbe = &corev1.Probe{
@@ -448,7 +508,7 @@ func (d Deployment) buildControllerManager(podSpec *corev1.PodSpec, tenantContro
 	}
 
 	podSpec.Containers[index].Name = "kube-controller-manager"
-	podSpec.Containers[index].Image = tenantControlPlane.Spec.ControlPlane.Deployment.RegistrySettings.KubeControllerManagerImage(tenantControlPlane.Spec.Kubernetes.Version)
+	podSpec.Containers[index].Image = tenantControlPlane.Spec.ControlPlane.Deployment.RegistrySettings.KubeControllerManagerImage(tenantControlPlane.GetKubernetesVersion())
 	podSpec.Containers[index].Command = []string{"kube-controller-manager"}
 	podSpec.Containers[index].Args = utilities.ArgsFromMapToSlice(args)
 	podSpec.Containers[index].LivenessProbe = &corev1.Probe{
@@ -540,6 +600,30 @@ func (d Deployment) ensureVolumeMount(in *[]corev1.VolumeMount, desired corev1.V
 	*in = list
 }
 
+// removeVolumeMount removes the named volumeMount, if present.
+func (d Deployment) removeVolumeMount(in *[]corev1.VolumeMount, name string) {
+	if found, index := utilities.HasNamedVolumeMount(*in, name); found {
+		var volumeMounts []corev1.VolumeMount
+
+		volumeMounts = append(volumeMounts, (*in)[:index]...)
+		volumeMounts = append(volumeMounts, (*in)[index+1:]...)
+
+		*in = volumeMounts
+	}
+}
+
+// removeVolume removes the named volume, if present.
+func (d Deployment) removeVolume(podSpec *corev1.PodSpec, name string) {
+	if found, index := utilities.HasNamedVolume(podSpec.Volumes, name); found {
+		var volumes []corev1.Volume
+
+		volumes = append(volumes, podSpec.Volumes[:index]...)
+		volumes = append(volumes, podSpec.Volumes[index+1:]...)
+
+		podSpec.Volumes = volumes
+	}
+}
+
 // initVolumeMounts is responsible to create the idempotent slice of corev1.VolumeMount:
 // firstSystemVolumeMountName must refer to the first Steward-space volume mount to detect properly user-space ones.
 func (d Deployment) initVolumeMounts(firstSystemVolumeMountName string, actual []corev1.VolumeMount, extra ...corev1.VolumeMount) []corev1.VolumeMount {
@@ -566,7 +650,7 @@ func (d Deployment) buildKubeAPIServer(podSpec *corev1.PodSpec, tenantControlPla
 
 	podSpec.Containers[index].Name = apiServerContainerName
 	podSpec.Containers[index].Args = utilities.ArgsFromMapToSlice(args)
-	podSpec.Containers[index].Image = tenantControlPlane.Spec.ControlPlane.Deployment.RegistrySettings.KubeAPIServerImage(tenantControlPlane.Spec.Kubernetes.Version)
+	podSpec.Containers[index].Image = tenantControlPlane.Spec.ControlPlane.Deployment.RegistrySettings.KubeAPIServerImage(tenantControlPlane.GetKubernetesVersion())
 	podSpec.Containers[index].Command = []string{"kube-apiserver"}
 	podSpec.Containers[index].LivenessProbe = &corev1.Probe{
 		ProbeHandler: corev1.ProbeHandler{
@@ -622,7 +706,8 @@ func (d Deployment) buildKubeAPIServer(podSpec *corev1.PodSpec, tenantControlPla
 
 	if d.DataStore.Spec.Driver == stewardv1alpha1.KineMySQLDriver ||
 		d.DataStore.Spec.Driver == stewardv1alpha1.KinePostgreSQLDriver ||
-		d.DataStore.Spec.Driver == stewardv1alpha1.KineNatsDriver {
+		d.DataStore.Spec.Driver == stewardv1alpha1.KineNatsDriver ||
+		d.DataStore.Spec.Driver == stewardv1alpha1.KineSQLiteDriver {
 		d.ensureVolumeMount(&volumeMounts, corev1.VolumeMount{
 			Name:      kineUDSVolume,
 			ReadOnly:  false,
@@ -651,6 +736,48 @@ func (d Deployment) buildKubeAPIServer(podSpec *corev1.PodSpec, tenantControlPla
 		MountPath: "/usr/local/share/ca-certificates",
 	})
 
+	if len(tenantControlPlane.Status.EncryptionAtRest.SecretName) > 0 {
+		d.ensureVolumeMount(&volumeMounts, corev1.VolumeMount{
+			Name:      encryptionConfigurationVolumeName,
+			ReadOnly:  true,
+			MountPath: encryptionConfigurationFolder,
+		})
+	} else {
+		d.removeVolumeMount(&volumeMounts, encryptionConfigurationVolumeName)
+	}
+
+	if tenantControlPlane.Spec.ControlPlane.Deployment.KMSPlugin != nil {
+		d.ensureVolumeMount(&volumeMounts, corev1.VolumeMount{
+			Name:      kmsPluginSocketVolumeName,
+			ReadOnly:  false,
+			MountPath: kmsPluginSocketFolder,
+		})
+	} else {
+		d.removeVolumeMount(&volumeMounts, kmsPluginSocketVolumeName)
+	}
+
+	d.buildAuditVolumeMounts(&volumeMounts, tenantControlPlane)
+
+	if d.isAuthenticationEnabled(tenantControlPlane) {
+		d.ensureVolumeMount(&volumeMounts, corev1.VolumeMount{
+			Name:      authenticationConfigurationVolumeName,
+			ReadOnly:  true,
+			MountPath: authenticationFolder,
+		})
+	} else {
+		d.removeVolumeMount(&volumeMounts, authenticationConfigurationVolumeName)
+	}
+
+	if d.isAuthorizationEnabled(tenantControlPlane) {
+		d.ensureVolumeMount(&volumeMounts, corev1.VolumeMount{
+			Name:      authorizationConfigurationVolumeName,
+			ReadOnly:  true,
+			MountPath: authorizationFolder,
+		})
+	} else {
+		d.removeVolumeMount(&volumeMounts, authorizationConfigurationVolumeName)
+	}
+
 	podSpec.Containers[index].VolumeMounts = volumeMounts
 
 	switch {
@@ -695,7 +822,7 @@ func (d Deployment) buildKubeAPIServerCommand(tenantControlPlane stewardv1alpha1
 		"--requestheader-group-headers":        "X-Remote-Group",
 		"--requestheader-username-headers":     "X-Remote-User",
 		"--secure-port":                        fmt.Sprintf("%d", tenantControlPlane.Spec.NetworkProfile.Port),
-		"--service-account-issuer":             "https://kubernetes.default.svc.cluster.local",
+		"--service-account-issuer":             ServiceAccountIssuer,
 		"--service-account-key-file":           path.Join(v1beta3.DefaultCertificatesDir, constants.ServiceAccountPublicKeyName),
 		"--service-account-signing-key-file":   path.Join(v1beta3.DefaultCertificatesDir, constants.ServiceAccountPrivateKeyName),
 		"--tls-cert-file":                      path.Join(v1beta3.DefaultCertificatesDir, constants.APIServerCertName),
@@ -703,7 +830,7 @@ func (d Deployment) buildKubeAPIServerCommand(tenantControlPlane stewardv1alpha1
 	}
 
 	switch d.DataStore.Spec.Driver {
-	case stewardv1alpha1.KineMySQLDriver, stewardv1alpha1.KinePostgreSQLDriver, stewardv1alpha1.KineNatsDriver:
+	case stewardv1alpha1.KineMySQLDriver, stewardv1alpha1.KinePostgreSQLDriver, stewardv1alpha1.KineNatsDriver, stewardv1alpha1.KineSQLiteDriver:
 		desiredArgs["--etcd-servers"] = "unix://" + kineUDSPath
 	case stewardv1alpha1.EtcdDriver:
 		httpsEndpoints := make([]string, 0, len(d.DataStore.Spec.Endpoints))
@@ -724,6 +851,29 @@ func (d Deployment) buildKubeAPIServerCommand(tenantControlPlane stewardv1alpha1
 		desiredArgs["--etcd-servers-overrides"] = d.etcdServersOverrides()
 	}
 
+	if len(tenantControlPlane.Status.EncryptionAtRest.SecretName) > 0 {
+		desiredArgs["--encryption-provider-config"] = path.Join(encryptionConfigurationFolder, EncryptionConfigurationFileName)
+	} else {
+		utilities.ArgsRemoveFlag(current, "--encryption-provider-config")
+	}
+
+	d.buildAuditArgs(desiredArgs, current, tenantControlPlane)
+
+	if d.isAuthenticationEnabled(tenantControlPlane) {
+		desiredArgs["--authentication-config"] = path.Join(authenticationFolder, AuthenticationConfigurationFileName)
+	} else {
+		utilities.ArgsRemoveFlag(current, "--authentication-config")
+	}
+	// The authorization modes flag is mutually exclusive with the AuthorizationConfiguration.
+	if d.isAuthorizationEnabled(tenantControlPlane) {
+		delete(desiredArgs, "--authorization-mode")
+		utilities.ArgsRemoveFlag(current, "--authorization-mode")
+
+		desiredArgs["--authorization-config"] = path.Join(authorizationFolder, AuthorizationConfigurationFileName)
+	} else {
+		utilities.ArgsRemoveFlag(current, "--authorization-config")
+	}
+
 	// When tcp-proxy is enabled, disable the built-in endpoint reconciler.
 	// tcp-proxy manages the kubernetes EndpointSlice directly inside the
 	// tenant cluster, so kube-apiserver must not fight it for ownership.
@@ -769,7 +919,7 @@ func (d Deployment) secretProjection(secretName, certKeyName, keyName string) *c
 }
 
 func (d Deployment) removeKineVolumes(podSpec *corev1.PodSpec) {
-	for _, volumeName := range []string{kineVolumeCertName, dataStoreCertsVolumeName, kineUDSVolume} {
+	for _, volumeName := range []string{kineVolumeCertName, dataStoreCertsVolumeName, kineUDSVolume, kineSQLiteVolumeName} {
 		if found, index := utilities.HasNamedVolume(podSpec.Volumes, volumeName); found {
 			var volumes []corev1.Volume
 
@@ -824,6 +974,111 @@ func (d Deployment) buildKineVolume(podSpec *corev1.PodSpec, tcp stewardv1alpha1
 	podSpec.Volumes[index].VolumeSource = corev1.VolumeSource{
 		EmptyDir: &corev1.EmptyDirVolumeSource{},
 	}
+
+	if d.DataStore.Spec.Driver != stewardv1alpha1.KineSQLiteDriver || tcp.Status.Storage.Volume == nil {
+		return
+	}
+
+	found, index = utilities.HasNamedVolume(podSpec.Volumes, kineSQLiteVolumeName)
+	if !found {
+		index = len(podSpec.Volumes)
+		podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{})
+	}
+
+	podSpec.Volumes[index].Name = kineSQLiteVolumeName
+	podSpec.Volumes[index].VolumeSource = corev1.VolumeSource{
+		PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+			ClaimName: tcp.Status.Storage.Volume.ClaimName,
+		},
+	}
+}
+
+func (d Deployment) buildEncryptionConfigurationVolume(podSpec *corev1.PodSpec, tcp stewardv1alpha1.TenantControlPlane) {
+	if len(tcp.Status.EncryptionAtRest.SecretName) == 0 {
+		d.removeVolume(podSpec, encryptionConfigurationVolumeName)
+
+		return
+	}
+
+	found, index := utilities.HasNamedVolume(podSpec.Volumes, encryptionConfigurationVolumeName)
+	if !found {
+		index = len(podSpec.Volumes)
+		podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{})
+	}
+
+	podSpec.Volumes[index].Name = encryptionConfigurationVolumeName
+	podSpec.Volumes[index].VolumeSource = corev1.VolumeSource{
+		Secret: &corev1.SecretVolumeSource{
+			SecretName:  tcp.Status.EncryptionAtRest.SecretName,
+			DefaultMode: pointer.To(int32(420)),
+		},
+	}
+}
+
+func (d Deployment) buildKMSPluginVolume(podSpec *corev1.PodSpec, tcp stewardv1alpha1.TenantControlPlane) {
+	if tcp.Spec.ControlPlane.Deployment.KMSPlugin == nil {
+		d.removeVolume(podSpec, kmsPluginSocketVolumeName)
+
+		return
+	}
+
+	found, index := utilities.HasNamedVolume(podSpec.Volumes, kmsPluginSocketVolumeName)
+	if !found {
+		index = len(podSpec.Volumes)
+		podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{})
+	}
+
+	podSpec.Volumes[index].Name = kmsPluginSocketVolumeName
+	podSpec.Volumes[index].VolumeSource = corev1.VolumeSource{
+		EmptyDir: &corev1.EmptyDirVolumeSource{
+			Medium: "Memory",
+		},
+	}
+}
+
+// buildKMSPlugin ensures the KMS plugin sidecar sharing the Unix Domain Socket folder with the API Server.
+func (d Deployment) buildKMSPlugin(podSpec *corev1.PodSpec, tcp stewardv1alpha1.TenantControlPlane) {
+	plugin := tcp.Spec.ControlPlane.Deployment.KMSPlugin
+
+	found, index := utilities.HasNamedContainer(podSpec.Containers, kmsPluginContainerName)
+
+	if plugin == nil {
+		if found {
+			var containers []corev1.Container
+
+			containers = append(containers, podSpec.Containers[:index]...)
+			containers = append(containers, podSpec.Containers[index+1:]...)
+
+			podSpec.Containers = containers
+		}
+
+		return
+	}
+
+	if !found {
+		index = len(podSpec.Containers)
+		podSpec.Containers = append(podSpec.Containers, corev1.Container{})
+	}
+
+	volumeMounts := append([]corev1.VolumeMount{}, plugin.VolumeMounts...)
+
+	d.ensureVolumeMount(&volumeMounts, corev1.VolumeMount{
+		Name:      kmsPluginSocketVolumeName,
+		ReadOnly:  false,
+		MountPath: kmsPluginSocketFolder,
+	})
+
+	podSpec.Containers[index].Name = kmsPluginContainerName
+	podSpec.Containers[index].Image = plugin.Image
+	podSpec.Containers[index].Command = plugin.Command
+	podSpec.Containers[index].Args = plugin.Args
+	podSpec.Containers[index].Env = plugin.Env
+	podSpec.Containers[index].VolumeMounts = volumeMounts
+	podSpec.Containers[index].Resources = corev1.ResourceRequirements{}
+
+	if plugin.Resources != nil {
+		podSpec.Containers[index].Resources = *plugin.Resources
+	}
 }
 
 func (d Deployment) removeKineContainers(podSpec *corev1.PodSpec) {
@@ -929,6 +1184,8 @@ func (d Deployment) buildKine(podSpec *corev1.PodSpec, tcp stewardv1alpha1.Tenan
 		args["--endpoint"] = "postgres://$(DB_USER):$(DB_PASSWORD)@$(DB_CONNECTION_STRING)/$(DB_SCHEMA)"
 	case stewardv1alpha1.KineNatsDriver:
 		args["--endpoint"] = "nats://$(DB_USER):$(DB_PASSWORD)@$(DB_CONNECTION_STRING)?bucket=$(DB_SCHEMA)&noEmbed"
+	case stewardv1alpha1.KineSQLiteDriver:
+		args["--endpoint"] = "sqlite://" + kineSQLiteFolder + "/state.db?_journal=WAL&cache=shared&_busy_timeout=30000"
 	}
 
 	podSpec.Containers[index].Name = kineContainerName
@@ -947,6 +1204,15 @@ func (d Deployment) buildKine(podSpec *corev1.PodSpec, tcp stewardv1alpha1.Tenan
 			ReadOnly:  false,
 		},
 	}
+
+	if d.DataStore.Spec.Driver == stewardv1alpha1.KineSQLiteDriver && tcp.Status.Storage.Volume != nil {
+		podSpec.Containers[index].VolumeMounts = append(podSpec.Containers[index].VolumeMounts, corev1.VolumeMount{
+			Name:      kineSQLiteVolumeName,
+			MountPath: kineSQLiteFolder,
+			ReadOnly:  false,
+		})
+	}
+
 	podSpec.Containers[index].Env = []corev1.EnvVar{
 		{
 			Name:  "GODEBUG",
@@ -992,6 +1258,20 @@ func (d Deployment) setSelector(deploymentSpec *appsv1.DeploymentSpec, tcp stewa
 
 func (d Deployment) setReplicas(deploymentSpec *appsv1.DeploymentSpec, tcp stewardv1alpha1.TenantControlPlane) {
 	deploymentSpec.Replicas = tcp.Spec.ControlPlane.Deployment.Replicas
+	// The embedded SQLite database cannot be shared among several Kine instances.
+	if d.DataStore.Spec.Driver == stewardv1alpha1.KineSQLiteDriver && pointer.Deref(deploymentSpec.Replicas, 1) > 1 {
+		deploymentSpec.Replicas = pointer.To(int32(1))
+	}
+}
+
+// setProgressDeadline enforces the upgrade rollback deadline: the Deployment controller reports the rollout
+// not making progress within it, triggering the rollback to the last-known-good version.
+func (d Deployment) setProgressDeadline(deploymentSpec *appsv1.DeploymentSpec, tcp stewardv1alpha1.TenantControlPlane) {
+	deploymentSpec.ProgressDeadlineSeconds = pointer.To(int32(defaultProgressDeadlineSeconds))
+
+	if strategy := tcp.Spec.Kubernetes.UpgradeStrategy; strategy != nil && strategy.RollbackDeadline != nil {
+		deploymentSpec.ProgressDeadlineSeconds = pointer.To(int32(strategy.RollbackDeadline.Seconds()))
+	}
 }
 
 func (d Deployment) setRuntimeClass(spec *corev1.PodSpec, tcp stewardv1alpha1.TenantControlPlane) {
@@ -1025,6 +1305,26 @@ func (d Deployment) templateLabels(ctx context.Context, tenantControlPlane *stew
 		"component.steward.butlerlabs.dev/datastore":                             tenantControlPlane.Status.Storage.DataStoreName,
 	}
 
+	if len(tenantControlPlane.Status.EncryptionAtRest.SecretName) > 0 {
+		labels[EncryptionConfigurationTemplateLabel] = tenantControlPlane.Status.EncryptionAtRest.Checksum
+	}
+
+	if d.isAuthenticationEnabled(*tenantControlPlane) {
+		labels["component.steward.butlerlabs.dev/authentication-configuration"] = tenantControlPlane.Status.Authentication.Checksum
+	}
+
+	if d.isAuthorizationEnabled(*tenantControlPlane) {
+		labels["component.steward.butlerlabs.dev/authorization-configuration"] = tenantControlPlane.Status.Authorization.Checksum
+	}
+
+	if d.isAuditEnabled(*tenantControlPlane) {
+		labels["component.steward.butlerlabs.dev/audit-policy"] = tenantControlPlane.Status.Audit.Checksum
+
+		if webhook := tenantControlPlane.Spec.Kubernetes.Audit.Backend.Webhook; webhook != nil {
+			labels["component.steward.butlerlabs.dev/audit-webhook-kubeconfig"] = hash(ctx, tenantControlPlane.GetNamespace(), webhook.KubeconfigSecretRef.Name)
+		}
+	}
+
 	return labels
 }
 
@@ -1141,3 +1441,280 @@ func (d Deployment) setServiceAccount(spec *corev1.PodSpec, tcp stewardv1alpha1.
 
 	spec.ServiceAccountName = "default"
 }
+
+// isAuthenticationEnabled returns true once the AuthenticationConfiguration ConfigMap has been rendered.
+func (d Deployment) isAuthenticationEnabled(tcp stewardv1alpha1.TenantControlPlane) bool {
+	return tcp.Spec.Kubernetes.Authentication != nil && len(tcp.Status.Authentication.ConfigMapName) > 0
+}
+
+func (d Deployment) buildAuthenticationConfigurationVolume(podSpec *corev1.PodSpec, tcp stewardv1alpha1.TenantControlPlane) {
+	if !d.isAuthenticationEnabled(tcp) {
+		d.removeVolume(podSpec, authenticationConfigurationVolumeName)
+
+		return
+	}
+
+	found, index := utilities.HasNamedVolume(podSpec.Volumes, authenticationConfigurationVolumeName)
+	if !found {
+		index = len(podSpec.Volumes)
+		podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{})
+	}
+
+	podSpec.Volumes[index].Name = authenticationConfigurationVolumeName
+	podSpec.Volumes[index].VolumeSource = corev1.VolumeSource{
+		ConfigMap: &corev1.ConfigMapVolumeSource{
+			LocalObjectReference: corev1.LocalObjectReference{Name: tcp.Status.Authentication.ConfigMapName},
+			DefaultMode:          pointer.To(int32(420)),
+		},
+	}
+}
+
+// AuthorizationWebhookKubeconfigPath returns the path of the kubeconfig used by the API Server to reach the given webhook authorizer.
+func AuthorizationWebhookKubeconfigPath(name string) string {
+	return path.Join(authorizationFolder, name+".kubeconfig")
+}
+
+// isAuthorizationEnabled returns true once the AuthorizationConfiguration ConfigMap has been rendered.
+func (d Deployment) isAuthorizationEnabled(tcp stewardv1alpha1.TenantControlPlane) bool {
+	return tcp.Spec.Kubernetes.Authorization != nil && len(tcp.Status.Authorization.ConfigMapName) > 0
+}
+
+// buildAuthorizationConfigurationVolume projects the AuthorizationConfiguration along with the webhook authorizers kubeconfig files.
+func (d Deployment) buildAuthorizationConfigurationVolume(podSpec *corev1.PodSpec, tcp stewardv1alpha1.TenantControlPlane) {
+	if !d.isAuthorizationEnabled(tcp) {
+		d.removeVolume(podSpec, authorizationConfigurationVolumeName)
+
+		return
+	}
+
+	found, index := utilities.HasNamedVolume(podSpec.Volumes, authorizationConfigurationVolumeName)
+	if !found {
+		index = len(podSpec.Volumes)
+		podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{})
+	}
+
+	sources := []corev1.VolumeProjection{
+		{
+			ConfigMap: &corev1.ConfigMapProjection{
+				LocalObjectReference: corev1.LocalObjectReference{Name: tcp.Status.Authorization.ConfigMapName},
+				Items: []corev1.KeyToPath{
+					{
+						Key:  AuthorizationConfigurationFileName,
+						Path: AuthorizationConfigurationFileName,
+					},
+				},
+			},
+		},
+	}
+
+	for _, authorizer := range tcp.Spec.Kubernetes.Authorization.Authorizers {
+		if authorizer.Webhook == nil {
+			continue
+		}
+
+		sources = append(sources, corev1.VolumeProjection{
+			Secret: &corev1.SecretProjection{
+				LocalObjectReference: corev1.LocalObjectReference{Name: authorizer.Webhook.KubeconfigSecretRef.Name},
+				Items: []corev1.KeyToPath{
+					{
+						Key:  authorizer.Webhook.KubeconfigSecretRef.Key,
+						Path: path.Base(AuthorizationWebhookKubeconfigPath(authorizer.Name)),
+					},
+				},
+			},
+		})
+	}
+
+	podSpec.Volumes[index].Name = authorizationConfigurationVolumeName
+	podSpec.Volumes[index].VolumeSource = corev1.VolumeSource{
+		Projected: &corev1.ProjectedVolumeSource{
+			Sources:     sources,
+			DefaultMode: pointer.To(int32(420)),
+		},
+	}
+}
+
+// isAuditEnabled returns true once the audit Policy ConfigMap has been rendered.
+func (d Deployment) isAuditEnabled(tcp stewardv1alpha1.TenantControlPlane) bool {
+	return tcp.Spec.Kubernetes.Audit != nil && len(tcp.Status.Audit.ConfigMapName) > 0
+}
+
+func (d Deployment) auditLogBackend(tcp stewardv1alpha1.TenantControlPlane) *stewardv1alpha1.AuditLogBackendSpec {
+	if !d.isAuditEnabled(tcp) {
+		return nil
+	}
+
+	return tcp.Spec.Kubernetes.Audit.Backend.Log
+}
+
+func (d Deployment) auditWebhookBackend(tcp stewardv1alpha1.TenantControlPlane) *stewardv1alpha1.AuditWebhookBackendSpec {
+	if !d.isAuditEnabled(tcp) {
+		return nil
+	}
+
+	return tcp.Spec.Kubernetes.Audit.Backend.Webhook
+}
+
+func (d Deployment) buildAuditArgs(desiredArgs, current map[string]string, tcp stewardv1alpha1.TenantControlPlane) {
+	if d.isAuditEnabled(tcp) {
+		desiredArgs["--audit-policy-file"] = path.Join(auditPolicyFolder, AuditPolicyFileName)
+	} else {
+		utilities.ArgsRemoveFlag(current, "--audit-policy-file")
+	}
+
+	if log := d.auditLogBackend(tcp); log != nil {
+		desiredArgs["--audit-log-path"] = path.Join(auditLogsFolder, "audit.log")
+		desiredArgs["--audit-log-maxage"] = fmt.Sprintf("%d", log.Retention.MaxAge)
+		desiredArgs["--audit-log-maxbackup"] = fmt.Sprintf("%d", log.Retention.MaxBackups)
+		desiredArgs["--audit-log-maxsize"] = fmt.Sprintf("%d", log.Retention.MaxSize)
+	} else {
+		for _, flag := range []string{"--audit-log-path", "--audit-log-maxage", "--audit-log-maxbackup", "--audit-log-maxsize"} {
+			utilities.ArgsRemoveFlag(current, flag)
+		}
+	}
+
+	webhook := d.auditWebhookBackend(tcp)
+	if webhook != nil {
+		desiredArgs["--audit-webhook-config-file"] = path.Join(auditWebhookFolder, auditWebhookFileName)
+		desiredArgs["--audit-webhook-mode"] = webhook.Mode
+	} else {
+		for _, flag := range []string{"--audit-webhook-config-file", "--audit-webhook-mode"} {
+			utilities.ArgsRemoveFlag(current, flag)
+		}
+	}
+
+	if webhook != nil && webhook.InitialBackoff != nil {
+		desiredArgs["--audit-webhook-initial-backoff"] = webhook.InitialBackoff.Duration.String()
+	} else {
+		utilities.ArgsRemoveFlag(current, "--audit-webhook-initial-backoff")
+	}
+}
+
+func (d Deployment) buildAuditVolumeMounts(volumeMounts *[]corev1.VolumeMount, tcp stewardv1alpha1.TenantControlPlane) {
+	if d.isAuditEnabled(tcp) {
+		d.ensureVolumeMount(volumeMounts, corev1.VolumeMount{
+			Name:      auditPolicyVolumeName,
+			ReadOnly:  true,
+			MountPath: auditPolicyFolder,
+		})
+	} else {
+		d.removeVolumeMount(volumeMounts, auditPolicyVolumeName)
+	}
+
+	if d.auditLogBackend(tcp) != nil {
+		d.ensureVolumeMount(volumeMounts, corev1.VolumeMount{
+			Name:      auditLogsVolumeName,
+			ReadOnly:  false,
+			MountPath: auditLogsFolder,
+		})
+	} else {
+		d.removeVolumeMount(volumeMounts, auditLogsVolumeName)
+	}
+
+	if d.auditWebhookBackend(tcp) != nil {
+		d.ensureVolumeMount(volumeMounts, corev1.VolumeMount{
+			Name:      auditWebhookVolumeName,
+			ReadOnly:  true,
+			MountPath: auditWebhookFolder,
+		})
+	} else {
+		d.removeVolumeMount(volumeMounts, auditWebhookVolumeName)
+	}
+}
+
+func (d Deployment) buildAuditVolumes(podSpec *corev1.PodSpec, tcp stewardv1alpha1.TenantControlPlane) {
+	ensureVolume := func(name string, source corev1.VolumeSource) {
+		found, index := utilities.HasNamedVolume(podSpec.Volumes, name)
+		if !found {
+			index = len(podSpec.Volumes)
+			podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{})
+		}
+
+		podSpec.Volumes[index].Name = name
+		podSpec.Volumes[index].VolumeSource = source
+	}
+
+	if d.isAuditEnabled(tcp) {
+		ensureVolume(auditPolicyVolumeName, corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: tcp.Status.Audit.ConfigMapName},
+				DefaultMode:          pointer.To(int32(420)),
+			},
+		})
+	} else {
+		d.removeVolume(podSpec, auditPolicyVolumeName)
+	}
+
+	if log := d.auditLogBackend(tcp); log != nil {
+		ensureVolume(auditLogsVolumeName, corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{
+				SizeLimit: log.SizeLimit,
+			},
+		})
+	} else {
+		d.removeVolume(podSpec, auditLogsVolumeName)
+	}
+
+	if webhook := d.auditWebhookBackend(tcp); webhook != nil {
+		ensureVolume(auditWebhookVolumeName, corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: webhook.KubeconfigSecretRef.Name,
+				Items: []corev1.KeyToPath{
+					{
+						Key:  webhook.KubeconfigSecretRef.Key,
+						Path: auditWebhookFileName,
+					},
+				},
+				DefaultMode: pointer.To(int32(420)),
+			},
+		})
+	} else {
+		d.removeVolume(podSpec, auditWebhookVolumeName)
+	}
+}
+
+// buildAuditLogShipper ensures the sidecar container shipping the audit logs written by the API Server.
+func (d Deployment) buildAuditLogShipper(podSpec *corev1.PodSpec, tcp stewardv1alpha1.TenantControlPlane) {
+	var shipper *stewardv1alpha1.AuditLogShipperSpec
+	if log := d.auditLogBackend(tcp); log != nil {
+		shipper = log.Shipper
+	}
+
+	found, index := utilities.HasNamedContainer(podSpec.Containers, auditLogShipperContainer)
+
+	if shipper == nil {
+		if found {
+			var containers []corev1.Container
+
+			containers = append(containers, podSpec.Containers[:index]...)
+			containers = append(containers, podSpec.Containers[index+1:]...)
+
+			podSpec.Containers = containers
+		}
+
+		return
+	}
+
+	if !found {
+		index = len(podSpec.Containers)
+		podSpec.Containers = append(podSpec.Containers, corev1.Container{})
+	}
+
+	podSpec.Containers[index].Name = auditLogShipperContainer
+	podSpec.Containers[index].Image = shipper.Image
+	podSpec.Containers[index].Command = shipper.Command
+	podSpec.Containers[index].Args = shipper.Args
+	podSpec.Containers[index].Env = shipper.Env
+	podSpec.Containers[index].VolumeMounts = []corev1.VolumeMount{
+		{
+			Name:      auditLogsVolumeName,
+			ReadOnly:  true,
+			MountPath: auditLogsFolder,
+		},
+	}
+	podSpec.Containers[index].Resources = corev1.ResourceRequirements{}
+
+	if shipper.Resources != nil {
+		podSpec.Containers[index].Resources = *shipper.Resources
+	}
+}
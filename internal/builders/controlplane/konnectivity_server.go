@@ -60,7 +60,7 @@ func (k Konnectivity) buildKonnectivityContainer(tcp stewardv1alpha1.TenantContr
 	}
 
 	podSpec.Containers[index].Name = konnectivityServerName
-	podSpec.Containers[index].Image = fmt.Sprintf("%s:%s", addon.KonnectivityServerSpec.Image, k.serverVersion(tcp.Spec.Kubernetes.Version, addon.KonnectivityServerSpec.Version))
+	podSpec.Containers[index].Image = fmt.Sprintf("%s:%s", addon.KonnectivityServerSpec.Image, k.serverVersion(tcp.GetKubernetesVersion(), addon.KonnectivityServerSpec.Version))
 	podSpec.Containers[index].Command = []string{"/proxy-server"}
 
 	args := utilities.ArgsFromSliceToMap(addon.KonnectivityServerSpec.ExtraArgs)
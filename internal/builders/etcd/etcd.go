@@ -0,0 +1,454 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package etcd
+
+import (
+	"bytes"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	pointer "k8s.io/utils/ptr"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/internal/constants"
+	"github.com/butlerdotdev/steward/internal/crypto"
+	"github.com/butlerdotdev/steward/internal/utilities"
+)
+
+const (
+	ClientPort  = 2379
+	PeerPort    = 2380
+	MetricsPort = 2381
+
+	// MaxMembers is the highest number of members supported by the etcd cluster:
+	// the server certificate is valid for each of them.
+	MaxMembers = 7
+
+	CACertificateKey      = "ca.crt"
+	CAPrivateKeyKey       = "ca.key"
+	ServerCertificateKey  = "server.crt"
+	ServerPrivateKeyKey   = "server.key"
+	ClientCertificateKey  = "tls.crt"
+	ClientPrivateKeyKey   = "tls.key"
+	RootUser              = "root"
+	containerName         = "etcd"
+	dataVolumeName        = "data"
+	dataPath              = "/var/lib/etcd"
+	certificatesVolume    = "certs"
+	certificatesPath      = "/etc/etcd/pki"
+	componentLabelValue   = "etcd"
+	podNameEnvironmentVar = "POD_NAME"
+)
+
+// Cluster is an etcd cluster deployed by Steward as a StatefulSet:
+// the members are reachable through the headless Service sharing the same name.
+type Cluster struct {
+	// Name is used for the StatefulSet, the Service, and as prefix of the Secrets.
+	Name      string
+	Namespace string
+	Spec      stewardv1alpha1.EtcdClusterSpec
+	// Members is the number of members part of the cluster, it differs from the desired replicas while scaling.
+	Members int32
+	// Bootstrapped reports whether the cluster has been already formed, new members are then joining it.
+	Bootstrapped bool
+}
+
+// ManagedCluster returns the etcd cluster backing the given managed DataStore,
+// deployed in the given namespace unless the DataStore specifies otherwise.
+func ManagedCluster(ds stewardv1alpha1.DataStore, namespace string) Cluster {
+	if ds.Spec.Managed.Namespace != "" {
+		namespace = ds.Spec.Managed.Namespace
+	}
+
+	return newCluster(ds.GetName()+"-etcd", namespace, ds.Spec.Managed.EtcdClusterSpec, ds.Status.Managed)
+}
+
+// DedicatedCluster returns the etcd cluster dedicated to the given Tenant Control Plane,
+// deployed in its namespace along with the other Tenant Control Plane resources.
+func DedicatedCluster(tcp stewardv1alpha1.TenantControlPlane) Cluster {
+	var spec stewardv1alpha1.EtcdClusterSpec
+	if tcp.Spec.DedicatedDataStore != nil {
+		spec = *tcp.Spec.DedicatedDataStore
+	}
+
+	return newCluster(utilities.AddTenantPrefix("etcd", &tcp), tcp.GetNamespace(), spec, tcp.Status.Storage.Dedicated)
+}
+
+func newCluster(name, namespace string, spec stewardv1alpha1.EtcdClusterSpec, status *stewardv1alpha1.EtcdClusterStatus) Cluster {
+	cluster := Cluster{
+		Name:      name,
+		Namespace: namespace,
+		Spec:      spec,
+		// The cluster is bootstrapped with all the desired members at once.
+		Members: spec.Replicas,
+	}
+
+	if status != nil {
+		cluster.Members, cluster.Bootstrapped = status.Members, status.Bootstrapped
+	}
+
+	return cluster
+}
+
+func (c Cluster) CASecretName() string {
+	return c.Name + "-ca"
+}
+
+func (c Cluster) CertificatesSecretName() string {
+	return c.Name + "-certs"
+}
+
+func (c Cluster) ClientSecretName() string {
+	return c.Name + "-root-client"
+}
+
+func (c Cluster) Labels() map[string]string {
+	return utilities.StewardLabels(c.Name, componentLabelValue)
+}
+
+// MemberName returns the name of the member with the given ordinal, matching the StatefulSet Pod.
+func (c Cluster) MemberName(ordinal int32) string {
+	return fmt.Sprintf("%s-%d", c.Name, ordinal)
+}
+
+// MemberHost returns the stable DNS name of the member with the given ordinal.
+func (c Cluster) MemberHost(ordinal int32) string {
+	return fmt.Sprintf("%s.%s.%s.svc", c.MemberName(ordinal), c.Name, c.Namespace)
+}
+
+func (c Cluster) MemberPeerURL(ordinal int32) string {
+	return "https://" + net.JoinHostPort(c.MemberHost(ordinal), strconv.Itoa(PeerPort))
+}
+
+// Endpoints returns the client endpoints of the current members, in the format expected by the DataStore.
+func (c Cluster) Endpoints() stewardv1alpha1.Endpoints {
+	endpoints := make(stewardv1alpha1.Endpoints, 0, c.Members)
+
+	for i := range c.Members {
+		endpoints = append(endpoints, net.JoinHostPort(c.MemberHost(i), strconv.Itoa(ClientPort)))
+	}
+
+	return endpoints
+}
+
+// TLSConfig returns the DataStore TLS configuration referring to the generated Secrets.
+func (c Cluster) TLSConfig() *stewardv1alpha1.TLSConfig {
+	ref := func(name string) stewardv1alpha1.ContentRef {
+		return stewardv1alpha1.ContentRef{
+			SecretRef: &stewardv1alpha1.SecretReference{
+				SecretReference: corev1.SecretReference{Name: name, Namespace: c.Namespace},
+			},
+		}
+	}
+
+	caCrt, caKey := ref(c.CASecretName()), ref(c.CASecretName())
+	caCrt.SecretRef.KeyPath, caKey.SecretRef.KeyPath = CACertificateKey, CAPrivateKeyKey
+
+	clientCrt, clientKey := ref(c.ClientSecretName()), ref(c.ClientSecretName())
+	clientCrt.SecretRef.KeyPath, clientKey.SecretRef.KeyPath = ClientCertificateKey, ClientPrivateKeyKey
+
+	return &stewardv1alpha1.TLSConfig{
+		CertificateAuthority: stewardv1alpha1.CertKeyPair{
+			Certificate: caCrt,
+			PrivateKey:  &caKey,
+		},
+		ClientCertificate: &stewardv1alpha1.ClientCertificate{
+			Certificate: clientCrt,
+			PrivateKey:  clientKey,
+		},
+	}
+}
+
+// GenerateCA generates the Certificate Authority of the cluster, signing both the server and the client certificates.
+func (c Cluster) GenerateCA() (map[string][]byte, error) {
+	crt, key, err := crypto.GenerateCertificateAuthority(c.Name + "-ca")
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string][]byte{
+		CACertificateKey: crt.Bytes(),
+		CAPrivateKeyKey:  key.Bytes(),
+	}, nil
+}
+
+// serverNames returns the names the server certificate must be valid for:
+// the peers resolve the DNS names of the certificate, thus each member is listed explicitly besides the wildcards.
+func (c Cluster) serverNames() []string {
+	names := make([]string, 0, 2*MaxMembers+5)
+
+	for i := range int32(MaxMembers) {
+		names = append(names, c.MemberHost(i), c.MemberHost(i)+".cluster.local")
+	}
+
+	service := fmt.Sprintf("%s.%s.svc", c.Name, c.Namespace)
+
+	return append(names, service, "*."+service, "*."+service+".cluster.local", c.Name, "localhost")
+}
+
+// GenerateServerCertificate generates the certificate used by the members both for the clients and the peers.
+func (c Cluster) GenerateServerCertificate(ca map[string][]byte) (map[string][]byte, error) {
+	template := crypto.NewCertificateTemplateWithSANs(c.Name, c.serverNames(), []net.IP{net.ParseIP("127.0.0.1")})
+
+	crt, key, err := crypto.GenerateCertificatePrivateKeyPair(template, ca[CACertificateKey], ca[CAPrivateKeyKey])
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot generate the etcd server certificate")
+	}
+
+	return map[string][]byte{
+		CACertificateKey:     ca[CACertificateKey],
+		ServerCertificateKey: crt.Bytes(),
+		ServerPrivateKeyKey:  key.Bytes(),
+	}, nil
+}
+
+// GenerateClientCertificate generates the certificate of the root user, used by Steward to operate the cluster.
+func (c Cluster) GenerateClientCertificate(ca map[string][]byte) (map[string][]byte, error) {
+	crt, key, err := crypto.GenerateCertificatePrivateKeyPair(crypto.NewCertificateTemplate(RootUser), ca[CACertificateKey], ca[CAPrivateKeyKey])
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot generate the etcd root client certificate")
+	}
+
+	return map[string][]byte{
+		CACertificateKey:     ca[CACertificateKey],
+		ClientCertificateKey: crt.Bytes(),
+		ClientPrivateKeyKey:  key.Bytes(),
+	}, nil
+}
+
+// IsServerCertificateValid checks the server certificate is signed by the CA, valid for all the members,
+// and not expiring within the given threshold.
+func (c Cluster) IsServerCertificateValid(ca, certs map[string][]byte, threshold time.Duration) bool {
+	if !bytes.Equal(ca[CACertificateKey], certs[CACertificateKey]) {
+		return false
+	}
+
+	if ok, err := crypto.IsValidCertificateKeyPairBytes(certs[ServerCertificateKey], certs[ServerPrivateKeyKey], threshold); err != nil || !ok {
+		return false
+	}
+
+	if ok, err := crypto.VerifyCertificate(certs[ServerCertificateKey], ca[CACertificateKey], x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth); err != nil || !ok {
+		return false
+	}
+
+	ok, err := crypto.CheckCertificateNamesAndIPs(certs[ServerCertificateKey], c.serverNames())
+
+	return err == nil && ok
+}
+
+// IsClientCertificateValid checks the root client certificate is signed by the CA, and not expiring within the given threshold.
+func (c Cluster) IsClientCertificateValid(ca, certs map[string][]byte, threshold time.Duration) bool {
+	if !bytes.Equal(ca[CACertificateKey], certs[CACertificateKey]) {
+		return false
+	}
+
+	if ok, err := crypto.IsValidCertificateKeyPairBytes(certs[ClientCertificateKey], certs[ClientPrivateKeyKey], threshold); err != nil || !ok {
+		return false
+	}
+
+	ok, err := crypto.VerifyCertificate(certs[ClientCertificateKey], ca[CACertificateKey], x509.ExtKeyUsageClientAuth)
+
+	return err == nil && ok
+}
+
+// BuildService defines the headless Service providing the stable network identity of the members:
+// the not ready addresses are published since the members must reach each other to form the quorum.
+func (c Cluster) BuildService(service *corev1.Service) {
+	service.SetLabels(utilities.MergeMaps(service.GetLabels(), c.Labels()))
+
+	service.Spec.ClusterIP = corev1.ClusterIPNone
+	service.Spec.PublishNotReadyAddresses = true
+	service.Spec.Selector = c.Labels()
+	service.Spec.Ports = []corev1.ServicePort{
+		{
+			Name:       "client",
+			Protocol:   corev1.ProtocolTCP,
+			Port:       ClientPort,
+			TargetPort: intstr.FromInt32(ClientPort),
+		},
+		{
+			Name:       "peer",
+			Protocol:   corev1.ProtocolTCP,
+			Port:       PeerPort,
+			TargetPort: intstr.FromInt32(PeerPort),
+		},
+	}
+}
+
+// BuildStatefulSet defines the StatefulSet running the members:
+// Pods are never restarted by the StatefulSet controller, since Steward rolls them one at a time,
+// and the volumes are deleted upon scale down, allowing a removed member to join again from scratch.
+func (c Cluster) BuildStatefulSet(sts *appsv1.StatefulSet, checksum string) {
+	sts.SetLabels(utilities.MergeMaps(sts.GetLabels(), c.Labels()))
+
+	sts.Spec.Replicas = pointer.To(c.Members)
+	sts.Spec.ServiceName = c.Name
+	sts.Spec.PodManagementPolicy = appsv1.ParallelPodManagement
+	sts.Spec.UpdateStrategy = appsv1.StatefulSetUpdateStrategy{Type: appsv1.OnDeleteStatefulSetStrategyType}
+	sts.Spec.PersistentVolumeClaimRetentionPolicy = &appsv1.StatefulSetPersistentVolumeClaimRetentionPolicy{
+		WhenDeleted: appsv1.DeletePersistentVolumeClaimRetentionPolicyType,
+		WhenScaled:  appsv1.DeletePersistentVolumeClaimRetentionPolicyType,
+	}
+	sts.Spec.Selector = &metav1.LabelSelector{MatchLabels: c.Labels()}
+	// The volume claim templates are immutable, they're set only upon creation.
+	if sts.CreationTimestamp.IsZero() {
+		size := c.Spec.Storage.Size
+		if size.IsZero() {
+			size = resource.MustParse("8Gi")
+		}
+
+		sts.Spec.VolumeClaimTemplates = []corev1.PersistentVolumeClaim{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   dataVolumeName,
+					Labels: c.Labels(),
+				},
+				Spec: corev1.PersistentVolumeClaimSpec{
+					AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+					StorageClassName: c.Spec.Storage.StorageClassName,
+					Resources: corev1.VolumeResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceStorage: size},
+					},
+				},
+			},
+		}
+	}
+
+	sts.Spec.Template.SetLabels(c.Labels())
+	sts.Spec.Template.SetAnnotations(map[string]string{constants.Checksum: checksum})
+
+	podSpec := &sts.Spec.Template.Spec
+	podSpec.Volumes = []corev1.Volume{
+		{
+			Name: certificatesVolume,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName:  c.CertificatesSecretName(),
+					DefaultMode: pointer.To(int32(420)),
+				},
+			},
+		},
+	}
+
+	found, index := utilities.HasNamedContainer(podSpec.Containers, containerName)
+	if !found {
+		index = len(podSpec.Containers)
+		podSpec.Containers = append(podSpec.Containers, corev1.Container{})
+	}
+
+	container := &podSpec.Containers[index]
+	container.Name = containerName
+	container.Image = c.Spec.Image
+	container.Command = append([]string{"etcd"}, c.args()...)
+	container.Env = []corev1.EnvVar{
+		{
+			Name: podNameEnvironmentVar,
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.name"},
+			},
+		},
+	}
+	container.Ports = []corev1.ContainerPort{
+		{Name: "client", ContainerPort: ClientPort, Protocol: corev1.ProtocolTCP},
+		{Name: "peer", ContainerPort: PeerPort, Protocol: corev1.ProtocolTCP},
+		{Name: "metrics", ContainerPort: MetricsPort, Protocol: corev1.ProtocolTCP},
+	}
+	container.VolumeMounts = []corev1.VolumeMount{
+		{Name: dataVolumeName, MountPath: dataPath},
+		{Name: certificatesVolume, MountPath: certificatesPath, ReadOnly: true},
+	}
+	container.ReadinessProbe = &corev1.Probe{
+		ProbeHandler: corev1.ProbeHandler{
+			HTTPGet: &corev1.HTTPGetAction{
+				Path:   "/health",
+				Port:   intstr.FromInt32(MetricsPort),
+				Scheme: corev1.URISchemeHTTP,
+			},
+		},
+		PeriodSeconds:    5,
+		FailureThreshold: 3,
+	}
+	container.LivenessProbe = &corev1.Probe{
+		ProbeHandler: corev1.ProbeHandler{
+			HTTPGet: &corev1.HTTPGetAction{
+				Path:   "/health?exclude=NOSPACE&serializable=true",
+				Port:   intstr.FromInt32(MetricsPort),
+				Scheme: corev1.URISchemeHTTP,
+			},
+		},
+		InitialDelaySeconds: 10,
+		PeriodSeconds:       10,
+		FailureThreshold:    8,
+	}
+
+	container.Resources = corev1.ResourceRequirements{}
+	if c.Spec.Resources != nil {
+		container.Resources = *c.Spec.Resources
+	}
+}
+
+// args returns the etcd flags: the initial cluster is used only by the members starting with an empty data directory,
+// listing the current members, and the one joining the cluster, if any.
+func (c Cluster) args() []string {
+	state := "new"
+	if c.Bootstrapped {
+		state = "existing"
+	}
+
+	initialCluster := make([]string, 0, c.Members)
+	for i := range c.Members {
+		initialCluster = append(initialCluster, fmt.Sprintf("%s=%s", c.MemberName(i), c.MemberPeerURL(i)))
+	}
+
+	host := fmt.Sprintf("$(%s).%s.%s.svc", podNameEnvironmentVar, c.Name, c.Namespace)
+
+	return []string{
+		fmt.Sprintf("--name=$(%s)", podNameEnvironmentVar),
+		fmt.Sprintf("--data-dir=%s/data", dataPath),
+		fmt.Sprintf("--listen-client-urls=https://0.0.0.0:%d", ClientPort),
+		fmt.Sprintf("--advertise-client-urls=https://%s:%d", host, ClientPort),
+		fmt.Sprintf("--listen-peer-urls=https://0.0.0.0:%d", PeerPort),
+		fmt.Sprintf("--initial-advertise-peer-urls=https://%s:%d", host, PeerPort),
+		fmt.Sprintf("--listen-metrics-urls=http://0.0.0.0:%d", MetricsPort),
+		"--initial-cluster=" + strings.Join(initialCluster, ","),
+		"--initial-cluster-state=" + state,
+		"--initial-cluster-token=" + c.Name,
+		"--client-cert-auth=true",
+		fmt.Sprintf("--trusted-ca-file=%s/%s", certificatesPath, CACertificateKey),
+		fmt.Sprintf("--cert-file=%s/%s", certificatesPath, ServerCertificateKey),
+		fmt.Sprintf("--key-file=%s/%s", certificatesPath, ServerPrivateKeyKey),
+		"--peer-client-cert-auth=true",
+		fmt.Sprintf("--peer-trusted-ca-file=%s/%s", certificatesPath, CACertificateKey),
+		fmt.Sprintf("--peer-cert-file=%s/%s", certificatesPath, ServerCertificateKey),
+		fmt.Sprintf("--peer-key-file=%s/%s", certificatesPath, ServerPrivateKeyKey),
+		"--auto-compaction-mode=periodic",
+		"--auto-compaction-retention=5m",
+		"--snapshot-count=10000",
+	}
+}
+
+// PodChecksum returns the checksum of the Pod template settings requiring a restart of the members:
+// the changes to the number of members are not part of it, since they're applied only to the joining ones.
+func (c Cluster) PodChecksum(certificates map[string][]byte) string {
+	resources := ""
+	if c.Spec.Resources != nil {
+		resources = c.Spec.Resources.String()
+	}
+
+	return utilities.CalculateMapChecksum(map[string]string{
+		"certificates": utilities.CalculateMapChecksum(certificates),
+		"image":        c.Spec.Image,
+		"resources":    resources,
+	})
+}
@@ -0,0 +1,164 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package etcd
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/internal/constants"
+)
+
+var _ = Describe("Etcd Cluster", func() {
+	var (
+		ds      stewardv1alpha1.DataStore
+		cluster Cluster
+	)
+
+	BeforeEach(func() {
+		ds = stewardv1alpha1.DataStore{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec: stewardv1alpha1.DataStoreSpec{
+				Driver: stewardv1alpha1.EtcdDriver,
+				Managed: &stewardv1alpha1.ManagedEtcdSpec{
+					EtcdClusterSpec: stewardv1alpha1.EtcdClusterSpec{Replicas: 3, Image: "registry.k8s.io/etcd:3.5.21-0"},
+				},
+			},
+		}
+
+		cluster = ManagedCluster(ds, "steward-system")
+	})
+
+	Describe("ManagedCluster", func() {
+		It("should bootstrap the cluster with all the desired members", func() {
+			Expect(cluster.Name).To(Equal("default-etcd"))
+			Expect(cluster.Namespace).To(Equal("steward-system"))
+			Expect(cluster.Members).To(Equal(int32(3)))
+			Expect(cluster.Bootstrapped).To(BeFalse())
+		})
+
+		It("should retain the current members while scaling", func() {
+			ds.Spec.Managed.Namespace = "etcd"
+			ds.Status.Managed = &stewardv1alpha1.EtcdClusterStatus{Members: 1, Bootstrapped: true}
+
+			cluster = ManagedCluster(ds, "steward-system")
+			Expect(cluster.Namespace).To(Equal("etcd"))
+			Expect(cluster.Members).To(Equal(int32(1)))
+			Expect(cluster.Bootstrapped).To(BeTrue())
+			Expect(cluster.Endpoints()).To(Equal(stewardv1alpha1.Endpoints{"default-etcd-0.default-etcd.etcd.svc:2379"}))
+		})
+	})
+
+	Describe("args", func() {
+		It("should form a new cluster out of the current members", func() {
+			Expect(cluster.args()).To(ContainElements(
+				"--name=$(POD_NAME)",
+				"--advertise-client-urls=https://$(POD_NAME).default-etcd.steward-system.svc:2379",
+				"--initial-advertise-peer-urls=https://$(POD_NAME).default-etcd.steward-system.svc:2380",
+				"--initial-cluster=default-etcd-0=https://default-etcd-0.default-etcd.steward-system.svc:2380,"+
+					"default-etcd-1=https://default-etcd-1.default-etcd.steward-system.svc:2380,"+
+					"default-etcd-2=https://default-etcd-2.default-etcd.steward-system.svc:2380",
+				"--initial-cluster-state=new",
+				"--initial-cluster-token=default-etcd",
+				"--client-cert-auth=true",
+				"--peer-client-cert-auth=true",
+			))
+		})
+
+		It("should join the existing cluster when bootstrapped", func() {
+			cluster.Bootstrapped, cluster.Members = true, 4
+
+			args := cluster.args()
+			Expect(args).To(ContainElement("--initial-cluster-state=existing"))
+			Expect(args).To(ContainElement(ContainSubstring("default-etcd-3=https://default-etcd-3.default-etcd.steward-system.svc:2380")))
+		})
+	})
+
+	Describe("BuildStatefulSet", func() {
+		var sts *appsv1.StatefulSet
+
+		BeforeEach(func() {
+			sts = &appsv1.StatefulSet{}
+			cluster.BuildStatefulSet(sts, "checksum")
+		})
+
+		It("should leave the member restarts to Steward", func() {
+			Expect(*sts.Spec.Replicas).To(Equal(int32(3)))
+			Expect(sts.Spec.ServiceName).To(Equal("default-etcd"))
+			Expect(sts.Spec.PodManagementPolicy).To(Equal(appsv1.ParallelPodManagement))
+			Expect(sts.Spec.UpdateStrategy.Type).To(Equal(appsv1.OnDeleteStatefulSetStrategyType))
+			Expect(sts.Spec.PersistentVolumeClaimRetentionPolicy.WhenScaled).To(Equal(appsv1.DeletePersistentVolumeClaimRetentionPolicyType))
+			Expect(sts.Spec.Template.GetAnnotations()).To(HaveKeyWithValue(constants.Checksum, "checksum"))
+			Expect(sts.Spec.Selector.MatchLabels).To(Equal(sts.Spec.Template.GetLabels()))
+		})
+
+		It("should run the etcd member with the generated certificates", func() {
+			Expect(sts.Spec.Template.Spec.Containers).To(HaveLen(1))
+
+			container := sts.Spec.Template.Spec.Containers[0]
+			Expect(container.Image).To(Equal("registry.k8s.io/etcd:3.5.21-0"))
+			Expect(container.Command).To(Equal(append([]string{"etcd"}, cluster.args()...)))
+			Expect(container.VolumeMounts).To(ConsistOf(
+				corev1.VolumeMount{Name: dataVolumeName, MountPath: dataPath},
+				corev1.VolumeMount{Name: certificatesVolume, MountPath: certificatesPath, ReadOnly: true},
+			))
+			Expect(sts.Spec.Template.Spec.Volumes[0].Secret.SecretName).To(Equal("default-etcd-certs"))
+		})
+
+		It("should default the storage size of the members", func() {
+			Expect(sts.Spec.VolumeClaimTemplates).To(HaveLen(1))
+			Expect(sts.Spec.VolumeClaimTemplates[0].Spec.Resources.Requests[corev1.ResourceStorage]).To(Equal(resource.MustParse("8Gi")))
+		})
+
+		It("should not change the volume claim templates of an existing StatefulSet", func() {
+			sts.CreationTimestamp = metav1.Now()
+			cluster.Spec.Storage.Size = resource.MustParse("16Gi")
+			cluster.Members = 4
+
+			cluster.BuildStatefulSet(sts, "checksum")
+			Expect(*sts.Spec.Replicas).To(Equal(int32(4)))
+			Expect(sts.Spec.VolumeClaimTemplates[0].Spec.Resources.Requests[corev1.ResourceStorage]).To(Equal(resource.MustParse("8Gi")))
+		})
+	})
+
+	Describe("PodChecksum", func() {
+		It("should restart the members only upon changes to the Pod settings", func() {
+			certs := map[string][]byte{ServerCertificateKey: []byte("crt")}
+			checksum := cluster.PodChecksum(certs)
+
+			cluster.Members = 5
+			Expect(cluster.PodChecksum(certs)).To(Equal(checksum))
+
+			cluster.Spec.Image = "registry.k8s.io/etcd:3.6.5-0"
+			Expect(cluster.PodChecksum(certs)).ToNot(Equal(checksum))
+		})
+	})
+
+	Describe("Certificates", func() {
+		It("should rotate the certificates expiring within the threshold", func() {
+			ca, err := cluster.GenerateCA()
+			Expect(err).ToNot(HaveOccurred())
+
+			server, err := cluster.GenerateServerCertificate(ca)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cluster.IsServerCertificateValid(ca, server, time.Hour)).To(BeTrue())
+			Expect(cluster.IsServerCertificateValid(ca, server, 11*365*24*time.Hour)).To(BeFalse())
+
+			client, err := cluster.GenerateClientCertificate(ca)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cluster.IsClientCertificateValid(ca, client, time.Hour)).To(BeTrue())
+			// A certificate signed by another CA is regenerated.
+			otherCA, err := cluster.GenerateCA()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cluster.IsClientCertificateValid(otherCA, client, time.Hour)).To(BeFalse())
+		})
+	})
+})
@@ -7,4 +7,9 @@ const (
 	// Checksum is the annotation label that we use to store the checksum for the resource:
 	// it allows to check by comparing it if the resource has been changed and must be aligned with the reconciliation.
 	Checksum = "steward.butlerlabs.dev/checksum"
+	// EncryptionKeyRotationRequest is the annotation used to request the rotation of the encryption at rest key:
+	// it must be applied with an empty value, and it's replaced with the completion timestamp in the RFC3339 format.
+	EncryptionKeyRotationRequest = "encryption.steward.butlerlabs.dev/rotate"
+	// EncryptionKeyRotationPhase tracks the current phase of the encryption at rest key rotation.
+	EncryptionKeyRotationPhase = "encryption.steward.butlerlabs.dev/rotation-phase"
 )
@@ -206,6 +206,34 @@ func VerifyCertificate(cert, ca []byte, usages ...x509.ExtKeyUsage) (bool, error
 	return len(chains) > 0, err
 }
 
+// GenerateCertificateAuthority generates a self-signed Certificate Authority with the given common name,
+// returning the bytes both for the certificate and its key.
+func GenerateCertificateAuthority(commonName string) (*bytes.Buffer, *bytes.Buffer, error) {
+	caPrivKey, err := rsa.GenerateKey(cryptorand.Reader, 2048)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "cannot generate an RSA key")
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(mathrand.Int63()),
+		Subject: pkix.Name{
+			CommonName: commonName,
+		},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().AddDate(10, 0, 0),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+	}
+
+	caBytes, err := x509.CreateCertificate(cryptorand.Reader, template, template, &caPrivKey.PublicKey, caPrivKey)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "cannot create the Certificate Authority")
+	}
+
+	return encodeCertificateKeyPair(caBytes, caPrivKey)
+}
+
 func generateCertificateKeyPairBytes(template *x509.Certificate, caCert *x509.Certificate, caKey crypto.Signer) (*bytes.Buffer, *bytes.Buffer, error) {
 	certPrivKey, err := rsa.GenerateKey(cryptorand.Reader, 2048)
 	if err != nil {
@@ -217,8 +245,12 @@ func generateCertificateKeyPairBytes(template *x509.Certificate, caCert *x509.Ce
 		return nil, nil, errors.Wrap(err, "cannot create the certificate")
 	}
 
+	return encodeCertificateKeyPair(certBytes, certPrivKey)
+}
+
+func encodeCertificateKeyPair(certBytes []byte, certPrivKey *rsa.PrivateKey) (*bytes.Buffer, *bytes.Buffer, error) {
 	certPEM := &bytes.Buffer{}
-	if err = pem.Encode(certPEM, &pem.Block{
+	if err := pem.Encode(certPEM, &pem.Block{
 		Type:    "CERTIFICATE",
 		Headers: nil,
 		Bytes:   certBytes,
@@ -227,7 +259,7 @@ func generateCertificateKeyPairBytes(template *x509.Certificate, caCert *x509.Ce
 	}
 
 	certPrivKeyPEM := &bytes.Buffer{}
-	if err = pem.Encode(certPrivKeyPEM, &pem.Block{
+	if err := pem.Encode(certPrivKeyPEM, &pem.Block{
 		Type:    "RSA PRIVATE KEY",
 		Headers: nil,
 		Bytes:   x509.MarshalPKCS1PrivateKey(certPrivKey),
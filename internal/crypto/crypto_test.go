@@ -0,0 +1,41 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package crypto
+
+import (
+	"crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateCertificateAuthority(t *testing.T) {
+	caCrt, caKey, err := GenerateCertificateAuthority("etcd-ca")
+	require.NoError(t, err)
+
+	t.Run("CA cert is valid", func(t *testing.T) {
+		cert, err := ParseCertificateBytes(caCrt.Bytes())
+		require.NoError(t, err)
+		assert.True(t, cert.IsCA)
+		assert.Equal(t, "etcd-ca", cert.Subject.CommonName)
+		assert.NotZero(t, cert.KeyUsage&x509.KeyUsageCertSign)
+	})
+
+	t.Run("CA key pair is valid", func(t *testing.T) {
+		ok, err := IsValidCertificateKeyPairBytes(caCrt.Bytes(), caKey.Bytes(), time.Hour)
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("CA signs certificates", func(t *testing.T) {
+		crt, _, err := GenerateCertificatePrivateKeyPair(NewCertificateTemplate("root"), caCrt.Bytes(), caKey.Bytes())
+		require.NoError(t, err)
+
+		ok, err := VerifyCertificate(crt.Bytes(), caCrt.Bytes(), x509.ExtKeyUsageClientAuth)
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+}
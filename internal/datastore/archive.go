@@ -0,0 +1,216 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package datastore
+
+import (
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// ArchiveVersion is the version of the backup archive format.
+	ArchiveVersion = 1
+	// ArchiveExtension is the file extension of the backup archives.
+	ArchiveExtension = ".jsonl.gz"
+)
+
+// ArchiveHeader is the first record of a backup archive, describing the exported keyspace.
+type ArchiveHeader struct {
+	Version            int    `json:"version"`
+	Driver             string `json:"driver"`
+	TenantControlPlane string `json:"tenantControlPlane"`
+	Schema             string `json:"schema"`
+}
+
+// ArchiveRecord is a key of the exported keyspace:
+// keys are stored without the Tenant Control Plane prefix, allowing to restore them in any schema.
+type ArchiveRecord struct {
+	Key   string `json:"key"`
+	Value []byte `json:"value"`
+}
+
+// ArchiveWriter writes a keyspace in the portable backup archive format:
+// a gzip compressed stream of JSON lines, with the header followed by a record for each key.
+type ArchiveWriter struct {
+	output   *countingWriter
+	hash     hash.Hash
+	gzip     *gzip.Writer
+	encoder  *json.Encoder
+	keys     int64
+	revision int64
+}
+
+type countingWriter struct {
+	io.Writer
+
+	count int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.Writer.Write(p)
+	c.count += int64(n)
+
+	return n, err
+}
+
+func NewArchiveWriter(w io.Writer, header ArchiveHeader) (*ArchiveWriter, error) {
+	sum := sha256.New()
+	output := &countingWriter{Writer: io.MultiWriter(w, sum)}
+	compressed := gzip.NewWriter(output)
+
+	archive := &ArchiveWriter{
+		output:  output,
+		hash:    sum,
+		gzip:    compressed,
+		encoder: json.NewEncoder(compressed),
+	}
+
+	header.Version = ArchiveVersion
+
+	if err := archive.encoder.Encode(header); err != nil {
+		return nil, errors.Wrap(err, "cannot write the archive header")
+	}
+
+	return archive, nil
+}
+
+// Write appends the given key to the archive.
+func (a *ArchiveWriter) Write(key string, value []byte) error {
+	if err := a.encoder.Encode(ArchiveRecord{Key: key, Value: value}); err != nil {
+		return errors.Wrapf(err, "cannot write the key %s to the archive", key)
+	}
+
+	a.keys++
+
+	return nil
+}
+
+// SetRevision records the DataStore revision of the exported keyspace.
+func (a *ArchiveWriter) SetRevision(revision int64) {
+	a.revision = revision
+}
+
+// Close flushes the archive, without closing the underlying writer.
+func (a *ArchiveWriter) Close() error {
+	return a.gzip.Close()
+}
+
+// Keys returns the amount of keys written to the archive.
+func (a *ArchiveWriter) Keys() int64 {
+	return a.keys
+}
+
+// Revision returns the DataStore revision of the exported keyspace.
+func (a *ArchiveWriter) Revision() int64 {
+	return a.revision
+}
+
+// Size returns the amount of bytes written by the closed archive.
+func (a *ArchiveWriter) Size() int64 {
+	return a.output.count
+}
+
+// Checksum returns the SHA-256 checksum of the closed archive.
+func (a *ArchiveWriter) Checksum() string {
+	return "sha256:" + hex.EncodeToString(a.hash.Sum(nil))
+}
+
+// ArchiveReader reads a keyspace from the portable backup archive format.
+type ArchiveReader struct {
+	input   *countingReader
+	hash    hash.Hash
+	gzip    *gzip.Reader
+	decoder *json.Decoder
+	header  ArchiveHeader
+	keys    int64
+}
+
+type countingReader struct {
+	io.Reader
+
+	count int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.Reader.Read(p)
+	c.count += int64(n)
+
+	return n, err
+}
+
+func NewArchiveReader(r io.Reader) (*ArchiveReader, error) {
+	sum := sha256.New()
+	input := &countingReader{Reader: io.TeeReader(r, sum)}
+
+	compressed, err := gzip.NewReader(input)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot decompress the archive")
+	}
+
+	archive := &ArchiveReader{
+		input:   input,
+		hash:    sum,
+		gzip:    compressed,
+		decoder: json.NewDecoder(compressed),
+	}
+
+	if err = archive.decoder.Decode(&archive.header); err != nil {
+		return nil, errors.Wrap(err, "cannot read the archive header")
+	}
+
+	if archive.header.Version != ArchiveVersion {
+		return nil, fmt.Errorf("unsupported archive version %d", archive.header.Version)
+	}
+
+	return archive, nil
+}
+
+// Header returns the header of the archive.
+func (a *ArchiveReader) Header() ArchiveHeader {
+	return a.header
+}
+
+// Next returns the next key of the archive, or io.EOF once all the keys have been read.
+func (a *ArchiveReader) Next() (*ArchiveRecord, error) {
+	var record ArchiveRecord
+
+	if err := a.decoder.Decode(&record); err != nil {
+		if errors.Is(err, io.EOF) {
+			// Draining the compressed stream, the checksum covers the whole archive.
+			if _, err = io.Copy(io.Discard, a.input); err != nil {
+				return nil, errors.Wrap(err, "cannot read the archive")
+			}
+
+			return nil, io.EOF
+		}
+
+		return nil, errors.Wrap(err, "cannot read the archive key")
+	}
+
+	a.keys++
+
+	return &record, nil
+}
+
+// Keys returns the amount of keys read from the archive.
+func (a *ArchiveReader) Keys() int64 {
+	return a.keys
+}
+
+// Size returns the amount of bytes read from the archive.
+func (a *ArchiveReader) Size() int64 {
+	return a.input.count
+}
+
+// Checksum returns the SHA-256 checksum of the archive, once all the keys have been read.
+func (a *ArchiveReader) Checksum() string {
+	return "sha256:" + hex.EncodeToString(a.hash.Sum(nil))
+}
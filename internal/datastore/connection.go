@@ -41,6 +41,8 @@ func NewStorageConnection(ctx context.Context, client client.Client, ds stewardv
 		return NewETCDConnection(*cc)
 	case stewardv1alpha1.KineNatsDriver:
 		return NewNATSConnection(*cc)
+	case stewardv1alpha1.KineSQLiteDriver:
+		return NewSQLiteConnection(*cc)
 	default:
 		return nil, fmt.Errorf("%s is not a valid driver", ds.Spec.Driver)
 	}
@@ -61,4 +63,22 @@ type Connection interface {
 	Check(ctx context.Context) error
 	Driver() string
 	Migrate(ctx context.Context, tcp stewardv1alpha1.TenantControlPlane, target Connection) error
+	// Export dumps the keyspace of the given Tenant Control Plane into the archive,
+	// recording the DataStore revision the keyspace has been read at.
+	Export(ctx context.Context, tcp stewardv1alpha1.TenantControlPlane, archive *ArchiveWriter) error
+	// Import replaces the keyspace of the given Tenant Control Plane with the keys of the archive:
+	// the keys are written under the current schema, regardless of the one they have been exported from.
+	Import(ctx context.Context, tcp stewardv1alpha1.TenantControlPlane, archive *ArchiveReader) error
+	// Usage returns the storage used by the given schema.
+	Usage(ctx context.Context, schema string) (*Usage, error)
+}
+
+// Usage is the storage used by a Tenant Control Plane in its DataStore.
+type Usage struct {
+	// Keys is the amount of keys stored.
+	Keys int64
+	// Bytes is the space taken by the keys, as accounted by the driver:
+	// the size of the existing keys and their latest values, shrinking once keys are deleted,
+	// or the database size in use for an etcd cluster dedicated to the Tenant Control Plane.
+	Bytes int64
 }
@@ -0,0 +1,77 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package datastore
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/internal/builders/etcd"
+)
+
+// GetDataStore retrieves the DataStore with the given name for the Tenant Control Plane,
+// resolving the reserved DedicatedDataStoreName to the dedicated etcd cluster of the Tenant Control Plane.
+func GetDataStore(ctx context.Context, c client.Client, tcp stewardv1alpha1.TenantControlPlane, name string) (*stewardv1alpha1.DataStore, error) {
+	if name == stewardv1alpha1.DedicatedDataStoreName {
+		ds := DedicatedDataStore(tcp)
+
+		return &ds, nil
+	}
+
+	var ds stewardv1alpha1.DataStore
+	if err := c.Get(ctx, k8stypes.NamespacedName{Name: name}, &ds); err != nil {
+		return nil, errors.Wrap(err, "cannot retrieve *stewardv1alpha.DataStore object")
+	}
+
+	return &ds, nil
+}
+
+// DesiredDataStoreName returns the name of the DataStore the Tenant Control Plane must use, according to its DataStore mode.
+func DesiredDataStoreName(tcp stewardv1alpha1.TenantControlPlane) string {
+	if tcp.Spec.DataStoreMode == stewardv1alpha1.DataStoreModeDedicated {
+		return stewardv1alpha1.DedicatedDataStoreName
+	}
+
+	return tcp.Spec.DataStore
+}
+
+// DedicatedDataStore returns the DataStore backed by the etcd cluster dedicated to the Tenant Control Plane:
+// it's never persisted, and it's reported as not ready until the etcd cluster is bootstrapped with all the members ready.
+func DedicatedDataStore(tcp stewardv1alpha1.TenantControlPlane) stewardv1alpha1.DataStore {
+	cluster := etcd.DedicatedCluster(tcp)
+
+	ready := metav1.Condition{
+		Type:    stewardv1alpha1.ReadyCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  stewardv1alpha1.DataStoreHealthyReason,
+		Message: "the dedicated etcd cluster is ready",
+	}
+
+	if status := tcp.Status.Storage.Dedicated; status == nil || !status.Bootstrapped || status.ReadyMembers < status.Members {
+		ready.Status, ready.Reason, ready.Message = metav1.ConditionFalse, stewardv1alpha1.DataStoreProvisioningReason, "the dedicated etcd cluster is being provisioned"
+
+		if status != nil && status.Failure != "" {
+			ready.Message += ": " + status.Failure
+		}
+	}
+
+	return stewardv1alpha1.DataStore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: stewardv1alpha1.DedicatedDataStoreName,
+		},
+		Spec: stewardv1alpha1.DataStoreSpec{
+			Driver:    stewardv1alpha1.EtcdDriver,
+			Endpoints: cluster.Endpoints(),
+			TLSConfig: cluster.TLSConfig(),
+		},
+		Status: stewardv1alpha1.DataStoreStatus{
+			Conditions: []metav1.Condition{ready},
+		},
+	}
+}
@@ -5,12 +5,21 @@ package datastore
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
 	"fmt"
+	"hash"
+	"io"
+	"strings"
+	"time"
 
 	goerrors "github.com/pkg/errors"
 	"go.etcd.io/etcd/api/v3/authpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
 	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	etcdclient "go.etcd.io/etcd/client/v3"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
 	"github.com/butlerdotdev/steward/internal/datastore/errors"
@@ -182,16 +191,454 @@ func (e *EtcdClient) Migrate(ctx context.Context, tcp stewardv1alpha1.TenantCont
 		return err
 	}
 
-	response, err := e.Client.Get(ctx, e.buildKey(tcp.Status.Storage.Setup.Schema), etcdclient.WithPrefix())
+	prefix := e.buildKey(tcp.Status.Storage.Setup.Schema)
+	// The keys are paged at a pinned revision, and written in batches:
+	// the keyspace is never loaded in memory as a whole.
+	batch := &etcdBatch{client: targetClient}
+	leases := make(map[etcdclient.LeaseID]etcdclient.LeaseID)
+	source, leased := newEtcdDigest(), make(map[string][sha256.Size]byte)
+
+	revision, err := e.rangePrefix(ctx, prefix, false, func(kv *mvccpb.KeyValue) error {
+		var opts []etcdclient.OpOption
+
+		if kv.Lease != 0 {
+			lease, lErr := e.migrateLease(ctx, targetClient, leases, etcdclient.LeaseID(kv.Lease))
+			if lErr != nil {
+				return goerrors.Wrapf(lErr, "cannot migrate the lease of the key %s", kv.Key)
+			}
+
+			opts = append(opts, etcdclient.WithLease(lease))
+		}
+
+		// The keys attached to a lease could expire meanwhile: they're verified separately, by their value hash.
+		if kv.Lease == 0 {
+			source.add(kv)
+		} else {
+			leased[string(kv.Key)] = sha256.Sum256(kv.Value)
+		}
+
+		return batch.put(ctx, etcdclient.OpPut(string(kv.Key), string(kv.Value), opts...), len(kv.Key)+len(kv.Value))
+	})
 	if err != nil {
+		return goerrors.Wrap(err, "cannot migrate the keys")
+	}
+
+	if err = batch.flush(ctx); err != nil {
+		return goerrors.Wrap(err, "cannot migrate the keys")
+	}
+	// Verifying the target keyspace matches the migrated snapshot, including any stale key of a prior migration.
+	// A leased key must match the migrated one, although it could be missing once expired.
+	migrated, mismatched := newEtcdDigest(), 0
+
+	if _, err = targetClient.rangePrefix(ctx, prefix, false, func(kv *mvccpb.KeyValue) error {
+		if kv.Lease == 0 {
+			migrated.add(kv)
+
+			return nil
+		}
+
+		if sum, ok := leased[string(kv.Key)]; !ok || sum != sha256.Sum256(kv.Value) {
+			mismatched++
+		}
+
+		return nil
+	}); err != nil {
+		return goerrors.Wrap(err, "cannot retrieve the migrated keys")
+	}
+
+	if source.count != migrated.count || source.sum() != migrated.sum() {
+		return fmt.Errorf("the migrated keyspace doesn't match the one at revision %d, expected %d keys with digest %s, got %d keys with digest %s", revision, source.count, source.sum(), migrated.count, migrated.sum())
+	}
+
+	if mismatched > 0 {
+		return fmt.Errorf("the migrated keyspace doesn't match the one at revision %d, %d leased keys are stale or differ", revision, mismatched)
+	}
+
+	return nil
+}
+
+// migrateLease returns the target lease replacing the source one, granted with the remaining time to live:
+// the keys attached to a lease, such as the Events, keep expiring in the target DataStore.
+func (e *EtcdClient) migrateLease(ctx context.Context, target *EtcdClient, leases map[etcdclient.LeaseID]etcdclient.LeaseID, id etcdclient.LeaseID) (etcdclient.LeaseID, error) {
+	if lease, ok := leases[id]; ok {
+		return lease, nil
+	}
+
+	ttl, err := e.Client.TimeToLive(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+	// The lease expired after the revision has been pinned: the keys are going to be deleted shortly.
+	if ttl.TTL <= 0 {
+		ttl.TTL = 1
+	}
+
+	granted, err := target.Client.Grant(ctx, ttl.TTL)
+	if err != nil {
+		return 0, err
+	}
+
+	leases[id] = granted.ID
+
+	return granted.ID, nil
+}
+
+const (
+	// etcdMigrateBatchSize is the amount of keys written with a single transaction upon migration,
+	// below the default etcd limit of operations per transaction.
+	etcdMigrateBatchSize = 100
+	// etcdMigrateBatchBytes is the size of the keys written with a single transaction upon migration,
+	// below the default etcd limit of the request size.
+	etcdMigrateBatchBytes = 1 << 20
+)
+
+// etcdBatch groups the writes into transactions, limited in operations and size.
+type etcdBatch struct {
+	client *EtcdClient
+	ops    []etcdclient.Op
+	bytes  int
+}
+
+func (b *etcdBatch) put(ctx context.Context, op etcdclient.Op, size int) error {
+	if len(b.ops) > 0 && (len(b.ops) >= etcdMigrateBatchSize || b.bytes+size > etcdMigrateBatchBytes) {
+		if err := b.flush(ctx); err != nil {
+			return err
+		}
+	}
+
+	b.ops, b.bytes = append(b.ops, op), b.bytes+size
+
+	return nil
+}
+
+func (b *etcdBatch) flush(ctx context.Context) error {
+	if len(b.ops) == 0 {
+		return nil
+	}
+
+	if _, err := b.client.Client.Txn(ctx).Then(b.ops...).Commit(); err != nil {
 		return err
 	}
 
-	for _, kv := range response.Kvs {
-		if _, err = targetClient.Client.Put(ctx, string(kv.Key), string(kv.Value)); err != nil {
+	b.ops, b.bytes = b.ops[:0], 0
+
+	return nil
+}
+
+// etcdDigest hashes the keys, and their values, in the range order:
+// two keyspaces have the same digest when they contain the same keys with the same values.
+type etcdDigest struct {
+	hash  hash.Hash
+	count int64
+}
+
+func newEtcdDigest() *etcdDigest {
+	return &etcdDigest{hash: sha256.New()}
+}
+
+func (d *etcdDigest) add(kv *mvccpb.KeyValue) {
+	// Length-prefixing the fields avoids collisions between different key and value splits.
+	var size [8]byte
+
+	for _, field := range [][]byte{kv.Key, kv.Value} {
+		binary.BigEndian.PutUint64(size[:], uint64(len(field)))
+		d.hash.Write(size[:])
+		d.hash.Write(field)
+	}
+
+	d.count++
+}
+
+func (d *etcdDigest) sum() string {
+	return hex.EncodeToString(d.hash.Sum(nil))
+}
+
+// etcdPageSize is the amount of keys retrieved with a single range request upon export, import, and migration.
+const etcdPageSize = 500
+
+func (e *EtcdClient) Export(ctx context.Context, tcp stewardv1alpha1.TenantControlPlane, archive *ArchiveWriter) error {
+	prefix := e.buildKey(tcp.Status.Storage.Setup.Schema)
+
+	revision, err := e.rangePrefix(ctx, prefix, false, func(kv *mvccpb.KeyValue) error {
+		// Trimming the trailing slash too since the API Server prefix is /<schema>.
+		return archive.Write(strings.TrimPrefix(string(kv.Key), strings.TrimSuffix(prefix, "/")), kv.Value)
+	})
+	if err != nil {
+		return goerrors.Wrap(err, "cannot retrieve the keys to export")
+	}
+
+	archive.SetRevision(revision)
+
+	return nil
+}
+
+func (e *EtcdClient) Import(ctx context.Context, tcp stewardv1alpha1.TenantControlPlane, archive *ArchiveReader) error {
+	prefix := e.buildKey(tcp.Status.Storage.Setup.Schema)
+	// The keys missing from the archive are deleted once all the archive keys have been written:
+	// the running API Servers are notified of the changes by the watch, as for any other write.
+	stale := sets.New[string]()
+
+	if _, err := e.rangePrefix(ctx, prefix, true, func(kv *mvccpb.KeyValue) error {
+		stale.Insert(string(kv.Key))
+
+		return nil
+	}); err != nil {
+		return goerrors.Wrap(err, "cannot retrieve the keys to replace")
+	}
+
+	for {
+		record, err := archive.Next()
+		if goerrors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
 			return err
 		}
+
+		key := strings.TrimSuffix(prefix, "/") + record.Key
+		if _, err = e.Client.Put(ctx, key, string(record.Value)); err != nil {
+			return goerrors.Wrapf(err, "cannot import the key %s", key)
+		}
+
+		stale.Delete(key)
+	}
+
+	for key := range stale {
+		if _, err := e.Client.Delete(ctx, key); err != nil {
+			return goerrors.Wrapf(err, "cannot delete the key %s", key)
+		}
 	}
 
 	return nil
 }
+
+// etcdProgressInterval is how often the watch replaying the changes requests a progress notification.
+const etcdProgressInterval = time.Second
+
+func (e *EtcdClient) Changes(ctx context.Context, tcp stewardv1alpha1.TenantControlPlane, revision int64, fn func(change KeyChange) error) (int64, error) {
+	prefix := e.buildKey(tcp.Status.Storage.Setup.Schema)
+
+	response, err := e.Client.Get(ctx, prefix, etcdclient.WithPrefix(), etcdclient.WithCountOnly())
+	if err != nil {
+		return 0, goerrors.Wrap(err, "cannot retrieve the current revision")
+	}
+
+	current := response.Header.Revision
+	if current <= revision {
+		return current, nil
+	}
+
+	watchCtx, cancelFn := context.WithCancel(etcdclient.WithRequireLeader(ctx))
+	defer cancelFn()
+	// Watching from a compacted revision fails, rather than silently skipping the changes.
+	watch := e.Client.Watch(watchCtx, prefix, etcdclient.WithPrefix(), etcdclient.WithRev(revision+1))
+	// The watch notifies nothing without changes in the prefix:
+	// the progress notifications report the revision it caught up to.
+	ticker := time.NewTicker(etcdProgressInterval)
+	defer ticker.Stop()
+
+	ttls := make(map[int64]int64)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-ticker.C:
+			if err = e.Client.RequestProgress(watchCtx); err != nil {
+				return 0, goerrors.Wrap(err, "cannot request the watch progress")
+			}
+		case event, ok := <-watch:
+			if !ok {
+				return 0, fmt.Errorf("the watch has been closed unexpectedly")
+			}
+
+			if err = event.Err(); err != nil {
+				return 0, err
+			}
+
+			if event.IsProgressNotify() && event.Header.Revision >= current {
+				return current, nil
+			}
+
+			for _, ev := range event.Events {
+				if ev.Kv.ModRevision > current {
+					return current, nil
+				}
+
+				change := KeyChange{
+					Key:     strings.TrimPrefix(string(ev.Kv.Key), strings.TrimSuffix(prefix, "/")),
+					Value:   ev.Kv.Value,
+					Deleted: ev.Type == mvccpb.DELETE,
+				}
+
+				if ev.Type == mvccpb.PUT && ev.Kv.Lease != 0 {
+					if change.TTL, err = e.leaseTTL(ctx, ttls, ev.Kv.Lease); err != nil {
+						return 0, goerrors.Wrapf(err, "cannot retrieve the lease of the key %s", ev.Kv.Key)
+					}
+				}
+
+				if err = fn(change); err != nil {
+					return 0, err
+				}
+
+				if ev.Kv.ModRevision == current {
+					return current, nil
+				}
+			}
+		}
+	}
+}
+
+func (e *EtcdClient) Apply(ctx context.Context, tcp stewardv1alpha1.TenantControlPlane, changes []KeyChange) error {
+	prefix := strings.TrimSuffix(e.buildKey(tcp.Status.Storage.Setup.Schema), "/")
+	batch := &etcdBatch{client: e}
+	leases := make(map[int64]etcdclient.LeaseID)
+	// A transaction cannot change the same key twice: the batch is flushed before.
+	keys := sets.New[string]()
+
+	for _, change := range changes {
+		if keys.Has(change.Key) {
+			if err := batch.flush(ctx); err != nil {
+				return goerrors.Wrap(err, "cannot apply the changes")
+			}
+
+			keys.Clear()
+		}
+
+		keys.Insert(change.Key)
+
+		op := etcdclient.OpDelete(prefix + change.Key)
+
+		if !change.Deleted {
+			var opts []etcdclient.OpOption
+
+			if change.TTL > 0 {
+				lease, ok := leases[change.TTL]
+				if !ok {
+					granted, err := e.Client.Grant(ctx, change.TTL)
+					if err != nil {
+						return goerrors.Wrapf(err, "cannot grant the lease of the key %s", change.Key)
+					}
+
+					lease, leases[change.TTL] = granted.ID, granted.ID
+				}
+
+				opts = append(opts, etcdclient.WithLease(lease))
+			}
+
+			op = etcdclient.OpPut(prefix+change.Key, string(change.Value), opts...)
+		}
+
+		if err := batch.put(ctx, op, len(change.Key)+len(change.Value)); err != nil {
+			return goerrors.Wrap(err, "cannot apply the changes")
+		}
+	}
+
+	return goerrors.Wrap(batch.flush(ctx), "cannot apply the changes")
+}
+
+// leaseTTL returns the remaining time to live of the given lease, caching it:
+// an expired lease is reported with the minimum time to live, since its keys are going to be deleted shortly.
+func (e *EtcdClient) leaseTTL(ctx context.Context, ttls map[int64]int64, id int64) (int64, error) {
+	if ttl, ok := ttls[id]; ok {
+		return ttl, nil
+	}
+
+	response, err := e.Client.TimeToLive(ctx, etcdclient.LeaseID(id))
+	if err != nil {
+		return 0, err
+	}
+
+	ttls[id] = max(response.TTL, 1)
+
+	return ttls[id], nil
+}
+
+func (e *EtcdClient) Usage(ctx context.Context, schema string) (*Usage, error) {
+	keys, err := e.Client.Get(ctx, e.buildKey(schema), etcdclient.WithPrefix(), etcdclient.WithCountOnly())
+	if err != nil {
+		return nil, goerrors.Wrap(err, "cannot count the etcd keys")
+	}
+
+	all, err := e.Client.Get(ctx, "\x00", etcdclient.WithFromKey(), etcdclient.WithCountOnly())
+	if err != nil {
+		return nil, goerrors.Wrap(err, "cannot count the etcd keys")
+	}
+
+	usage := Usage{Keys: keys.Count}
+	// The etcd cluster is dedicated to the Tenant Control Plane: the database size in use accounts its keys,
+	// and it shrinks once the deleted keys have been compacted.
+	if all.Count == keys.Count {
+		if usage.Bytes, err = e.dbSizeInUse(ctx); err != nil {
+			return nil, goerrors.Wrap(err, "cannot retrieve the etcd database size")
+		}
+
+		return &usage, nil
+	}
+	// The database size of a shared etcd cluster accounts all the Tenant Control Planes: the keys and values are read.
+	if _, err = e.rangePrefix(ctx, e.buildKey(schema), false, func(kv *mvccpb.KeyValue) error {
+		usage.Bytes += int64(len(kv.Key) + len(kv.Value))
+
+		return nil
+	}); err != nil {
+		return nil, goerrors.Wrap(err, "cannot compute the etcd usage")
+	}
+
+	return &usage, nil
+}
+
+// dbSizeInUse returns the database size in use reported by the first reachable member.
+func (e *EtcdClient) dbSizeInUse(ctx context.Context) (int64, error) {
+	var err error
+
+	for _, endpoint := range e.Client.Endpoints() {
+		var status *etcdclient.StatusResponse
+
+		if status, err = e.Client.Status(ctx, endpoint); err == nil {
+			return status.DbSizeInUse, nil
+		}
+	}
+
+	return 0, err
+}
+
+// rangePrefix pages through the keys with the given prefix, returning the revision they have been read at:
+// the first page pins the revision, guaranteeing a consistent snapshot across the pages.
+func (e *EtcdClient) rangePrefix(ctx context.Context, prefix string, keysOnly bool, fn func(kv *mvccpb.KeyValue) error) (int64, error) {
+	end := etcdclient.GetPrefixRangeEnd(prefix)
+
+	var revision int64
+
+	for key := prefix; ; {
+		opts := []etcdclient.OpOption{etcdclient.WithRange(end), etcdclient.WithLimit(etcdPageSize)}
+		if revision > 0 {
+			opts = append(opts, etcdclient.WithRev(revision))
+		}
+
+		if keysOnly {
+			opts = append(opts, etcdclient.WithKeysOnly())
+		}
+
+		response, err := e.Client.Get(ctx, key, opts...)
+		if err != nil {
+			return 0, err
+		}
+
+		if revision == 0 {
+			revision = response.Header.Revision
+		}
+
+		for _, kv := range response.Kvs {
+			if err = fn(kv); err != nil {
+				return 0, err
+			}
+		}
+
+		if !response.More || len(response.Kvs) == 0 {
+			return revision, nil
+		}
+
+		key = string(append(response.Kvs[len(response.Kvs)-1].Key, 0))
+	}
+}
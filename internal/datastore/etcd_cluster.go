@@ -0,0 +1,101 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package datastore
+
+import (
+	"context"
+	"slices"
+
+	"github.com/pkg/errors"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	etcdclient "go.etcd.io/etcd/client/v3"
+)
+
+// The following functions operate the members of the etcd clusters managed by Steward.
+
+// EnableAuthentication creates the root user, bound to the root role, and enables the authentication:
+// the Tenant Control Planes are then allowed to access only their own key prefix.
+// It's idempotent, and it can be executed again upon a failure.
+func (e *EtcdClient) EnableAuthentication(ctx context.Context, rootUser string) error {
+	status, err := e.Client.AuthStatus(ctx)
+	if err != nil {
+		return errors.Wrap(err, "cannot retrieve the authentication status")
+	}
+
+	if status.Enabled {
+		return nil
+	}
+
+	if _, err = e.Client.UserAddWithOptions(ctx, rootUser, "", &etcdclient.UserAddOptions{NoPassword: true}); err != nil && !errors.Is(err, rpctypes.ErrUserAlreadyExist) {
+		return errors.Wrap(err, "cannot create the root user")
+	}
+
+	if _, err = e.Client.RoleAdd(ctx, rootUser); err != nil && !errors.Is(err, rpctypes.ErrRoleAlreadyExist) {
+		return errors.Wrap(err, "cannot create the root role")
+	}
+
+	if _, err = e.Client.UserGrantRole(ctx, rootUser, rootUser); err != nil {
+		return errors.Wrap(err, "cannot grant the root role")
+	}
+
+	if _, err = e.Client.AuthEnable(ctx); err != nil {
+		return errors.Wrap(err, "cannot enable the authentication")
+	}
+
+	return nil
+}
+
+// AddMember announces a new member with the given peer URL, which is then allowed to join the cluster:
+// it's a no-op if the member has been already announced.
+func (e *EtcdClient) AddMember(ctx context.Context, peerURL string) error {
+	members, err := e.Client.MemberList(ctx)
+	if err != nil {
+		return errors.Wrap(err, "cannot list the etcd members")
+	}
+
+	for _, member := range members.Members {
+		if slices.Contains(member.PeerURLs, peerURL) {
+			return nil
+		}
+	}
+
+	if _, err = e.Client.MemberAdd(ctx, []string{peerURL}); err != nil {
+		return errors.Wrap(err, "cannot add the etcd member")
+	}
+
+	return nil
+}
+
+// RemoveMember removes the member with the given peer URL from the cluster:
+// it's a no-op if the member has been already removed.
+func (e *EtcdClient) RemoveMember(ctx context.Context, peerURL string) error {
+	members, err := e.Client.MemberList(ctx)
+	if err != nil {
+		return errors.Wrap(err, "cannot list the etcd members")
+	}
+
+	for _, member := range members.Members {
+		if !slices.Contains(member.PeerURLs, peerURL) {
+			continue
+		}
+
+		if _, err = e.Client.MemberRemove(ctx, member.ID); err != nil {
+			return errors.Wrap(err, "cannot remove the etcd member")
+		}
+	}
+
+	return nil
+}
+
+// Defragment defragments the members one at a time:
+// the member is blocked during the defragmentation, thus the quorum is preserved.
+func (e *EtcdClient) Defragment(ctx context.Context) error {
+	for _, endpoint := range e.Client.Endpoints() {
+		if _, err := e.Client.Defragment(ctx, endpoint); err != nil {
+			return errors.Wrapf(err, "cannot defragment the etcd member %s", endpoint)
+		}
+	}
+
+	return nil
+}
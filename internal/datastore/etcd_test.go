@@ -0,0 +1,213 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package datastore
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	etcdclient "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/server/v3/embed"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+)
+
+// embeddedEtcd starts an etcd server for the current spec, returning a connection to it.
+func embeddedEtcd() *EtcdClient {
+	config := embed.NewConfig()
+	config.Dir = GinkgoT().TempDir()
+	config.LogLevel = "error"
+	// Binding random ports, allowing several servers to run at the same time.
+	listen := url.URL{Scheme: "http", Host: "127.0.0.1:0"}
+	config.ListenClientUrls, config.AdvertiseClientUrls = []url.URL{listen}, []url.URL{listen}
+	config.ListenPeerUrls = []url.URL{listen}
+
+	server, err := embed.StartEtcd(config)
+	Expect(err).ToNot(HaveOccurred())
+	DeferCleanup(server.Close)
+
+	Eventually(server.Server.ReadyNotify()).WithTimeout(time.Minute).Should(BeClosed())
+
+	client, err := etcdclient.New(etcdclient.Config{Endpoints: []string{server.Clients[0].Addr().String()}, DialTimeout: 5 * time.Second})
+	Expect(err).ToNot(HaveOccurred())
+	DeferCleanup(client.Close)
+
+	return &EtcdClient{Client: *client}
+}
+
+// txnCounter counts the transactions committed by the client.
+type txnCounter struct {
+	etcdclient.KV
+
+	count atomic.Int32
+}
+
+func (t *txnCounter) Txn(ctx context.Context) etcdclient.Txn {
+	t.count.Add(1)
+
+	return t.KV.Txn(ctx)
+}
+
+var _ = Describe("EtcdClient", func() {
+	var (
+		ctx            context.Context
+		tcp            stewardv1alpha1.TenantControlPlane
+		source, target *EtcdClient
+		txns           *txnCounter
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		tcp = stewardv1alpha1.TenantControlPlane{}
+		tcp.Status.Storage.Setup.Schema = "tenant"
+
+		source, target = embeddedEtcd(), embeddedEtcd()
+
+		txns = &txnCounter{KV: target.Client.KV}
+		target.Client.KV = txns
+	})
+
+	Describe("Migrate", func() {
+		It("should write the keys in transactions limited in operations", func() {
+			for i := range 250 {
+				_, err := source.Client.Put(ctx, fmt.Sprintf("/tenant/key-%03d", i), "value")
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			Expect(source.Migrate(ctx, tcp, target)).To(Succeed())
+			Expect(txns.count.Load()).To(Equal(int32(3)))
+
+			response, err := target.Client.Get(ctx, "/tenant/", etcdclient.WithPrefix(), etcdclient.WithCountOnly())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.Count).To(Equal(int64(250)))
+		})
+
+		It("should write the keys in transactions limited in size", func() {
+			value := strings.Repeat("x", 600*1024)
+
+			for i := range 3 {
+				_, err := source.Client.Put(ctx, fmt.Sprintf("/tenant/key-%d", i), value)
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			Expect(source.Migrate(ctx, tcp, target)).To(Succeed())
+			Expect(txns.count.Load()).To(Equal(int32(3)))
+		})
+
+		It("should attach the leased keys to a new lease expiring at the same time", func() {
+			lease, err := source.Client.Grant(ctx, 300)
+			Expect(err).ToNot(HaveOccurred())
+
+			for _, key := range []string{"/tenant/events/a", "/tenant/events/b"} {
+				_, err = source.Client.Put(ctx, key, "event", etcdclient.WithLease(lease.ID))
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			Expect(source.Migrate(ctx, tcp, target)).To(Succeed())
+
+			response, err := target.Client.Get(ctx, "/tenant/events/", etcdclient.WithPrefix())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.Kvs).To(HaveLen(2))
+			// The keys sharing a lease share the new one too.
+			Expect(response.Kvs[0].Lease).ToNot(BeZero())
+			Expect(response.Kvs[1].Lease).To(Equal(response.Kvs[0].Lease))
+
+			ttl, err := target.Client.TimeToLive(ctx, etcdclient.LeaseID(response.Kvs[0].Lease))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ttl.TTL).To(And(BeNumerically(">", 290), BeNumerically("<=", 300)))
+		})
+
+		It("should fail upon stale keys left in the target", func() {
+			_, err := source.Client.Put(ctx, "/tenant/key", "value")
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = target.Client.Put(ctx, "/tenant/stale", "value")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(source.Migrate(ctx, tcp, target)).To(MatchError(ContainSubstring("expected 1 keys")))
+		})
+
+		It("should fail upon stale leased keys left in the target", func() {
+			lease, err := target.Client.Grant(ctx, 300)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = target.Client.Put(ctx, "/tenant/events/stale", "event", etcdclient.WithLease(lease.ID))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(source.Migrate(ctx, tcp, target)).To(MatchError(ContainSubstring("1 leased keys are stale or differ")))
+		})
+	})
+
+	Describe("Import", func() {
+		It("should write the exported keys under the schema of the Tenant Control Plane", func() {
+			_, err := source.Client.Put(ctx, "/source/registry/pods/default/a", "a")
+			Expect(err).ToNot(HaveOccurred())
+
+			var buffer bytes.Buffer
+
+			writer, err := NewArchiveWriter(&buffer, ArchiveHeader{Version: ArchiveVersion, Driver: source.Driver(), Schema: "source"})
+			Expect(err).ToNot(HaveOccurred())
+
+			tcp.Status.Storage.Setup.Schema = "source"
+			Expect(source.Export(ctx, tcp, writer)).To(Succeed())
+			Expect(writer.Close()).To(Succeed())
+			// The target keys missing from the archive are deleted, the ones of the other schemas are left untouched.
+			for _, key := range []string{"/target/registry/pods/default/a", "/target/registry/pods/default/stale", "/other/registry/pods/default/a"} {
+				_, err = target.Client.Put(ctx, key, "stale")
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			reader, err := NewArchiveReader(&buffer)
+			Expect(err).ToNot(HaveOccurred())
+
+			tcp.Status.Storage.Setup.Schema = "target"
+			Expect(target.Import(ctx, tcp, reader)).To(Succeed())
+
+			response, err := target.Client.Get(ctx, "/", etcdclient.WithPrefix())
+			Expect(err).ToNot(HaveOccurred())
+
+			keys := make(map[string]string)
+			for _, kv := range response.Kvs {
+				keys[string(kv.Key)] = string(kv.Value)
+			}
+
+			Expect(keys).To(Equal(map[string]string{
+				"/target/registry/pods/default/a": "a",
+				"/other/registry/pods/default/a":  "stale",
+			}))
+		})
+	})
+})
+
+var _ = Describe("etcdDigest", func() {
+	digest := func(kvs ...string) *etcdDigest {
+		d := newEtcdDigest()
+		for i := 0; i < len(kvs); i += 2 {
+			d.add(&mvccpb.KeyValue{Key: []byte(kvs[i]), Value: []byte(kvs[i+1])})
+		}
+
+		return d
+	}
+
+	It("should match the same keys and values", func() {
+		Expect(digest("/a", "1", "/b", "2").sum()).To(Equal(digest("/a", "1", "/b", "2").sum()))
+	})
+
+	It("should differ upon a different value", func() {
+		Expect(digest("/a", "1", "/b", "2").sum()).ToNot(Equal(digest("/a", "1", "/b", "3").sum()))
+	})
+
+	It("should differ upon a different key and value split", func() {
+		Expect(digest("/ab", "c").sum()).ToNot(Equal(digest("/a", "bc").sum()))
+	})
+})
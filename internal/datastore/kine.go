@@ -0,0 +1,109 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package datastore
+
+import (
+	"context"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// kineRow is the latest row of a key in the Kine table.
+type kineRow struct {
+	ID             int64
+	Created        bool
+	Deleted        bool
+	CreateRevision int64
+}
+
+// createRevision returns the revision the key has been created at:
+// Kine doesn't store it in the row creating the key, since it matches its id.
+func (r kineRow) createRevision() int64 {
+	if r.Created {
+		return r.ID
+	}
+
+	return r.CreateRevision
+}
+
+// kineWriter appends the rows to the Kine table, as Kine does upon create, update, and delete.
+type kineWriter interface {
+	create(ctx context.Context, name string, prevRevision, lease int64, value []byte) error
+	update(ctx context.Context, name string, row kineRow, lease int64, value []byte) error
+	delete(ctx context.Context, name string, row kineRow) error
+}
+
+// kineImport replaces the keyspace of the Kine table with the keys of the archive by appending rows,
+// rather than rewriting the table: revisions keep increasing, and the running Kine instances notice the changes.
+func kineImport(ctx context.Context, latest map[string]kineRow, archive *ArchiveReader, writer kineWriter) error {
+	for {
+		record, err := archive.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+
+		row, found := latest[record.Key]
+
+		if err = kinePut(ctx, writer, record.Key, row, found, 0, record.Value); err != nil {
+			return errors.Wrapf(err, "cannot import the key %s", record.Key)
+		}
+
+		delete(latest, record.Key)
+	}
+
+	for name, row := range latest {
+		if row.Deleted {
+			continue
+		}
+
+		if err := writer.delete(ctx, name, row); err != nil {
+			return errors.Wrapf(err, "cannot delete the key %s", name)
+		}
+	}
+
+	return nil
+}
+
+// kinePut writes the value of the key, given its latest row, if found:
+// the lease is the time to live of the key in seconds, as Kine uses it as the lease id, zero if none.
+func kinePut(ctx context.Context, writer kineWriter, name string, row kineRow, found bool, lease int64, value []byte) error {
+	switch {
+	case !found:
+		return writer.create(ctx, name, 0, lease, value)
+	case row.Deleted:
+		return writer.create(ctx, name, row.ID, lease, value)
+	default:
+		return writer.update(ctx, name, row, lease, value)
+	}
+}
+
+// kineApply writes the changes of the live migration, retrieving the latest row of each key with the given function.
+func kineApply(ctx context.Context, changes []KeyChange, latest func(name string) (kineRow, bool, error), writer kineWriter) error {
+	for _, change := range changes {
+		row, found, err := latest(change.Key)
+		if err != nil {
+			return errors.Wrapf(err, "cannot retrieve the key %s", change.Key)
+		}
+
+		switch {
+		case change.Deleted && (!found || row.Deleted):
+			continue
+		case change.Deleted:
+			err = writer.delete(ctx, change.Key, row)
+		default:
+			err = kinePut(ctx, writer, change.Key, row, found, change.TTL, change.Value)
+		}
+
+		if err != nil {
+			return errors.Wrapf(err, "cannot replay the change of the key %s", change.Key)
+		}
+	}
+
+	return nil
+}
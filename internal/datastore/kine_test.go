@@ -0,0 +1,136 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package datastore
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+)
+
+// recordingKineWriter records the rows appended to the Kine table.
+type recordingKineWriter struct {
+	rows []string
+}
+
+func (w *recordingKineWriter) create(_ context.Context, name string, prevRevision, lease int64, value []byte) error {
+	w.rows = append(w.rows, fmt.Sprintf("create %s prev=%d lease=%d value=%s", name, prevRevision, lease, value))
+
+	return nil
+}
+
+func (w *recordingKineWriter) update(_ context.Context, name string, row kineRow, lease int64, value []byte) error {
+	w.rows = append(w.rows, fmt.Sprintf("update %s prev=%d created=%d lease=%d value=%s", name, row.ID, row.createRevision(), lease, value))
+
+	return nil
+}
+
+func (w *recordingKineWriter) delete(_ context.Context, name string, row kineRow) error {
+	w.rows = append(w.rows, fmt.Sprintf("delete %s prev=%d created=%d", name, row.ID, row.createRevision()))
+
+	return nil
+}
+
+var _ = Describe("Kine", func() {
+	var (
+		ctx    context.Context
+		writer *recordingKineWriter
+		latest func(name string) (kineRow, bool, error)
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		writer = &recordingKineWriter{}
+
+		rows := map[string]kineRow{
+			"/registry/pods/default/created": {ID: 10, Created: true},
+			"/registry/pods/default/updated": {ID: 12, CreateRevision: 7},
+			"/registry/pods/default/deleted": {ID: 14, Deleted: true, CreateRevision: 9},
+		}
+
+		latest = func(name string) (kineRow, bool, error) {
+			row, found := rows[name]
+
+			return row, found, nil
+		}
+	})
+
+	It("should append the rows replaying the changes", func() {
+		Expect(kineApply(ctx, []KeyChange{
+			{Key: "/registry/pods/default/new", Value: []byte("v1")},
+			{Key: "/registry/pods/default/created", Value: []byte("v2")},
+			{Key: "/registry/pods/default/updated", Value: []byte("v3")},
+			{Key: "/registry/pods/default/deleted", Value: []byte("v4")},
+		}, latest, writer)).To(Succeed())
+
+		Expect(writer.rows).To(Equal([]string{
+			"create /registry/pods/default/new prev=0 lease=0 value=v1",
+			// The row creating the key stores no create revision, since it matches its id.
+			"update /registry/pods/default/created prev=10 created=10 lease=0 value=v2",
+			"update /registry/pods/default/updated prev=12 created=7 lease=0 value=v3",
+			"create /registry/pods/default/deleted prev=14 lease=0 value=v4",
+		}))
+	})
+
+	It("should delete the existing keys only", func() {
+		Expect(kineApply(ctx, []KeyChange{
+			{Key: "/registry/pods/default/updated", Deleted: true},
+			{Key: "/registry/pods/default/deleted", Deleted: true},
+			{Key: "/registry/pods/default/missing", Deleted: true},
+		}, latest, writer)).To(Succeed())
+
+		Expect(writer.rows).To(Equal([]string{"delete /registry/pods/default/updated prev=12 created=7"}))
+	})
+
+	It("should carry the lease of the keys", func() {
+		Expect(kineApply(ctx, []KeyChange{
+			{Key: "/registry/events/default/new", Value: []byte("v1"), TTL: 3600},
+			{Key: "/registry/pods/default/updated", Value: []byte("v2"), TTL: 60},
+		}, latest, writer)).To(Succeed())
+
+		Expect(writer.rows).To(Equal([]string{
+			"create /registry/events/default/new prev=0 lease=3600 value=v1",
+			"update /registry/pods/default/updated prev=12 created=7 lease=60 value=v2",
+		}))
+	})
+
+	It("should replace the keyspace with the archive keys", func() {
+		var buffer bytes.Buffer
+		// The archive keys are stored without the prefix of the exported schema.
+		archive, err := NewArchiveWriter(&buffer, ArchiveHeader{Version: ArchiveVersion, Driver: string(stewardv1alpha1.EtcdDriver), Schema: "source"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(archive.Write("/registry/pods/default/new", []byte("v1"))).To(Succeed())
+		Expect(archive.Write("/registry/pods/default/updated", []byte("v2"))).To(Succeed())
+		Expect(archive.Close()).To(Succeed())
+
+		reader, err := NewArchiveReader(&buffer)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(kineImport(ctx, map[string]kineRow{
+			"/registry/pods/default/created": {ID: 10, Created: true},
+			"/registry/pods/default/updated": {ID: 12, CreateRevision: 7},
+			"/registry/pods/default/deleted": {ID: 14, Deleted: true, CreateRevision: 9},
+		}, reader, writer)).To(Succeed())
+
+		Expect(writer.rows).To(Equal([]string{
+			"create /registry/pods/default/new prev=0 lease=0 value=v1",
+			"update /registry/pods/default/updated prev=12 created=7 lease=0 value=v2",
+			"delete /registry/pods/default/created prev=10 created=10",
+		}))
+	})
+
+	It("should fail when the latest row cannot be retrieved", func() {
+		latest = func(string) (kineRow, bool, error) {
+			return kineRow{}, false, fmt.Errorf("connection refused")
+		}
+
+		Expect(kineApply(ctx, []KeyChange{{Key: "/registry/pods/default/new", Value: []byte("v1")}}, latest, writer)).To(MatchError(ContainSubstring("cannot retrieve the key /registry/pods/default/new: connection refused")))
+		Expect(writer.rows).To(BeEmpty())
+	})
+})
@@ -0,0 +1,96 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package datastore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+)
+
+// KeyChange is a change of the keyspace, replayed by the live migration.
+type KeyChange struct {
+	// Key is relative to the Tenant Control Plane schema, as the archive keys.
+	Key     string
+	Value   []byte
+	Deleted bool
+	// TTL is the remaining time to live, in seconds, of the lease attached to the key, zero if none:
+	// Kine doesn't track the lease expiration, thus its keys report the whole time to live.
+	TTL int64
+}
+
+// LiveMigrator is implemented by the drivers supporting the live migration:
+// the keyspace is copied at a revision while the writes continue,
+// and the changes occurred after it are replayed once the writes are blocked.
+type LiveMigrator interface {
+	// Changes calls fn with the changes of the keyspace occurred after the given revision, in order,
+	// returning the revision the changes have been read up to.
+	// It fails when the changes are no longer available, such as upon compaction.
+	Changes(ctx context.Context, tcp stewardv1alpha1.TenantControlPlane, revision int64, fn func(change KeyChange) error) (int64, error)
+	// Apply writes the given changes into the keyspace.
+	Apply(ctx context.Context, tcp stewardv1alpha1.TenantControlPlane, changes []KeyChange) error
+}
+
+// replayBatchSize is the amount of changes applied to the target DataStore at once.
+const replayBatchSize = 100
+
+// CheckLiveMigration ensures the keyspace can be live migrated between the given DataStores.
+func CheckLiveMigration(origin, target Connection) error {
+	for _, connection := range []Connection{origin, target} {
+		if _, ok := connection.(LiveMigrator); !ok {
+			return fmt.Errorf("the %s driver doesn't support the live migration", connection.Driver())
+		}
+	}
+
+	return nil
+}
+
+// Replay applies to the target DataStore the changes of the origin keyspace occurred after the given revision:
+// it returns the amount of replayed changes, and the revision of the origin they have been read up to.
+func Replay(ctx context.Context, tcp stewardv1alpha1.TenantControlPlane, origin, target Connection, revision int64) (int64, int64, error) {
+	if err := CheckLiveMigration(origin, target); err != nil {
+		return 0, 0, err
+	}
+
+	source, destination := origin.(LiveMigrator), target.(LiveMigrator) //nolint:forcetypeassert
+
+	var replayed int64
+
+	changes := make([]KeyChange, 0, replayBatchSize)
+
+	flush := func() error {
+		if len(changes) == 0 {
+			return nil
+		}
+
+		if err := destination.Apply(ctx, tcp, changes); err != nil {
+			return errors.Wrap(err, "cannot apply the changes to the target")
+		}
+
+		replayed += int64(len(changes))
+		changes = changes[:0]
+
+		return nil
+	}
+
+	current, err := source.Changes(ctx, tcp, revision, func(change KeyChange) error {
+		if changes = append(changes, change); len(changes) < replayBatchSize {
+			return nil
+		}
+
+		return flush()
+	})
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "cannot retrieve the changes after revision %d", revision)
+	}
+
+	if err = flush(); err != nil {
+		return 0, 0, err
+	}
+
+	return replayed, current, nil
+}
@@ -0,0 +1,114 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+)
+
+// fakeConnection is a Connection of the given driver, unimplemented methods panic.
+type fakeConnection struct {
+	Connection
+
+	driver string
+}
+
+func (c *fakeConnection) Driver() string {
+	return c.driver
+}
+
+// liveConnection replays the recorded changes, and records the applied ones.
+type liveConnection struct {
+	fakeConnection
+
+	changes  []KeyChange
+	revision int64
+	applied  [][]KeyChange
+	applyErr error
+}
+
+func (c *liveConnection) Changes(_ context.Context, _ stewardv1alpha1.TenantControlPlane, _ int64, fn func(change KeyChange) error) (int64, error) {
+	for _, change := range c.changes {
+		if err := fn(change); err != nil {
+			return 0, err
+		}
+	}
+
+	return c.revision, nil
+}
+
+func (c *liveConnection) Apply(_ context.Context, _ stewardv1alpha1.TenantControlPlane, changes []KeyChange) error {
+	if c.applyErr != nil {
+		return c.applyErr
+	}
+
+	c.applied = append(c.applied, slices.Clone(changes))
+
+	return nil
+}
+
+var _ = Describe("Live migration", func() {
+	var (
+		ctx            context.Context
+		tcp            stewardv1alpha1.TenantControlPlane
+		origin, target *liveConnection
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		origin = &liveConnection{fakeConnection: fakeConnection{driver: string(stewardv1alpha1.EtcdDriver)}, revision: 1250}
+		target = &liveConnection{fakeConnection: fakeConnection{driver: string(stewardv1alpha1.KinePostgreSQLDriver)}}
+
+		for i := range 250 {
+			origin.changes = append(origin.changes, KeyChange{Key: fmt.Sprintf("/registry/configmaps/default/%03d", i), Value: []byte("value"), Deleted: i%10 == 0})
+		}
+	})
+
+	It("should replay the changes in batches, in order", func() {
+		replayed, current, err := Replay(ctx, tcp, origin, target, 1000)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(replayed).To(Equal(int64(250)))
+		Expect(current).To(Equal(int64(1250)))
+
+		Expect(target.applied).To(HaveLen(3))
+		Expect(target.applied[0]).To(HaveLen(replayBatchSize))
+		Expect(target.applied[1]).To(HaveLen(replayBatchSize))
+		Expect(target.applied[2]).To(HaveLen(50))
+		Expect(slices.Concat(target.applied...)).To(Equal(origin.changes))
+	})
+
+	It("should apply nothing without changes", func() {
+		origin.changes = nil
+
+		replayed, current, err := Replay(ctx, tcp, origin, target, 1250)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(replayed).To(BeZero())
+		Expect(current).To(Equal(int64(1250)))
+		Expect(target.applied).To(BeEmpty())
+	})
+
+	It("should fail when the changes cannot be applied", func() {
+		target.applyErr = fmt.Errorf("connection refused")
+
+		_, _, err := Replay(ctx, tcp, origin, target, 1000)
+		Expect(err).To(MatchError(ContainSubstring("cannot apply the changes to the target: connection refused")))
+	})
+
+	It("should reject the drivers not supporting the live migration", func() {
+		nats := &fakeConnection{driver: string(stewardv1alpha1.KineNatsDriver)}
+
+		Expect(CheckLiveMigration(origin, target)).To(Succeed())
+		Expect(CheckLiveMigration(origin, nats)).To(MatchError("the NATS driver doesn't support the live migration"))
+
+		_, _, err := Replay(ctx, tcp, nats, target, 1000)
+		Expect(err).To(HaveOccurred())
+	})
+})
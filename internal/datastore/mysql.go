@@ -33,6 +33,18 @@ const (
 	mysqlDropDBStatement           = "DROP DATABASE IF EXISTS `%s`"
 	mysqlDropUserStatement         = "DROP USER IF EXISTS `%s`"
 	mysqlRevokePrivilegesStatement = "REVOKE ALL PRIVILEGES ON `%s`.* FROM `%s`"
+	mysqlKineRevisionStatement     = "SELECT COALESCE(MAX(id), 0) FROM `%s`.kine"
+	mysqlKineExportStatement       = "SELECT kv.name, kv.value FROM `%[1]s`.kine AS kv JOIN (SELECT MAX(id) AS id FROM `%[1]s`.kine GROUP BY name) AS latest ON kv.id = latest.id WHERE kv.deleted = 0 AND kv.name LIKE '/%%' ORDER BY kv.name"
+	mysqlKineCreateTableStatement  = "CREATE TABLE IF NOT EXISTS `%s`.kine (id BIGINT UNSIGNED AUTO_INCREMENT, name VARCHAR(630) CHARACTER SET ascii, created INTEGER, deleted INTEGER, create_revision BIGINT UNSIGNED, prev_revision BIGINT UNSIGNED, lease INTEGER, value MEDIUMBLOB, old_value MEDIUMBLOB, PRIMARY KEY (id))"
+	mysqlKineLatestStatement       = "SELECT kv.id, kv.name, kv.created, kv.deleted, kv.create_revision FROM `%[1]s`.kine AS kv JOIN (SELECT MAX(id) AS id FROM `%[1]s`.kine GROUP BY name) AS latest ON kv.id = latest.id WHERE kv.name LIKE '/%%'"
+	mysqlKineCreateStatement       = "INSERT INTO `%s`.kine (name, created, deleted, create_revision, prev_revision, lease, value, old_value) VALUES (?, 1, 0, 0, ?, ?, ?, NULL)"
+	mysqlKineUpdateStatement       = "INSERT INTO `%[1]s`.kine (name, created, deleted, create_revision, prev_revision, lease, value, old_value) SELECT name, 0, 0, ?, id, ?, ?, value FROM `%[1]s`.kine WHERE id = ?"
+	mysqlKineDeleteStatement       = "INSERT INTO `%[1]s`.kine (name, created, deleted, create_revision, prev_revision, lease, value, old_value) SELECT name, 0, 1, ?, id, 0, value, value FROM `%[1]s`.kine WHERE id = ?"
+	mysqlKineCompactStatement      = "SELECT COALESCE(MAX(prev_revision), 0) FROM `%s`.kine WHERE name = 'compact_rev_key'"
+	mysqlKineChangesStatement      = "SELECT name, deleted, value, lease FROM `%s`.kine WHERE id > ? AND id <= ? AND name LIKE '/%%' ORDER BY id"
+	mysqlKineLatestKeyStatement    = "SELECT id, created, deleted, create_revision FROM `%s`.kine WHERE name = ? ORDER BY id DESC LIMIT 1"
+	mysqlKineTableExistsStatement  = "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = ? AND table_name = 'kine'"
+	mysqlKineUsageStatement        = "SELECT COUNT(*), COALESCE(SUM(LENGTH(kv.name) + COALESCE(LENGTH(kv.value), 0)), 0) FROM `%[1]s`.kine AS kv JOIN (SELECT MAX(id) AS id FROM `%[1]s`.kine GROUP BY name) AS latest ON kv.id = latest.id WHERE kv.deleted = 0 AND kv.name LIKE '/%%'"
 )
 
 type MySQLConnection struct {
@@ -91,6 +103,230 @@ func (c *MySQLConnection) Migrate(ctx context.Context, tcp stewardv1alpha1.Tenan
 	return nil
 }
 
+func (c *MySQLConnection) Export(ctx context.Context, tcp stewardv1alpha1.TenantControlPlane, archive *ArchiveWriter) error {
+	// The repeatable read transaction guarantees the exported keys are consistent with the revision.
+	tx, err := c.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return fmt.Errorf("unable to start the MySQL export transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	var revision int64
+	if err = tx.QueryRowContext(ctx, fmt.Sprintf(mysqlKineRevisionStatement, tcp.Status.Storage.Setup.Schema)).Scan(&revision); err != nil {
+		return fmt.Errorf("unable to retrieve the MySQL revision: %w", err)
+	}
+
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf(mysqlKineExportStatement, tcp.Status.Storage.Setup.Schema))
+	if err != nil {
+		return fmt.Errorf("unable to retrieve the MySQL keys to export: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var key string
+		var value []byte
+
+		if err = rows.Scan(&key, &value); err != nil {
+			return fmt.Errorf("unable to scan the MySQL key to export: %w", err)
+		}
+
+		if err = archive.Write(key, value); err != nil {
+			return err
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("unable to export the MySQL keys: %w", err)
+	}
+
+	archive.SetRevision(revision)
+
+	return nil
+}
+
+func (c *MySQLConnection) Import(ctx context.Context, tcp stewardv1alpha1.TenantControlPlane, archive *ArchiveReader) error {
+	schema := tcp.Status.Storage.Setup.Schema
+	// The table is created by Kine upon start, the restored Tenant Control Plane could have never been started.
+	if _, err := c.db.ExecContext(ctx, fmt.Sprintf(mysqlKineCreateTableStatement, schema)); err != nil {
+		return fmt.Errorf("unable to create the MySQL Kine table: %w", err)
+	}
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("unable to start the MySQL import transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf(mysqlKineLatestStatement, schema))
+	if err != nil {
+		return fmt.Errorf("unable to retrieve the MySQL keys to replace: %w", err)
+	}
+	defer rows.Close()
+
+	latest := make(map[string]kineRow)
+
+	for rows.Next() {
+		var name string
+		var row kineRow
+		var createRevision sql.NullInt64
+
+		if err = rows.Scan(&row.ID, &name, &row.Created, &row.Deleted, &createRevision); err != nil {
+			return fmt.Errorf("unable to scan the MySQL key to replace: %w", err)
+		}
+
+		row.CreateRevision = createRevision.Int64
+		latest[name] = row
+	}
+
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("unable to retrieve the MySQL keys to replace: %w", err)
+	}
+
+	if err = kineImport(ctx, latest, archive, &mysqlKineWriter{tx: tx, schema: schema}); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("unable to commit the MySQL import transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (c *MySQLConnection) Usage(ctx context.Context, schema string) (*Usage, error) {
+	var tables int
+	var usage Usage
+
+	if err := c.db.QueryRowContext(ctx, mysqlKineTableExistsStatement, schema).Scan(&tables); err != nil {
+		return nil, fmt.Errorf("unable to check the MySQL table: %w", err)
+	}
+	// The table is created by Kine upon start.
+	if tables == 0 {
+		return &usage, nil
+	}
+	// The latest rows of the existing keys only are accounted: the table size includes the rows not compacted yet.
+	if err := c.db.QueryRowContext(ctx, fmt.Sprintf(mysqlKineUsageStatement, schema)).Scan(&usage.Keys, &usage.Bytes); err != nil {
+		return nil, fmt.Errorf("unable to retrieve the MySQL usage: %w", err)
+	}
+
+	return &usage, nil
+}
+
+func (c *MySQLConnection) Changes(ctx context.Context, tcp stewardv1alpha1.TenantControlPlane, revision int64, fn func(change KeyChange) error) (int64, error) {
+	schema := tcp.Status.Storage.Setup.Schema
+
+	tx, err := c.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return 0, fmt.Errorf("unable to start the MySQL changes transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	var current, compacted int64
+	if err = tx.QueryRowContext(ctx, fmt.Sprintf(mysqlKineRevisionStatement, schema)).Scan(&current); err != nil {
+		return 0, fmt.Errorf("unable to retrieve the MySQL revision: %w", err)
+	}
+	// The compaction drops the rows of the deleted keys: the deletions occurred after the revision would be lost.
+	if err = tx.QueryRowContext(ctx, fmt.Sprintf(mysqlKineCompactStatement, schema)).Scan(&compacted); err != nil {
+		return 0, fmt.Errorf("unable to retrieve the MySQL compacted revision: %w", err)
+	}
+
+	if compacted > revision {
+		return 0, fmt.Errorf("the MySQL keyspace has been compacted at revision %d", compacted)
+	}
+
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf(mysqlKineChangesStatement, schema), revision, current)
+	if err != nil {
+		return 0, fmt.Errorf("unable to retrieve the MySQL changes: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var change KeyChange
+
+		if err = rows.Scan(&change.Key, &change.Deleted, &change.Value, &change.TTL); err != nil {
+			return 0, fmt.Errorf("unable to scan the MySQL change: %w", err)
+		}
+
+		if err = fn(change); err != nil {
+			return 0, err
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return 0, fmt.Errorf("unable to retrieve the MySQL changes: %w", err)
+	}
+
+	return current, nil
+}
+
+func (c *MySQLConnection) Apply(ctx context.Context, tcp stewardv1alpha1.TenantControlPlane, changes []KeyChange) error {
+	schema := tcp.Status.Storage.Setup.Schema
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("unable to start the MySQL apply transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	latest := func(name string) (kineRow, bool, error) {
+		var row kineRow
+		var createRevision sql.NullInt64
+
+		if qErr := tx.QueryRowContext(ctx, fmt.Sprintf(mysqlKineLatestKeyStatement, schema), name).Scan(&row.ID, &row.Created, &row.Deleted, &createRevision); qErr != nil {
+			if c.checkEmptyQueryResult(qErr) {
+				return row, false, nil
+			}
+
+			return row, false, qErr
+		}
+
+		row.CreateRevision = createRevision.Int64
+
+		return row, true, nil
+	}
+
+	if err = kineApply(ctx, changes, latest, &mysqlKineWriter{tx: tx, schema: schema}); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("unable to commit the MySQL apply transaction: %w", err)
+	}
+
+	return nil
+}
+
+type mysqlKineWriter struct {
+	tx     *sql.Tx
+	schema string
+}
+
+func (w *mysqlKineWriter) create(ctx context.Context, name string, prevRevision, lease int64, value []byte) error {
+	_, err := w.tx.ExecContext(ctx, fmt.Sprintf(mysqlKineCreateStatement, w.schema), name, prevRevision, lease, value)
+
+	return err
+}
+
+func (w *mysqlKineWriter) update(ctx context.Context, _ string, row kineRow, lease int64, value []byte) error {
+	_, err := w.tx.ExecContext(ctx, fmt.Sprintf(mysqlKineUpdateStatement, w.schema), row.createRevision(), lease, value, row.ID)
+
+	return err
+}
+
+func (w *mysqlKineWriter) delete(ctx context.Context, _ string, row kineRow) error {
+	_, err := w.tx.ExecContext(ctx, fmt.Sprintf(mysqlKineDeleteStatement, w.schema), row.createRevision(), row.ID)
+
+	return err
+}
+
 func (c *MySQLConnection) Driver() string {
 	return string(stewardv1alpha1.KineMySQLDriver)
 }
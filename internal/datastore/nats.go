@@ -6,10 +6,12 @@ package datastore
 import (
 	"context"
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/nats-io/nats.go"
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
 )
@@ -182,3 +184,124 @@ func (nc *NATSConnection) Migrate(ctx context.Context, tcp stewardv1alpha1.Tenan
 
 	return nil
 }
+
+// Export dumps the keys of the Kine bucket as they're stored,
+// since the keys and the values are encoded by the Kine NATS backend.
+func (nc *NATSConnection) Export(ctx context.Context, tcp stewardv1alpha1.TenantControlPlane, archive *ArchiveWriter) error {
+	kv, err := nc.js.KeyValue(tcp.Status.Storage.Setup.Schema)
+	if err != nil {
+		return errors.Wrap(err, "unable to retrieve the NATS bucket")
+	}
+
+	var revision uint64
+
+	if err = natsLatest(ctx, kv, func(entry nats.KeyValueEntry) error {
+		revision = max(revision, entry.Revision())
+
+		return archive.Write(entry.Key(), entry.Value())
+	}); err != nil {
+		return errors.Wrap(err, "unable to export the NATS keys")
+	}
+
+	archive.SetRevision(int64(revision)) //nolint:gosec
+
+	return nil
+}
+
+// natsLatest calls fn with the latest value of the existing keys of the bucket.
+// NATS cannot read a bucket at a given revision: the values are delivered by a single consumer
+// created upon the call, as a consistent snapshot of the bucket at that time.
+func natsLatest(ctx context.Context, kv nats.KeyValue, fn func(entry nats.KeyValueEntry) error) error {
+	watcher, err := kv.WatchAll(nats.IgnoreDeletes(), nats.Context(ctx))
+	if err != nil {
+		return errors.Wrap(err, "unable to watch the NATS keys")
+	}
+	defer func() {
+		_ = watcher.Stop()
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case entry, ok := <-watcher.Updates():
+			if !ok {
+				return fmt.Errorf("the NATS watch has been closed unexpectedly")
+			}
+			// The nil entry marks the end of the snapshot, the following ones are the changes happened meanwhile.
+			if entry == nil {
+				return nil
+			}
+
+			if err = fn(entry); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Import writes the keys of the archive as they're stored,
+// requiring an archive exported from a Kine NATS backend.
+func (nc *NATSConnection) Import(_ context.Context, tcp stewardv1alpha1.TenantControlPlane, archive *ArchiveReader) error {
+	if driver := archive.Header().Driver; driver != string(stewardv1alpha1.KineNatsDriver) {
+		return fmt.Errorf("cannot import an archive exported from the %s driver into NATS", driver)
+	}
+
+	kv, err := nc.js.KeyValue(tcp.Status.Storage.Setup.Schema)
+	if err != nil {
+		return errors.Wrap(err, "unable to retrieve the NATS bucket")
+	}
+
+	keys, err := kv.Keys()
+	if err != nil && !errors.Is(err, nats.ErrNoKeysFound) {
+		return errors.Wrap(err, "unable to list the NATS keys")
+	}
+
+	stale := sets.New[string](keys...)
+
+	for {
+		record, nErr := archive.Next()
+		if errors.Is(nErr, io.EOF) {
+			break
+		}
+
+		if nErr != nil {
+			return nErr
+		}
+
+		if _, err = kv.Put(record.Key, record.Value); err != nil {
+			return errors.Wrapf(err, "unable to import the NATS key %s", record.Key)
+		}
+
+		stale.Delete(record.Key)
+	}
+
+	for key := range stale {
+		if err = kv.Delete(key); err != nil {
+			return errors.Wrapf(err, "unable to delete the NATS key %s", key)
+		}
+	}
+
+	return nil
+}
+
+func (nc *NATSConnection) Usage(ctx context.Context, schema string) (*Usage, error) {
+	kv, err := nc.js.KeyValue(schema)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to retrieve the NATS bucket")
+	}
+	// The bucket size includes the history of the keys, and the delete markers:
+	// the latest values of the existing keys only are accounted.
+	var usage Usage
+
+	if err = natsLatest(ctx, kv, func(entry nats.KeyValueEntry) error {
+		usage.Keys++
+		usage.Bytes += int64(len(entry.Key()) + len(entry.Value()))
+
+		return nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "unable to retrieve the NATS keys")
+	}
+
+	return &usage, nil
+}
@@ -30,8 +30,39 @@ const (
 	postgresqlRevokePrivilegesStatement   = "REVOKE ALL PRIVILEGES ON DATABASE %s FROM %s"
 	postgresqlDropRoleStatement           = "DROP ROLE %s"
 	postgresqlDropDBStatement             = "DROP DATABASE %s WITH (FORCE)"
+	postgresqlKineRevisionStatement       = "SELECT COALESCE(MAX(id), 0) FROM kine"
+	postgresqlKineExportCursorStatement   = "DECLARE kine_export NO SCROLL CURSOR FOR SELECT kv.name, kv.value FROM kine AS kv JOIN (SELECT MAX(id) AS id FROM kine GROUP BY name) AS latest ON kv.id = latest.id WHERE kv.deleted = 0 AND kv.name LIKE '/%' ORDER BY kv.name"
+	postgresqlKineExportFetchStatement    = "FETCH 500 FROM kine_export"
+	postgresqlKineLatestStatement         = "SELECT kv.id, kv.name, kv.created, kv.deleted, kv.create_revision FROM kine AS kv JOIN (SELECT MAX(id) AS id FROM kine GROUP BY name) AS latest ON kv.id = latest.id WHERE kv.name LIKE '/%'"
+	postgresqlKineCreateStatement         = "INSERT INTO kine (name, created, deleted, create_revision, prev_revision, lease, value, old_value) VALUES (?, 1, 0, 0, ?, ?, ?, NULL)"
+	postgresqlKineUpdateStatement         = "INSERT INTO kine (name, created, deleted, create_revision, prev_revision, lease, value, old_value) SELECT name, 0, 0, ?, id, ?, ?, value FROM kine WHERE id = ?"
+	postgresqlKineDeleteStatement         = "INSERT INTO kine (name, created, deleted, create_revision, prev_revision, lease, value, old_value) SELECT name, 0, 1, ?, id, 0, value, value FROM kine WHERE id = ?"
+	postgresqlKineCompactStatement        = "SELECT COALESCE(MAX(prev_revision), 0) FROM kine WHERE name = 'compact_rev_key'"
+	postgresqlKineChangesStatement        = "SELECT name, deleted, value, lease FROM kine WHERE id > ? AND id <= ? AND name LIKE '/%' ORDER BY id"
+	postgresqlKineLatestKeyStatement      = "SELECT id, created, deleted, create_revision FROM kine WHERE name = ? ORDER BY id DESC LIMIT 1"
+	postgresqlKineUsageStatement          = "SELECT COUNT(*), COALESCE(SUM(octet_length(kv.name) + COALESCE(octet_length(kv.value), 0)), 0) FROM kine AS kv JOIN (SELECT MAX(id) AS id FROM kine GROUP BY name) AS latest ON kv.id = latest.id WHERE kv.deleted = 0 AND kv.name LIKE '/%'"
 )
 
+// postgresqlKineSchemaStatements creates the Kine table, as Kine does upon start.
+var postgresqlKineSchemaStatements = []string{
+	`CREATE TABLE IF NOT EXISTS kine (
+		id SERIAL PRIMARY KEY,
+		name VARCHAR(630),
+		created INTEGER,
+		deleted INTEGER,
+		create_revision INTEGER,
+		prev_revision INTEGER,
+		lease INTEGER,
+		value bytea,
+		old_value bytea
+	)`,
+	`CREATE INDEX IF NOT EXISTS kine_name_index ON kine (name)`,
+	`CREATE INDEX IF NOT EXISTS kine_name_id_index ON kine (name,id)`,
+	`CREATE INDEX IF NOT EXISTS kine_id_deleted_index ON kine (id,deleted)`,
+	`CREATE INDEX IF NOT EXISTS kine_prev_revision_index ON kine (prev_revision)`,
+	`CREATE UNIQUE INDEX IF NOT EXISTS kine_name_prev_revision_uindex ON kine (name, prev_revision)`,
+}
+
 type PostgreSQLConnection struct {
 	db               *pg.DB
 	connection       ConnectionEndpoint
@@ -54,25 +85,7 @@ func (r *PostgreSQLConnection) Migrate(ctx context.Context, tcp stewardv1alpha1.
 	targetConn := target.(*PostgreSQLConnection).switchDatabaseFn(tcp.Status.Storage.Setup.Schema) //nolint:forcetypeassert
 
 	err := targetConn.RunInTransaction(ctx, func(tx *pg.Tx) error {
-		for _, stm := range []string{
-			`CREATE TABLE IF NOT EXISTS kine (
-				id SERIAL PRIMARY KEY,
-				name VARCHAR(630),
-				created INTEGER,
-				deleted INTEGER,
-				create_revision INTEGER,
-				prev_revision INTEGER,
-				lease INTEGER,
-				value bytea,
-				old_value bytea
-			)`,
-			`TRUNCATE TABLE kine`,
-			`CREATE INDEX IF NOT EXISTS kine_name_index ON kine (name)`,
-			`CREATE INDEX IF NOT EXISTS kine_name_id_index ON kine (name,id)`,
-			`CREATE INDEX IF NOT EXISTS kine_id_deleted_index ON kine (id,deleted)`,
-			`CREATE INDEX IF NOT EXISTS kine_prev_revision_index ON kine (prev_revision)`,
-			`CREATE UNIQUE INDEX IF NOT EXISTS kine_name_prev_revision_uindex ON kine (name, prev_revision)`,
-		} {
+		for _, stm := range append(postgresqlKineSchemaStatements, `TRUNCATE TABLE kine`) {
 			if _, err := tx.ExecContext(ctx, stm); err != nil {
 				return fmt.Errorf("unable to perform schema creation: %w", err)
 			}
@@ -97,6 +110,220 @@ func (r *PostgreSQLConnection) Migrate(ctx context.Context, tcp stewardv1alpha1.
 	return nil
 }
 
+func (r *PostgreSQLConnection) Export(ctx context.Context, tcp stewardv1alpha1.TenantControlPlane, archive *ArchiveWriter) error {
+	db := r.switchDatabaseFn(tcp.Status.Storage.Setup.Schema)
+	defer db.Close()
+
+	var revision int64
+
+	err := db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		// The repeatable read transaction guarantees the exported keys are consistent with the revision.
+		if _, err := tx.ExecContext(ctx, "SET TRANSACTION ISOLATION LEVEL REPEATABLE READ READ ONLY"); err != nil {
+			return err
+		}
+
+		if _, err := tx.QueryOneContext(ctx, pg.Scan(&revision), postgresqlKineRevisionStatement); err != nil {
+			return err
+		}
+		// The keys are fetched in pages through a cursor: the keyspace is never loaded in memory as a whole.
+		if _, err := tx.ExecContext(ctx, postgresqlKineExportCursorStatement); err != nil {
+			return err
+		}
+
+		for {
+			var records []struct {
+				Name  string `pg:"name"`
+				Value []byte `pg:"value"`
+			}
+
+			if _, err := tx.QueryContext(ctx, &records, postgresqlKineExportFetchStatement); err != nil {
+				return err
+			}
+
+			if len(records) == 0 {
+				return nil
+			}
+
+			for _, record := range records {
+				if err := archive.Write(record.Name, record.Value); err != nil {
+					return err
+				}
+			}
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("unable to export the PostgreSQL keys: %w", err)
+	}
+
+	archive.SetRevision(revision)
+
+	return nil
+}
+
+func (r *PostgreSQLConnection) Import(ctx context.Context, tcp stewardv1alpha1.TenantControlPlane, archive *ArchiveReader) error {
+	db := r.switchDatabaseFn(tcp.Status.Storage.Setup.Schema)
+	defer db.Close()
+
+	err := db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		// The table is created by Kine upon start, the restored Tenant Control Plane could have never been started.
+		for _, stm := range postgresqlKineSchemaStatements {
+			if _, err := tx.ExecContext(ctx, stm); err != nil {
+				return fmt.Errorf("unable to perform schema creation: %w", err)
+			}
+		}
+
+		var rows []struct {
+			ID             int64  `pg:"id"`
+			Name           string `pg:"name"`
+			Created        int    `pg:"created"`
+			Deleted        int    `pg:"deleted"`
+			CreateRevision int64  `pg:"create_revision"`
+		}
+
+		if _, err := tx.QueryContext(ctx, &rows, postgresqlKineLatestStatement); err != nil {
+			return fmt.Errorf("unable to retrieve the PostgreSQL keys to replace: %w", err)
+		}
+
+		latest := make(map[string]kineRow, len(rows))
+		for _, row := range rows {
+			latest[row.Name] = kineRow{ID: row.ID, Created: row.Created == 1, Deleted: row.Deleted == 1, CreateRevision: row.CreateRevision}
+		}
+
+		return kineImport(ctx, latest, archive, &postgresqlKineWriter{tx: tx})
+	})
+	if err != nil {
+		return fmt.Errorf("unable to perform import transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgreSQLConnection) Usage(ctx context.Context, schema string) (*Usage, error) {
+	db := r.switchDatabaseFn(schema)
+	defer db.Close()
+
+	var usage Usage
+
+	exists, err := r.kineTableExists(ctx, db)
+	if err != nil {
+		return nil, fmt.Errorf("unable to check the PostgreSQL table: %w", err)
+	}
+	// The table is created by Kine upon start.
+	if !exists {
+		return &usage, nil
+	}
+
+	// The latest rows of the existing keys only are accounted: the table size includes the rows not compacted yet.
+	if _, err = db.QueryOneContext(ctx, pg.Scan(&usage.Keys, &usage.Bytes), postgresqlKineUsageStatement); err != nil {
+		return nil, fmt.Errorf("unable to retrieve the PostgreSQL usage: %w", err)
+	}
+
+	return &usage, nil
+}
+
+func (r *PostgreSQLConnection) Changes(ctx context.Context, tcp stewardv1alpha1.TenantControlPlane, revision int64, fn func(change KeyChange) error) (int64, error) {
+	db := r.switchDatabaseFn(tcp.Status.Storage.Setup.Schema)
+	defer db.Close()
+
+	var records []struct {
+		Name    string `pg:"name"`
+		Deleted int    `pg:"deleted"`
+		Value   []byte `pg:"value"`
+		Lease   int64  `pg:"lease"`
+	}
+
+	var current, compacted int64
+
+	err := db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		// The repeatable read transaction guarantees the changes are consistent with the current revision.
+		if _, err := tx.ExecContext(ctx, "SET TRANSACTION ISOLATION LEVEL REPEATABLE READ READ ONLY"); err != nil {
+			return err
+		}
+
+		if _, err := tx.QueryOneContext(ctx, pg.Scan(&current), postgresqlKineRevisionStatement); err != nil {
+			return err
+		}
+		// The compaction drops the rows of the deleted keys: the deletions occurred after the revision would be lost.
+		if _, err := tx.QueryOneContext(ctx, pg.Scan(&compacted), postgresqlKineCompactStatement); err != nil {
+			return err
+		}
+
+		if compacted > revision {
+			return fmt.Errorf("the keyspace has been compacted at revision %d", compacted)
+		}
+
+		_, err := tx.QueryContext(ctx, &records, postgresqlKineChangesStatement, revision, current)
+
+		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("unable to retrieve the PostgreSQL changes: %w", err)
+	}
+
+	for _, record := range records {
+		if err = fn(KeyChange{Key: record.Name, Value: record.Value, Deleted: record.Deleted == 1, TTL: record.Lease}); err != nil {
+			return 0, err
+		}
+	}
+
+	return current, nil
+}
+
+func (r *PostgreSQLConnection) Apply(ctx context.Context, tcp stewardv1alpha1.TenantControlPlane, changes []KeyChange) error {
+	db := r.switchDatabaseFn(tcp.Status.Storage.Setup.Schema)
+	defer db.Close()
+
+	err := db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		latest := func(name string) (kineRow, bool, error) {
+			var row struct {
+				ID             int64 `pg:"id"`
+				Created        int   `pg:"created"`
+				Deleted        int   `pg:"deleted"`
+				CreateRevision int64 `pg:"create_revision"`
+			}
+
+			if _, err := tx.QueryOneContext(ctx, &row, postgresqlKineLatestKeyStatement, name); err != nil {
+				if goerrors.Is(err, pg.ErrNoRows) {
+					return kineRow{}, false, nil
+				}
+
+				return kineRow{}, false, err
+			}
+
+			return kineRow{ID: row.ID, Created: row.Created == 1, Deleted: row.Deleted == 1, CreateRevision: row.CreateRevision}, true, nil
+		}
+
+		return kineApply(ctx, changes, latest, &postgresqlKineWriter{tx: tx})
+	})
+	if err != nil {
+		return fmt.Errorf("unable to perform apply transaction: %w", err)
+	}
+
+	return nil
+}
+
+type postgresqlKineWriter struct {
+	tx *pg.Tx
+}
+
+func (w *postgresqlKineWriter) create(ctx context.Context, name string, prevRevision, lease int64, value []byte) error {
+	_, err := w.tx.ExecContext(ctx, postgresqlKineCreateStatement, name, prevRevision, lease, value)
+
+	return err
+}
+
+func (w *postgresqlKineWriter) update(ctx context.Context, _ string, row kineRow, lease int64, value []byte) error {
+	_, err := w.tx.ExecContext(ctx, postgresqlKineUpdateStatement, row.createRevision(), lease, value, row.ID)
+
+	return err
+}
+
+func (w *postgresqlKineWriter) delete(ctx context.Context, _ string, row kineRow) error {
+	_, err := w.tx.ExecContext(ctx, postgresqlKineDeleteStatement, row.createRevision(), row.ID)
+
+	return err
+}
+
 func NewPostgreSQLConnection(config ConnectionConfig) (Connection, error) {
 	opt := &pg.Options{
 		Addr:      config.Endpoints[0].String(),
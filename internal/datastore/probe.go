@@ -0,0 +1,95 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package datastore
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"time"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+)
+
+// ProbeResult is the outcome of a DataStore probe.
+type ProbeResult struct {
+	// Latency is the duration of the connectivity check.
+	Latency time.Duration
+	// Err is the reason the DataStore cannot be reached, nil if healthy.
+	Err error
+	// CertificatesExpiration is the earliest expiration of the configured CA and client certificates, nil if none.
+	CertificatesExpiration *time.Time
+}
+
+// Probe checks the connectivity of the given DataStore, measuring its latency,
+// along with the expiration of the certificates used to reach it.
+func Probe(ctx context.Context, c client.Client, ds stewardv1alpha1.DataStore) (*ProbeResult, error) {
+	expiration, err := certificatesExpiration(ctx, c, ds)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ProbeResult{CertificatesExpiration: expiration}
+
+	start := time.Now()
+	defer func() {
+		result.Latency = time.Since(start)
+	}()
+
+	connection, err := NewStorageConnection(ctx, c, ds)
+	if err != nil {
+		result.Err = err
+
+		return result, nil
+	}
+	defer connection.Close()
+
+	result.Err = connection.Check(ctx)
+
+	return result, nil
+}
+
+// certificatesExpiration returns the earliest expiration among the CA, and the client, certificates of the DataStore.
+func certificatesExpiration(ctx context.Context, c client.Client, ds stewardv1alpha1.DataStore) (*time.Time, error) {
+	if ds.Spec.TLSConfig == nil {
+		return nil, nil //nolint:nilnil
+	}
+
+	refs := map[string]stewardv1alpha1.ContentRef{
+		"certificate authority": ds.Spec.TLSConfig.CertificateAuthority.Certificate,
+	}
+
+	if crt := ds.Spec.TLSConfig.ClientCertificate; crt != nil {
+		refs["client certificate"] = crt.Certificate
+	}
+
+	var earliest *time.Time
+
+	for name, ref := range refs {
+		content, err := ref.GetContent(ctx, c)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot retrieve the %s", name)
+		}
+		// The certificate authority could be a bundle: all the certificates are considered.
+		for block, rest := pem.Decode(content); block != nil; block, rest = pem.Decode(rest) {
+			if block.Type != "CERTIFICATE" {
+				continue
+			}
+
+			crt, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, errors.Wrapf(err, "cannot parse the %s", name)
+			}
+
+			if earliest == nil || crt.NotAfter.Before(*earliest) {
+				earliest = &crt.NotAfter
+			}
+		}
+	}
+
+	return earliest, nil
+}
@@ -0,0 +1,95 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package datastore
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+)
+
+var _ = Describe("certificatesExpiration", func() {
+	var (
+		ctx context.Context
+		now time.Time
+		ds  stewardv1alpha1.DataStore
+	)
+
+	// certificate returns a PEM encoded self-signed certificate expiring at the given time.
+	certificate := func(notAfter time.Time) []byte {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).ToNot(HaveOccurred())
+
+		template := &x509.Certificate{SerialNumber: big.NewInt(1), Subject: pkix.Name{CommonName: "steward"}, NotBefore: now, NotAfter: notAfter}
+
+		der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+		Expect(err).ToNot(HaveOccurred())
+
+		return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	}
+
+	BeforeEach(func() {
+		ctx, now = context.Background(), time.Now().Truncate(time.Second)
+
+		ds = stewardv1alpha1.DataStore{Spec: stewardv1alpha1.DataStoreSpec{
+			TLSConfig: &stewardv1alpha1.TLSConfig{
+				CertificateAuthority: stewardv1alpha1.CertKeyPair{Certificate: stewardv1alpha1.ContentRef{Content: certificate(now.Add(365 * 24 * time.Hour))}},
+			},
+		}}
+	})
+
+	It("should ignore a DataStore without TLS", func() {
+		ds.Spec.TLSConfig = nil
+
+		Expect(certificatesExpiration(ctx, nil, ds)).To(BeNil())
+	})
+
+	It("should return the earliest expiration of the certificate authority bundle", func() {
+		ds.Spec.TLSConfig.CertificateAuthority.Certificate.Content = append(certificate(now.Add(24*time.Hour)), ds.Spec.TLSConfig.CertificateAuthority.Certificate.Content...)
+
+		Expect(certificatesExpiration(ctx, nil, ds)).To(HaveValue(BeTemporally("==", now.Add(24*time.Hour))))
+	})
+
+	It("should consider the client certificate referenced by a Secret", func() {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "client", Namespace: "steward-system"},
+			Data:       map[string][]byte{"tls.crt": certificate(now.Add(time.Hour))},
+		}
+
+		scheme := runtime.NewScheme()
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+
+		ds.Spec.TLSConfig.ClientCertificate = &stewardv1alpha1.ClientCertificate{
+			Certificate: stewardv1alpha1.ContentRef{SecretRef: &stewardv1alpha1.SecretReference{
+				SecretReference: corev1.SecretReference{Name: "client", Namespace: "steward-system"},
+				KeyPath:         "tls.crt",
+			}},
+		}
+
+		Expect(certificatesExpiration(ctx, fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build(), ds)).
+			To(HaveValue(BeTemporally("==", now.Add(time.Hour))))
+	})
+
+	It("should fail on an invalid certificate", func() {
+		ds.Spec.TLSConfig.CertificateAuthority.Certificate.Content = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("invalid")})
+
+		_, err := certificatesExpiration(ctx, nil, ds)
+		Expect(err).To(MatchError(ContainSubstring("cannot parse the certificate authority")))
+	})
+})
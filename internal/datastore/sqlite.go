@@ -0,0 +1,100 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package datastore
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+)
+
+// ErrSQLiteNotReachable is returned by the operations requiring to read, or write, the SQLite database:
+// it's embedded in the Tenant Control Plane Pod, and cannot be reached by Steward.
+var ErrSQLiteNotReachable = errors.New("the SQLite database is embedded in the Tenant Control Plane and cannot be reached")
+
+// SQLiteConnection represents the embedded SQLite DataStore: Kine runs in the Tenant Control Plane Pod,
+// storing the keys in a database file on a PersistentVolumeClaim, thus there's no schema, or user, to provision.
+type SQLiteConnection struct {
+	config ConnectionConfig
+}
+
+func NewSQLiteConnection(config ConnectionConfig) (*SQLiteConnection, error) {
+	return &SQLiteConnection{config: config}, nil
+}
+
+func (s *SQLiteConnection) CreateUser(context.Context, string, string) error {
+	return nil
+}
+
+func (s *SQLiteConnection) CreateDB(context.Context, string) error {
+	return nil
+}
+
+func (s *SQLiteConnection) GrantPrivileges(context.Context, string, string) error {
+	return nil
+}
+
+func (s *SQLiteConnection) UserExists(context.Context, string) (bool, error) {
+	return true, nil
+}
+
+func (s *SQLiteConnection) DBExists(context.Context, string) (bool, error) {
+	return true, nil
+}
+
+func (s *SQLiteConnection) GrantPrivilegesExists(context.Context, string, string) (bool, error) {
+	return true, nil
+}
+
+func (s *SQLiteConnection) DeleteUser(context.Context, string) error {
+	return nil
+}
+
+// DeleteDB is a no-op: the database file is removed along with the PersistentVolumeClaim,
+// which is owned by the Tenant Control Plane.
+func (s *SQLiteConnection) DeleteDB(context.Context, string) error {
+	return nil
+}
+
+func (s *SQLiteConnection) RevokePrivileges(context.Context, string, string) error {
+	return nil
+}
+
+func (s *SQLiteConnection) GetConnectionString() string {
+	return ""
+}
+
+func (s *SQLiteConnection) Close() error {
+	return nil
+}
+
+func (s *SQLiteConnection) Check(context.Context) error {
+	return nil
+}
+
+func (s *SQLiteConnection) Driver() string {
+	return string(stewardv1alpha1.KineSQLiteDriver)
+}
+
+func (s *SQLiteConnection) GetConfig() ConnectionConfig {
+	return s.config
+}
+
+func (s *SQLiteConnection) Migrate(context.Context, stewardv1alpha1.TenantControlPlane, Connection) error {
+	return errors.Wrap(ErrSQLiteNotReachable, "cannot migrate the Tenant Control Plane data")
+}
+
+func (s *SQLiteConnection) Export(context.Context, stewardv1alpha1.TenantControlPlane, *ArchiveWriter) error {
+	return errors.Wrap(ErrSQLiteNotReachable, "cannot export the Tenant Control Plane data")
+}
+
+func (s *SQLiteConnection) Import(context.Context, stewardv1alpha1.TenantControlPlane, *ArchiveReader) error {
+	return errors.Wrap(ErrSQLiteNotReachable, "cannot import the Tenant Control Plane data")
+}
+
+func (s *SQLiteConnection) Usage(context.Context, string) (*Usage, error) {
+	return nil, errors.Wrap(ErrSQLiteNotReachable, "cannot measure the Tenant Control Plane storage usage")
+}
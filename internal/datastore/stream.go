@@ -0,0 +1,96 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+)
+
+// CheckStreamDrivers ensures the keyspace exported from the source driver can be imported into the target one:
+// etcd and the SQL Kine drivers share the same keys, whereas NATS stores the raw bucket keys.
+// The embedded SQLite database cannot be reached, thus it's neither a source, nor a target.
+func CheckStreamDrivers(source, target stewardv1alpha1.Driver) error {
+	if source == stewardv1alpha1.KineSQLiteDriver || target == stewardv1alpha1.KineSQLiteDriver {
+		return fmt.Errorf("the keyspace of a %s DataStore cannot be moved to a %s one, since SQLite is embedded in the Tenant Control Plane", source, target)
+	}
+
+	if source != target && (source == stewardv1alpha1.KineNatsDriver || target == stewardv1alpha1.KineNatsDriver) {
+		return fmt.Errorf("the keyspace of a %s DataStore cannot be moved to a %s one", source, target)
+	}
+
+	return nil
+}
+
+// Stream copies the keyspace of the given Tenant Control Plane from the origin DataStore to the target one, regardless of their drivers:
+// the keys are listed at a consistent revision of the origin, and written into the target as a driver-neutral key/value stream.
+// It returns the amount of copied keys, and the revision of the origin they have been read at.
+func Stream(ctx context.Context, tcp stewardv1alpha1.TenantControlPlane, origin, target Connection) (int64, int64, error) {
+	if err := CheckStreamDrivers(stewardv1alpha1.Driver(origin.Driver()), stewardv1alpha1.Driver(target.Driver())); err != nil {
+		return 0, 0, err
+	}
+
+	if err := target.Check(ctx); err != nil {
+		return 0, 0, fmt.Errorf("unable to check target datastore: %w", err)
+	}
+
+	if ok, _ := target.DBExists(ctx, tcp.Status.Storage.Setup.Schema); !ok {
+		if err := target.CreateDB(ctx, tcp.Status.Storage.Setup.Schema); err != nil {
+			return 0, 0, err
+		}
+	}
+	// The stream is the archive format, piped from the export to the import:
+	// the keys are not buffered, regardless of the keyspace size.
+	reader, writer := io.Pipe()
+	exported := make(chan error, 1)
+
+	var revision int64
+
+	go func() {
+		err := func() error {
+			archive, err := NewArchiveWriter(writer, ArchiveHeader{
+				Driver:             origin.Driver(),
+				TenantControlPlane: tcp.GetName(),
+				Schema:             tcp.Status.Storage.Setup.Schema,
+			})
+			if err != nil {
+				return err
+			}
+
+			if err = origin.Export(ctx, tcp, archive); err != nil {
+				return errors.Wrap(err, "cannot export the origin keyspace")
+			}
+
+			revision = archive.Revision()
+
+			return archive.Close()
+		}()
+		// The export failure is propagated to the import, which stops reading the keys.
+		_ = writer.CloseWithError(err)
+
+		exported <- err
+	}()
+
+	archive, err := NewArchiveReader(reader)
+	if err == nil {
+		err = target.Import(ctx, tcp, archive)
+	}
+	// Unblocking the export, in case the import failed before reading all the keys.
+	_ = reader.CloseWithError(io.ErrClosedPipe)
+
+	if exportErr := <-exported; exportErr != nil {
+		return 0, 0, exportErr
+	}
+
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "cannot import the keyspace into the target")
+	}
+
+	return archive.Keys(), revision, nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Usage", func() {
+	var ctx context.Context
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	Describe("etcd", func() {
+		var connection *EtcdClient
+
+		BeforeEach(func() {
+			connection = embeddedEtcd()
+
+			for i := range 3 {
+				_, err := connection.Client.Put(ctx, fmt.Sprintf("/tenant/key-%d", i), "value")
+				Expect(err).ToNot(HaveOccurred())
+			}
+			// The deleted keys are not accounted.
+			_, err := connection.Client.Put(ctx, "/tenant/deleted", "value")
+			Expect(err).ToNot(HaveOccurred())
+			_, err = connection.Client.Delete(ctx, "/tenant/deleted")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should report the database size in use of a dedicated cluster", func() {
+			usage, err := connection.Usage(ctx, "tenant")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(usage.Keys).To(Equal(int64(3)))
+
+			status, err := connection.Client.Status(ctx, connection.Client.Endpoints()[0])
+			Expect(err).ToNot(HaveOccurred())
+			Expect(usage.Bytes).To(Equal(status.DbSizeInUse))
+		})
+
+		It("should read the keys and values of a shared cluster", func() {
+			_, err := connection.Client.Put(ctx, "/other/key", "value")
+			Expect(err).ToNot(HaveOccurred())
+
+			usage, err := connection.Usage(ctx, "tenant")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(usage).To(Equal(&Usage{Keys: 3, Bytes: 3 * int64(len("/tenant/key-0")+len("value"))}))
+		})
+	})
+
+	Describe("MySQL", func() {
+		var (
+			mock       sqlmock.Sqlmock
+			connection *MySQLConnection
+		)
+
+		BeforeEach(func() {
+			db, m, err := sqlmock.New()
+			Expect(err).ToNot(HaveOccurred())
+
+			mock, connection = m, &MySQLConnection{db: db}
+		})
+
+		AfterEach(func() {
+			Expect(mock.ExpectationsWereMet()).To(Succeed())
+		})
+
+		It("should report the latest values of the existing keys", func() {
+			mock.ExpectQuery(regexp.QuoteMeta(mysqlKineTableExistsStatement)).WithArgs("tenant").
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+			mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(mysqlKineUsageStatement, "tenant"))).
+				WillReturnRows(sqlmock.NewRows([]string{"count", "size"}).AddRow(3, 1024))
+
+			Expect(connection.Usage(ctx, "tenant")).To(Equal(&Usage{Keys: 3, Bytes: 1024}))
+		})
+
+		It("should report no usage until Kine creates its table", func() {
+			mock.ExpectQuery(regexp.QuoteMeta(mysqlKineTableExistsStatement)).WithArgs("tenant").
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+			Expect(connection.Usage(ctx, "tenant")).To(Equal(&Usage{}))
+		})
+
+		It("should fail when the usage cannot be retrieved", func() {
+			mock.ExpectQuery(regexp.QuoteMeta(mysqlKineTableExistsStatement)).WithArgs("tenant").
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+			mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(mysqlKineUsageStatement, "tenant"))).
+				WillReturnError(fmt.Errorf("connection refused"))
+
+			_, err := connection.Usage(ctx, "tenant")
+			Expect(err).To(MatchError(ContainSubstring("connection refused")))
+		})
+	})
+})
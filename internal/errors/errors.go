@@ -20,3 +20,9 @@ type MissingValidIPError struct{}
 func (m MissingValidIPError) Error() string {
 	return "the actual resource doesn't have yet a valid IP address"
 }
+
+type RestoreInProcessError struct{}
+
+func (n RestoreInProcessError) Error() string {
+	return "cannot continue reconciliation, the current TenantControlPlane is still in restore status"
+}
@@ -13,6 +13,8 @@ func ShouldReconcileErrorBeIgnored(err error) bool {
 		return true
 	case errors.As(err, &MigrationInProcessError{}):
 		return true
+	case errors.As(err, &RestoreInProcessError{}):
+		return true
 	default:
 		return false
 	}
@@ -0,0 +1,46 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package hibernation
+
+import (
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/common/model"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// DispatchedRequestsMetric is the API Priority and Fairness metric counting the requests dispatched by the API Server,
+// labelled with the FlowSchema matching them: it allows to distinguish the requests of users and workloads
+// from the ones issued by the control plane components, the nodes, and the kube-system Service Accounts.
+const DispatchedRequestsMetric = "apiserver_flowcontrol_dispatched_requests_total"
+
+// CountRequests returns the amount of requests dispatched by the API Server for the given FlowSchemas,
+// parsing its metrics in the Prometheus text format.
+func CountRequests(metrics io.Reader, flowSchemas sets.Set[string]) (float64, error) {
+	parser := expfmt.NewTextParser(model.UTF8Validation)
+
+	families, err := parser.TextToMetricFamilies(metrics)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot parse the API Server metrics")
+	}
+
+	family, ok := families[DispatchedRequestsMetric]
+	if !ok {
+		return 0, errors.Errorf("the API Server metrics are missing %s, is API Priority and Fairness enabled?", DispatchedRequestsMetric)
+	}
+
+	var count float64
+
+	for _, metric := range family.GetMetric() {
+		for _, label := range metric.GetLabel() {
+			if label.GetName() == "flow_schema" && flowSchemas.Has(label.GetValue()) {
+				count += metric.GetCounter().GetValue()
+			}
+		}
+	}
+
+	return count, nil
+}
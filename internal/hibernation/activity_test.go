@@ -0,0 +1,35 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package hibernation_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/butlerdotdev/steward/internal/hibernation"
+)
+
+var _ = Describe("Hibernation activity", func() {
+	const metrics = `# HELP apiserver_flowcontrol_dispatched_requests_total [BETA] Number of requests executed by API Priority and Fairness subsystem
+# TYPE apiserver_flowcontrol_dispatched_requests_total counter
+apiserver_flowcontrol_dispatched_requests_total{flow_schema="exempt",priority_level="exempt"} 1200
+apiserver_flowcontrol_dispatched_requests_total{flow_schema="global-default",priority_level="global-default"} 15
+apiserver_flowcontrol_dispatched_requests_total{flow_schema="service-accounts",priority_level="workload-low"} 27
+apiserver_flowcontrol_dispatched_requests_total{flow_schema="system-nodes",priority_level="system"} 3400
+`
+
+	It("should count the requests of the given FlowSchemas only", func() {
+		count, err := hibernation.CountRequests(strings.NewReader(metrics), sets.New("global-default", "service-accounts"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(count).To(BeNumerically("==", 42))
+	})
+
+	It("should fail when API Priority and Fairness metrics are missing", func() {
+		_, err := hibernation.CountRequests(strings.NewReader("# TYPE up gauge\nup 1\n"), sets.New("global-default"))
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,113 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package hibernation
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/robfig/cron/v3"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+)
+
+type Action string
+
+const (
+	ActionNone  Action = ""
+	ActionSleep Action = "Sleep"
+	ActionWake  Action = "Wake"
+)
+
+// maxLookBehind limits the evaluation of the missed schedules,
+// such as when Steward has been stopped for a long period.
+const maxLookBehind = 31 * 24 * time.Hour
+
+type Schedule struct {
+	sleep    cron.Schedule
+	wake     cron.Schedule
+	location *time.Location
+}
+
+// ParseSchedule validates the cron expressions and the time zone of the given hibernation schedule.
+func ParseSchedule(spec stewardv1alpha1.HibernationSchedule) (*Schedule, error) {
+	timeZone := spec.TimeZone
+	if timeZone == "" {
+		timeZone = "UTC"
+	}
+
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot load the time zone")
+	}
+
+	sleep, err := cron.ParseStandard(spec.Sleep)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot parse the sleep cron expression")
+	}
+
+	wake, err := cron.ParseStandard(spec.Wake)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot parse the wake cron expression")
+	}
+
+	return &Schedule{sleep: sleep, wake: wake, location: location}, nil
+}
+
+// Transition returns the latest action scheduled in the (from, to] interval along with its time,
+// or ActionNone if no sleep nor wake window boundary has been crossed.
+func (s *Schedule) Transition(from, to time.Time) (Action, time.Time) {
+	if to.Sub(from) > maxLookBehind {
+		from = to.Add(-maxLookBehind)
+	}
+
+	lastSleep, lastWake := s.last(s.sleep, from, to), s.last(s.wake, from, to)
+
+	switch {
+	case lastSleep.IsZero() && lastWake.IsZero():
+		return ActionNone, time.Time{}
+	case lastSleep.After(lastWake):
+		return ActionSleep, lastSleep
+	default:
+		return ActionWake, lastWake
+	}
+}
+
+// Next returns the time of the first sleep or wake window boundary after the given time.
+func (s *Schedule) Next(after time.Time) time.Time {
+	sleep, wake := s.sleep.Next(after.In(s.location)), s.wake.Next(after.In(s.location))
+	if sleep.Before(wake) {
+		return sleep
+	}
+
+	return wake
+}
+
+func (s *Schedule) last(schedule cron.Schedule, from, to time.Time) time.Time {
+	var last time.Time
+
+	for next := schedule.Next(from.In(s.location)); !next.IsZero() && !next.After(to); next = schedule.Next(next) {
+		last = next
+	}
+
+	return last
+}
+
+// Evaluate returns the latest action across all the given schedules in the (from, to] interval,
+// along with the time of the first window boundary after the interval.
+func Evaluate(schedules []*Schedule, from, to time.Time) (action Action, next time.Time) {
+	var at time.Time
+
+	for _, schedule := range schedules {
+		if scheduleAction, scheduleAt := schedule.Transition(from, to); scheduleAction != ActionNone && scheduleAt.After(at) {
+			action, at = scheduleAction, scheduleAt
+		}
+
+		if scheduleNext := schedule.Next(to); next.IsZero() || scheduleNext.Before(next) {
+			next = scheduleNext
+		}
+	}
+
+	return action, next
+}
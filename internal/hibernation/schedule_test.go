@@ -0,0 +1,73 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package hibernation_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/internal/hibernation"
+)
+
+var _ = Describe("Hibernation schedule", func() {
+	var nights *hibernation.Schedule
+
+	BeforeEach(func() {
+		var err error
+
+		nights, err = hibernation.ParseSchedule(stewardv1alpha1.HibernationSchedule{
+			Sleep:    "0 20 * * 1-5",
+			Wake:     "0 8 * * 1-5",
+			TimeZone: "Europe/Rome",
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should reject invalid cron expressions and time zones", func() {
+		_, err := hibernation.ParseSchedule(stewardv1alpha1.HibernationSchedule{Sleep: "every night", Wake: "0 8 * * *"})
+		Expect(err).To(HaveOccurred())
+
+		_, err = hibernation.ParseSchedule(stewardv1alpha1.HibernationSchedule{Sleep: "0 20 * * *", Wake: "0 8 * * *", TimeZone: "Mars/Olympus"})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should evaluate the expressions in the given time zone", func() {
+		// Monday 18:30 UTC is 20:30 in Rome, during summer time.
+		from, to := time.Date(2026, time.June, 1, 17, 0, 0, 0, time.UTC), time.Date(2026, time.June, 1, 18, 30, 0, 0, time.UTC)
+
+		action, at := nights.Transition(from, to)
+		Expect(action).To(Equal(hibernation.ActionSleep))
+		Expect(at.UTC()).To(Equal(time.Date(2026, time.June, 1, 18, 0, 0, 0, time.UTC)))
+	})
+
+	It("should not act when no window boundary has been crossed", func() {
+		from, to := time.Date(2026, time.June, 1, 19, 0, 0, 0, time.UTC), time.Date(2026, time.June, 1, 23, 0, 0, 0, time.UTC)
+
+		action, _ := nights.Transition(from, to)
+		Expect(action).To(Equal(hibernation.ActionNone))
+	})
+
+	It("should return the latest transition when several boundaries have been missed", func() {
+		// From Friday morning to Monday morning: the Friday sleep is followed by the Monday wake.
+		from, to := time.Date(2026, time.June, 5, 9, 0, 0, 0, time.UTC), time.Date(2026, time.June, 8, 9, 0, 0, 0, time.UTC)
+
+		action, at := nights.Transition(from, to)
+		Expect(action).To(Equal(hibernation.ActionWake))
+		Expect(at.UTC()).To(Equal(time.Date(2026, time.June, 8, 6, 0, 0, 0, time.UTC)))
+	})
+
+	It("should merge multiple schedules", func() {
+		weekends, err := hibernation.ParseSchedule(stewardv1alpha1.HibernationSchedule{Sleep: "0 12 * * 6", Wake: "0 0 * * 1"})
+		Expect(err).ToNot(HaveOccurred())
+
+		from, to := time.Date(2026, time.June, 6, 11, 0, 0, 0, time.UTC), time.Date(2026, time.June, 6, 13, 0, 0, 0, time.UTC)
+
+		action, next := hibernation.Evaluate([]*hibernation.Schedule{nights, weekends}, from, to)
+		Expect(action).To(Equal(hibernation.ActionSleep))
+		Expect(next.UTC()).To(Equal(time.Date(2026, time.June, 8, 0, 0, 0, 0, time.UTC)))
+	})
+})
@@ -0,0 +1,95 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+// Package mock provides a local KMS v2 service, meant for testing the Tenant Control Plane encryption at rest
+// without an external Key Management Service: the key encryption key is held in memory, thus not suitable for production.
+package mock
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"k8s.io/kms/pkg/service"
+)
+
+const keySize = 32
+
+type Service struct {
+	aead  cipher.AEAD
+	keyID string
+}
+
+var _ service.Service = (*Service)(nil)
+
+// NewService returns a KMS v2 service using AES-GCM with the provided key,
+// a random one is generated when empty.
+func NewService(key []byte) (*Service, error) {
+	if len(key) == 0 {
+		key = make([]byte, keySize)
+		if _, err := rand.Read(key); err != nil {
+			return nil, errors.Wrap(err, "cannot generate the key encryption key")
+		}
+	}
+
+	if len(key) != keySize {
+		return nil, fmt.Errorf("the key encryption key must be %d bytes long, got %d", keySize, len(key))
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create the AES cipher")
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create the GCM cipher")
+	}
+
+	checksum := sha256.Sum256(key)
+
+	return &Service{
+		aead:  aead,
+		keyID: hex.EncodeToString(checksum[:8]),
+	}, nil
+}
+
+func (s *Service) Decrypt(_ context.Context, _ string, req *service.DecryptRequest) ([]byte, error) {
+	if req.KeyID != s.keyID {
+		return nil, fmt.Errorf("unknown key ID %s", req.KeyID)
+	}
+
+	nonceSize := s.aead.NonceSize()
+	if len(req.Ciphertext) < nonceSize {
+		return nil, fmt.Errorf("ciphertext is too short")
+	}
+
+	nonce, ciphertext := req.Ciphertext[:nonceSize], req.Ciphertext[nonceSize:]
+
+	return s.aead.Open(nil, nonce, ciphertext, []byte(req.KeyID))
+}
+
+func (s *Service) Encrypt(_ context.Context, _ string, data []byte) (*service.EncryptResponse, error) {
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, errors.Wrap(err, "cannot generate the nonce")
+	}
+
+	return &service.EncryptResponse{
+		Ciphertext: s.aead.Seal(nonce, nonce, data, []byte(s.keyID)),
+		KeyID:      s.keyID,
+	}, nil
+}
+
+func (s *Service) Status(context.Context) (*service.StatusResponse, error) {
+	return &service.StatusResponse{
+		Version: "v2",
+		Healthz: "ok",
+		KeyID:   s.keyID,
+	}, nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package mock_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	kmsapi "k8s.io/kms/apis/v2"
+	"k8s.io/kms/pkg/service"
+
+	"github.com/butlerdotdev/steward/internal/kms/mock"
+)
+
+var _ = Describe("KMS mock plugin", func() {
+	var (
+		ctx    context.Context
+		server *service.GRPCService
+		client kmsapi.KeyManagementServiceClient
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		dir, err := os.MkdirTemp("", "kms")
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(os.RemoveAll, dir)
+
+		svc, err := mock.NewService(nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		socket := filepath.Join(dir, "socket.sock")
+
+		server = service.NewGRPCService(socket, 3*time.Second, svc)
+		go func() {
+			defer GinkgoRecover()
+
+			_ = server.ListenAndServe()
+		}()
+		DeferCleanup(server.Close)
+
+		Eventually(func() error {
+			_, statErr := os.Stat(socket)
+
+			return statErr
+		}).Should(Succeed())
+
+		conn, err := grpc.NewClient("unix://"+socket, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(conn.Close)
+
+		client = kmsapi.NewKeyManagementServiceClient(conn)
+	})
+
+	It("should report an healthy status", func() {
+		res, err := client.Status(ctx, &kmsapi.StatusRequest{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.GetVersion()).To(Equal("v2"))
+		Expect(res.GetHealthz()).To(Equal("ok"))
+		Expect(res.GetKeyId()).ToNot(BeEmpty())
+	})
+
+	It("should decrypt the encrypted data", func() {
+		encrypted, err := client.Encrypt(ctx, &kmsapi.EncryptRequest{Plaintext: []byte("steward"), Uid: "encrypt"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(encrypted.GetCiphertext()).ToNot(ContainSubstring("steward"))
+
+		decrypted, err := client.Decrypt(ctx, &kmsapi.DecryptRequest{Ciphertext: encrypted.GetCiphertext(), KeyId: encrypted.GetKeyId(), Uid: "decrypt"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(decrypted.GetPlaintext())).To(Equal("steward"))
+	})
+
+	It("should refuse to decrypt data encrypted with an unknown key", func() {
+		encrypted, err := client.Encrypt(ctx, &kmsapi.EncryptRequest{Plaintext: []byte("steward"), Uid: "encrypt"})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = client.Decrypt(ctx, &kmsapi.DecryptRequest{Ciphertext: encrypted.GetCiphertext(), KeyId: "unknown", Uid: "decrypt"})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -335,7 +335,7 @@ func (k *KubeProxy) decodeManifests(ctx context.Context, tcp *stewardv1alpha1.Te
 	if len(tcp.Spec.Addons.KubeProxy.ImageTag) > 0 {
 		config.Parameters.KubeProxyOptions.Tag = tcp.Spec.Addons.KubeProxy.ImageTag
 	} else {
-		config.Parameters.KubeProxyOptions.Tag = tcp.Spec.Kubernetes.Version
+		config.Parameters.KubeProxyOptions.Tag = tcp.GetKubernetesVersion()
 	}
 
 	manifests, err := kubeadm.AddKubeProxy(tcpClient, config)
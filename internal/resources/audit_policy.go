@@ -0,0 +1,152 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	builder "github.com/butlerdotdev/steward/internal/builders/controlplane"
+	"github.com/butlerdotdev/steward/internal/utilities"
+)
+
+// AuditPolicy renders the audit Policy consumed by the Tenant Control Plane API Server,
+// either provided inline or copied from the referenced ConfigMap.
+type AuditPolicy struct {
+	resource *corev1.ConfigMap
+	Client   client.Client
+
+	deleted bool
+}
+
+func (r *AuditPolicy) GetHistogram() prometheus.Histogram {
+	auditpolicyCollector = LazyLoadHistogramFromResource(auditpolicyCollector, r)
+
+	return auditpolicyCollector
+}
+
+func (r *AuditPolicy) Define(_ context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) error {
+	r.resource = &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      utilities.AddTenantPrefix(r.GetName(), tenantControlPlane),
+			Namespace: tenantControlPlane.GetNamespace(),
+		},
+	}
+
+	return nil
+}
+
+func (r *AuditPolicy) ShouldCleanup(tenantControlPlane *stewardv1alpha1.TenantControlPlane) bool {
+	return tenantControlPlane.Spec.Kubernetes.Audit == nil && len(tenantControlPlane.Status.Audit.ConfigMapName) > 0
+}
+
+func (r *AuditPolicy) CleanUp(ctx context.Context, _ *stewardv1alpha1.TenantControlPlane) (bool, error) {
+	logger := log.FromContext(ctx, "resource", r.GetName())
+
+	if err := r.Client.Delete(ctx, r.resource); err != nil && !k8serrors.IsNotFound(err) {
+		logger.Error(err, "cannot delete the requested resource")
+
+		return false, err
+	}
+
+	r.deleted = true
+
+	return true, nil
+}
+
+func (r *AuditPolicy) CreateOrUpdate(ctx context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) (controllerutil.OperationResult, error) {
+	if tenantControlPlane.Spec.Kubernetes.Audit == nil {
+		return controllerutil.OperationResultNone, nil
+	}
+
+	return utilities.CreateOrUpdateWithConflict(ctx, r.Client, r.resource, r.mutate(ctx, tenantControlPlane))
+}
+
+func (r *AuditPolicy) GetName() string {
+	return "audit-policy"
+}
+
+func (r *AuditPolicy) ShouldStatusBeUpdated(_ context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) bool {
+	if r.deleted {
+		return len(tenantControlPlane.Status.Audit.ConfigMapName) > 0
+	}
+
+	return tenantControlPlane.Status.Audit.ConfigMapName != r.resource.GetName() ||
+		tenantControlPlane.Status.Audit.Checksum != utilities.GetObjectChecksum(r.resource)
+}
+
+func (r *AuditPolicy) UpdateTenantControlPlaneStatus(_ context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) error {
+	tenantControlPlane.Status.Audit = stewardv1alpha1.AuditStatus{}
+
+	if r.deleted || tenantControlPlane.Spec.Kubernetes.Audit == nil {
+		return nil
+	}
+
+	tenantControlPlane.Status.Audit.ConfigMapName = r.resource.GetName()
+	tenantControlPlane.Status.Audit.Checksum = utilities.GetObjectChecksum(r.resource)
+	tenantControlPlane.Status.Audit.LastUpdate = metav1.Now()
+
+	return nil
+}
+
+// getPolicy returns the audit Policy content, validating it.
+func (r *AuditPolicy) getPolicy(ctx context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) (string, error) {
+	source := tenantControlPlane.Spec.Kubernetes.Audit.Policy
+
+	policy := source.Inline
+
+	if ref := source.ConfigMapRef; ref != nil {
+		configMap := &corev1.ConfigMap{}
+		if err := r.Client.Get(ctx, k8stypes.NamespacedName{Namespace: tenantControlPlane.GetNamespace(), Name: ref.Name}, configMap); err != nil {
+			return "", errors.Wrap(err, "cannot retrieve the audit Policy ConfigMap")
+		}
+
+		var ok bool
+		if policy, ok = configMap.Data[ref.Key]; !ok {
+			return "", fmt.Errorf("the audit Policy ConfigMap %s is missing the key %s", ref.Name, ref.Key)
+		}
+	}
+
+	decoded := &auditv1.Policy{}
+	if err := utilities.DecodeFromYAML(policy, decoded); err != nil {
+		return "", errors.Wrap(err, "cannot decode the audit Policy")
+	}
+
+	if decoded.Kind != "Policy" || decoded.APIVersion != auditv1.SchemeGroupVersion.String() {
+		return "", fmt.Errorf("the audit Policy must be a %s Policy, got %s %s", auditv1.SchemeGroupVersion.String(), decoded.APIVersion, decoded.Kind)
+	}
+
+	return policy, nil
+}
+
+func (r *AuditPolicy) mutate(ctx context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) controllerutil.MutateFn {
+	return func() error {
+		policy, err := r.getPolicy(ctx, tenantControlPlane)
+		if err != nil {
+			return err
+		}
+
+		r.resource.SetLabels(utilities.MergeMaps(r.resource.GetLabels(), utilities.StewardLabels(tenantControlPlane.GetName(), r.GetName())))
+		r.resource.Data = map[string]string{
+			builder.AuditPolicyFileName: policy,
+		}
+
+		utilities.SetObjectChecksum(r.resource, r.resource.Data)
+
+		return ctrl.SetControllerReference(tenantControlPlane, r.resource, r.Client.Scheme())
+	}
+}
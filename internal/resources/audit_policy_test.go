@@ -0,0 +1,121 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package resources_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	builder "github.com/butlerdotdev/steward/internal/builders/controlplane"
+	"github.com/butlerdotdev/steward/internal/resources"
+)
+
+var _ = Describe("AuditPolicy", func() {
+	const policy = `apiVersion: audit.k8s.io/v1
+kind: Policy
+rules:
+- level: Metadata
+`
+
+	var (
+		ctx        context.Context
+		fakeClient client.Client
+		tcp        *stewardv1alpha1.TenantControlPlane
+	)
+
+	handle := func() error {
+		resource := &resources.AuditPolicy{Client: fakeClient}
+
+		if _, err := resources.Handle(ctx, resource, tcp); err != nil {
+			return err
+		}
+
+		return resource.UpdateTenantControlPlaneStatus(ctx, tcp)
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		fakeClient = fake.NewClientBuilder().WithScheme(runtimeScheme).Build()
+
+		tcp = &stewardv1alpha1.TenantControlPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-tcp",
+				Namespace: "default",
+				UID:       "test-uid",
+			},
+			Spec: stewardv1alpha1.TenantControlPlaneSpec{
+				Kubernetes: stewardv1alpha1.KubernetesSpec{
+					Audit: &stewardv1alpha1.AuditSpec{
+						Policy: stewardv1alpha1.AuditPolicySource{Inline: policy},
+						Backend: stewardv1alpha1.AuditBackendSpec{
+							Log: &stewardv1alpha1.AuditLogBackendSpec{},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	It("should render the inline audit Policy", func() {
+		Expect(handle()).To(Succeed())
+
+		Expect(tcp.Status.Audit.ConfigMapName).To(Equal("test-tcp-audit-policy"))
+		Expect(tcp.Status.Audit.Checksum).ToNot(BeEmpty())
+
+		configMap := &corev1.ConfigMap{}
+		Expect(fakeClient.Get(ctx, k8stypes.NamespacedName{Namespace: tcp.Namespace, Name: tcp.Status.Audit.ConfigMapName}, configMap)).To(Succeed())
+		Expect(configMap.Data).To(HaveKeyWithValue(builder.AuditPolicyFileName, policy))
+	})
+
+	It("should copy the audit Policy from the referenced ConfigMap, and track its changes", func() {
+		referenced := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-policy", Namespace: tcp.Namespace},
+			Data:       map[string]string{"policy.yaml": policy},
+		}
+		Expect(fakeClient.Create(ctx, referenced)).To(Succeed())
+
+		tcp.Spec.Kubernetes.Audit.Policy = stewardv1alpha1.AuditPolicySource{
+			ConfigMapRef: &corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "my-policy"},
+				Key:                  "policy.yaml",
+			},
+		}
+
+		Expect(handle()).To(Succeed())
+		checksum := tcp.Status.Audit.Checksum
+
+		referenced.Data["policy.yaml"] = policy + "- level: RequestResponse\n  resources:\n  - group: \"\"\n    resources: [\"secrets\"]\n"
+		Expect(fakeClient.Update(ctx, referenced)).To(Succeed())
+
+		Expect(handle()).To(Succeed())
+		Expect(tcp.Status.Audit.Checksum).ToNot(Equal(checksum))
+	})
+
+	It("should refuse a non audit Policy", func() {
+		tcp.Spec.Kubernetes.Audit.Policy.Inline = "apiVersion: v1\nkind: ConfigMap\n"
+
+		Expect(handle()).ToNot(Succeed())
+	})
+
+	It("should delete the audit Policy once disabled", func() {
+		Expect(handle()).To(Succeed())
+
+		tcp.Spec.Kubernetes.Audit = nil
+		Expect(handle()).To(Succeed())
+
+		Expect(tcp.Status.Audit.ConfigMapName).To(BeEmpty())
+		err := fakeClient.Get(ctx, k8stypes.NamespacedName{Namespace: tcp.Namespace, Name: "test-tcp-audit-policy"}, &corev1.ConfigMap{})
+		Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+	})
+})
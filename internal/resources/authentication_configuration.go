@@ -0,0 +1,174 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/blang/semver"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	apiserverv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	builder "github.com/butlerdotdev/steward/internal/builders/controlplane"
+	"github.com/butlerdotdev/steward/internal/utilities"
+)
+
+// authenticationConfigurationMinVersionGA is the Kubernetes version
+// where the AuthenticationConfiguration has been promoted to v1.
+var authenticationConfigurationMinVersionGA = semver.MustParse("1.34.0")
+
+// AuthenticationConfiguration renders the structured AuthenticationConfiguration consumed by the Tenant Control Plane API Server,
+// resolving the certificate authorities referenced by the JWT issuers.
+type AuthenticationConfiguration struct {
+	resource *corev1.ConfigMap
+	Client   client.Client
+
+	deleted bool
+}
+
+func (r *AuthenticationConfiguration) GetHistogram() prometheus.Histogram {
+	authenticationconfigurationCollector = LazyLoadHistogramFromResource(authenticationconfigurationCollector, r)
+
+	return authenticationconfigurationCollector
+}
+
+func (r *AuthenticationConfiguration) Define(_ context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) error {
+	r.resource = &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      utilities.AddTenantPrefix(r.GetName(), tenantControlPlane),
+			Namespace: tenantControlPlane.GetNamespace(),
+		},
+	}
+
+	return nil
+}
+
+func (r *AuthenticationConfiguration) ShouldCleanup(tenantControlPlane *stewardv1alpha1.TenantControlPlane) bool {
+	return tenantControlPlane.Spec.Kubernetes.Authentication == nil && len(tenantControlPlane.Status.Authentication.ConfigMapName) > 0
+}
+
+func (r *AuthenticationConfiguration) CleanUp(ctx context.Context, _ *stewardv1alpha1.TenantControlPlane) (bool, error) {
+	logger := log.FromContext(ctx, "resource", r.GetName())
+
+	if err := r.Client.Delete(ctx, r.resource); err != nil && !k8serrors.IsNotFound(err) {
+		logger.Error(err, "cannot delete the requested resource")
+
+		return false, err
+	}
+
+	r.deleted = true
+
+	return true, nil
+}
+
+func (r *AuthenticationConfiguration) CreateOrUpdate(ctx context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) (controllerutil.OperationResult, error) {
+	if tenantControlPlane.Spec.Kubernetes.Authentication == nil {
+		return controllerutil.OperationResultNone, nil
+	}
+
+	return utilities.CreateOrUpdateWithConflict(ctx, r.Client, r.resource, r.mutate(ctx, tenantControlPlane))
+}
+
+func (r *AuthenticationConfiguration) GetName() string {
+	return "authentication-configuration"
+}
+
+func (r *AuthenticationConfiguration) ShouldStatusBeUpdated(_ context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) bool {
+	if r.deleted {
+		return len(tenantControlPlane.Status.Authentication.ConfigMapName) > 0
+	}
+
+	return tenantControlPlane.Status.Authentication.ConfigMapName != r.resource.GetName() ||
+		tenantControlPlane.Status.Authentication.Checksum != utilities.GetObjectChecksum(r.resource)
+}
+
+func (r *AuthenticationConfiguration) UpdateTenantControlPlaneStatus(_ context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) error {
+	tenantControlPlane.Status.Authentication = stewardv1alpha1.AuthenticationStatus{}
+
+	if r.deleted || tenantControlPlane.Spec.Kubernetes.Authentication == nil {
+		return nil
+	}
+
+	tenantControlPlane.Status.Authentication.ConfigMapName = r.resource.GetName()
+	tenantControlPlane.Status.Authentication.Checksum = utilities.GetObjectChecksum(r.resource)
+	tenantControlPlane.Status.Authentication.LastUpdate = metav1.Now()
+
+	return nil
+}
+
+// getAPIVersion returns the AuthenticationConfiguration version supported by the Tenant Control Plane:
+// the beta one is still served by the GA releases, although it's going to be deprecated.
+func (r *AuthenticationConfiguration) getAPIVersion(tenantControlPlane *stewardv1alpha1.TenantControlPlane) (string, error) {
+	version, err := semver.ParseTolerant(tenantControlPlane.GetKubernetesVersion())
+	if err != nil {
+		return "", errors.Wrap(err, "cannot parse the Tenant Control Plane version")
+	}
+
+	if version.GTE(authenticationConfigurationMinVersionGA) {
+		return apiserverv1.SchemeGroupVersion.String(), nil
+	}
+
+	return "apiserver.config.k8s.io/v1beta1", nil
+}
+
+func (r *AuthenticationConfiguration) mutate(ctx context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) controllerutil.MutateFn {
+	return func() error {
+		apiVersion, err := r.getAPIVersion(tenantControlPlane)
+		if err != nil {
+			return err
+		}
+
+		configuration := &apiserverv1.AuthenticationConfiguration{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "AuthenticationConfiguration",
+				APIVersion: apiVersion,
+			},
+		}
+
+		for _, authenticator := range tenantControlPlane.Spec.Kubernetes.Authentication.JWT {
+			jwt := *authenticator.JWTAuthenticator.DeepCopy()
+
+			if ref := authenticator.CertificateAuthorityRef; ref != nil {
+				configMap := &corev1.ConfigMap{}
+				if err = r.Client.Get(ctx, k8stypes.NamespacedName{Namespace: tenantControlPlane.GetNamespace(), Name: ref.Name}, configMap); err != nil {
+					return errors.Wrapf(err, "cannot retrieve the certificate authority ConfigMap of the issuer %s", jwt.Issuer.URL)
+				}
+
+				ca, ok := configMap.Data[ref.Key]
+				if !ok {
+					return fmt.Errorf("the certificate authority ConfigMap %s is missing the key %s", ref.Name, ref.Key)
+				}
+
+				jwt.Issuer.CertificateAuthority = ca
+			}
+
+			configuration.JWT = append(configuration.JWT, jwt)
+		}
+
+		data, err := utilities.EncodeToYaml(configuration)
+		if err != nil {
+			return errors.Wrap(err, "cannot encode the AuthenticationConfiguration")
+		}
+
+		r.resource.SetLabels(utilities.MergeMaps(r.resource.GetLabels(), utilities.StewardLabels(tenantControlPlane.GetName(), r.GetName())))
+		r.resource.Data = map[string]string{
+			builder.AuthenticationConfigurationFileName: string(data),
+		}
+
+		utilities.SetObjectChecksum(r.resource, r.resource.Data)
+
+		return ctrl.SetControllerReference(tenantControlPlane, r.resource, r.Client.Scheme())
+	}
+}
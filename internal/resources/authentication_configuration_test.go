@@ -0,0 +1,128 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package resources_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	apiserverv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	builder "github.com/butlerdotdev/steward/internal/builders/controlplane"
+	"github.com/butlerdotdev/steward/internal/resources"
+	"github.com/butlerdotdev/steward/internal/utilities"
+)
+
+var _ = Describe("AuthenticationConfiguration", func() {
+	var (
+		ctx        context.Context
+		fakeClient client.Client
+		tcp        *stewardv1alpha1.TenantControlPlane
+	)
+
+	handle := func() error {
+		resource := &resources.AuthenticationConfiguration{Client: fakeClient}
+
+		if _, err := resources.Handle(ctx, resource, tcp); err != nil {
+			return err
+		}
+
+		return resource.UpdateTenantControlPlaneStatus(ctx, tcp)
+	}
+
+	getConfiguration := func() *apiserverv1.AuthenticationConfiguration {
+		configMap := &corev1.ConfigMap{}
+		Expect(fakeClient.Get(ctx, k8stypes.NamespacedName{Namespace: tcp.Namespace, Name: tcp.Status.Authentication.ConfigMapName}, configMap)).To(Succeed())
+
+		configuration := &apiserverv1.AuthenticationConfiguration{}
+		Expect(utilities.DecodeFromYAML(configMap.Data[builder.AuthenticationConfigurationFileName], configuration)).To(Succeed())
+
+		return configuration
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		fakeClient = fake.NewClientBuilder().WithScheme(runtimeScheme).Build()
+
+		tcp = &stewardv1alpha1.TenantControlPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-tcp",
+				Namespace: "default",
+				UID:       "test-uid",
+			},
+			Spec: stewardv1alpha1.TenantControlPlaneSpec{
+				Kubernetes: stewardv1alpha1.KubernetesSpec{
+					Version: "v1.33.0",
+					Authentication: &stewardv1alpha1.AuthenticationSpec{
+						JWT: []stewardv1alpha1.JWTAuthenticatorSpec{
+							{
+								JWTAuthenticator: apiserverv1.JWTAuthenticator{
+									Issuer: apiserverv1.Issuer{
+										URL:       "https://idp.example.com",
+										Audiences: []string{"steward"},
+									},
+									ClaimMappings: apiserverv1.ClaimMappings{
+										Username: apiserverv1.PrefixedClaimOrExpression{Claim: "sub", Prefix: ptr.To("idp:")},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	It("should render the beta AuthenticationConfiguration for Kubernetes versions prior to v1.34", func() {
+		Expect(handle()).To(Succeed())
+
+		Expect(tcp.Status.Authentication.ConfigMapName).To(Equal("test-tcp-authentication-configuration"))
+		Expect(tcp.Status.Authentication.Checksum).ToNot(BeEmpty())
+
+		configuration := getConfiguration()
+		Expect(configuration.APIVersion).To(Equal("apiserver.config.k8s.io/v1beta1"))
+		Expect(configuration.JWT).To(HaveLen(1))
+		Expect(configuration.JWT[0].Issuer.URL).To(Equal("https://idp.example.com"))
+	})
+
+	It("should render the GA AuthenticationConfiguration starting from Kubernetes v1.34", func() {
+		tcp.Spec.Kubernetes.Version = "v1.34.1"
+
+		Expect(handle()).To(Succeed())
+		Expect(getConfiguration().APIVersion).To(Equal("apiserver.config.k8s.io/v1"))
+	})
+
+	It("should resolve the referenced certificate authority", func() {
+		Expect(fakeClient.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "idp-ca", Namespace: tcp.Namespace},
+			Data:       map[string]string{"ca.crt": "certificate"},
+		})).To(Succeed())
+
+		tcp.Spec.Kubernetes.Authentication.JWT[0].CertificateAuthorityRef = &corev1.ConfigMapKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "idp-ca"},
+			Key:                  "ca.crt",
+		}
+
+		Expect(handle()).To(Succeed())
+		Expect(getConfiguration().JWT[0].Issuer.CertificateAuthority).To(Equal("certificate"))
+	})
+
+	It("should fail when the referenced certificate authority is missing", func() {
+		tcp.Spec.Kubernetes.Authentication.JWT[0].CertificateAuthorityRef = &corev1.ConfigMapKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "idp-ca"},
+			Key:                  "ca.crt",
+		}
+
+		Expect(handle()).ToNot(Succeed())
+	})
+})
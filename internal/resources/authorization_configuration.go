@@ -0,0 +1,197 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/blang/semver"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	apiserverv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	builder "github.com/butlerdotdev/steward/internal/builders/controlplane"
+	"github.com/butlerdotdev/steward/internal/utilities"
+)
+
+// authorizationConfigurationMinVersionGA is the Kubernetes version
+// where the AuthorizationConfiguration has been promoted to v1.
+var authorizationConfigurationMinVersionGA = semver.MustParse("1.32.0")
+
+// AuthorizationConfiguration renders the structured AuthorizationConfiguration consumed by the Tenant Control Plane API Server.
+// The checksum takes into account the kubeconfig of the webhook authorizers,
+// since the API Server doesn't reload them upon changes.
+type AuthorizationConfiguration struct {
+	resource *corev1.ConfigMap
+	Client   client.Client
+
+	deleted bool
+}
+
+func (r *AuthorizationConfiguration) GetHistogram() prometheus.Histogram {
+	authorizationconfigurationCollector = LazyLoadHistogramFromResource(authorizationconfigurationCollector, r)
+
+	return authorizationconfigurationCollector
+}
+
+func (r *AuthorizationConfiguration) Define(_ context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) error {
+	r.resource = &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      utilities.AddTenantPrefix(r.GetName(), tenantControlPlane),
+			Namespace: tenantControlPlane.GetNamespace(),
+		},
+	}
+
+	return nil
+}
+
+func (r *AuthorizationConfiguration) ShouldCleanup(tenantControlPlane *stewardv1alpha1.TenantControlPlane) bool {
+	return tenantControlPlane.Spec.Kubernetes.Authorization == nil && len(tenantControlPlane.Status.Authorization.ConfigMapName) > 0
+}
+
+func (r *AuthorizationConfiguration) CleanUp(ctx context.Context, _ *stewardv1alpha1.TenantControlPlane) (bool, error) {
+	logger := log.FromContext(ctx, "resource", r.GetName())
+
+	if err := r.Client.Delete(ctx, r.resource); err != nil && !k8serrors.IsNotFound(err) {
+		logger.Error(err, "cannot delete the requested resource")
+
+		return false, err
+	}
+
+	r.deleted = true
+
+	return true, nil
+}
+
+func (r *AuthorizationConfiguration) CreateOrUpdate(ctx context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) (controllerutil.OperationResult, error) {
+	if tenantControlPlane.Spec.Kubernetes.Authorization == nil {
+		return controllerutil.OperationResultNone, nil
+	}
+
+	return utilities.CreateOrUpdateWithConflict(ctx, r.Client, r.resource, r.mutate(ctx, tenantControlPlane))
+}
+
+func (r *AuthorizationConfiguration) GetName() string {
+	return "authorization-configuration"
+}
+
+func (r *AuthorizationConfiguration) ShouldStatusBeUpdated(_ context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) bool {
+	if r.deleted {
+		return len(tenantControlPlane.Status.Authorization.ConfigMapName) > 0
+	}
+
+	return tenantControlPlane.Status.Authorization.ConfigMapName != r.resource.GetName() ||
+		tenantControlPlane.Status.Authorization.Checksum != utilities.GetObjectChecksum(r.resource)
+}
+
+func (r *AuthorizationConfiguration) UpdateTenantControlPlaneStatus(_ context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) error {
+	tenantControlPlane.Status.Authorization = stewardv1alpha1.AuthorizationStatus{}
+
+	if r.deleted || tenantControlPlane.Spec.Kubernetes.Authorization == nil {
+		return nil
+	}
+
+	tenantControlPlane.Status.Authorization.ConfigMapName = r.resource.GetName()
+	tenantControlPlane.Status.Authorization.Checksum = utilities.GetObjectChecksum(r.resource)
+	tenantControlPlane.Status.Authorization.LastUpdate = metav1.Now()
+
+	return nil
+}
+
+// getAPIVersion returns the AuthorizationConfiguration version supported by the Tenant Control Plane.
+func (r *AuthorizationConfiguration) getAPIVersion(tenantControlPlane *stewardv1alpha1.TenantControlPlane) (string, error) {
+	version, err := semver.ParseTolerant(tenantControlPlane.GetKubernetesVersion())
+	if err != nil {
+		return "", errors.Wrap(err, "cannot parse the Tenant Control Plane version")
+	}
+
+	if version.GTE(authorizationConfigurationMinVersionGA) {
+		return apiserverv1.SchemeGroupVersion.String(), nil
+	}
+
+	return "apiserver.config.k8s.io/v1beta1", nil
+}
+
+func (r *AuthorizationConfiguration) mutate(ctx context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) controllerutil.MutateFn {
+	return func() error {
+		apiVersion, err := r.getAPIVersion(tenantControlPlane)
+		if err != nil {
+			return err
+		}
+
+		configuration := &apiserverv1.AuthorizationConfiguration{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "AuthorizationConfiguration",
+				APIVersion: apiVersion,
+			},
+		}
+		// Data used to compute the checksum, along with the kubeconfig files of the webhooks.
+		checksumData := map[string]string{}
+
+		for _, authorizer := range tenantControlPlane.Spec.Kubernetes.Authorization.Authorizers {
+			item := apiserverv1.AuthorizerConfiguration{
+				Type: string(authorizer.Type),
+				Name: authorizer.Name,
+			}
+
+			if webhook := authorizer.Webhook; webhook != nil {
+				secret := &corev1.Secret{}
+				if err = r.Client.Get(ctx, k8stypes.NamespacedName{Namespace: tenantControlPlane.GetNamespace(), Name: webhook.KubeconfigSecretRef.Name}, secret); err != nil {
+					return errors.Wrapf(err, "cannot retrieve the kubeconfig Secret of the %s authorizer", authorizer.Name)
+				}
+
+				kubeconfig, ok := secret.Data[webhook.KubeconfigSecretRef.Key]
+				if !ok {
+					return fmt.Errorf("the kubeconfig Secret %s is missing the key %s", webhook.KubeconfigSecretRef.Name, webhook.KubeconfigSecretRef.Key)
+				}
+
+				checksumData[builder.AuthorizationWebhookKubeconfigPath(authorizer.Name)] = string(kubeconfig)
+
+				item.Webhook = &apiserverv1.WebhookConfiguration{
+					AuthorizedTTL:                            webhook.AuthorizedTTL,
+					CacheAuthorizedRequests:                  webhook.CacheAuthorizedRequests,
+					UnauthorizedTTL:                          webhook.UnauthorizedTTL,
+					CacheUnauthorizedRequests:                webhook.CacheUnauthorizedRequests,
+					Timeout:                                  webhook.Timeout,
+					SubjectAccessReviewVersion:               webhook.SubjectAccessReviewVersion,
+					MatchConditionSubjectAccessReviewVersion: webhook.MatchConditionSubjectAccessReviewVersion,
+					FailurePolicy:                            webhook.FailurePolicy,
+					ConnectionInfo: apiserverv1.WebhookConnectionInfo{
+						Type:           apiserverv1.AuthorizationWebhookConnectionInfoTypeKubeConfigFile,
+						KubeConfigFile: ptr.To(builder.AuthorizationWebhookKubeconfigPath(authorizer.Name)),
+					},
+					MatchConditions: webhook.MatchConditions,
+				}
+			}
+
+			configuration.Authorizers = append(configuration.Authorizers, item)
+		}
+
+		data, err := utilities.EncodeToYaml(configuration)
+		if err != nil {
+			return errors.Wrap(err, "cannot encode the AuthorizationConfiguration")
+		}
+
+		r.resource.SetLabels(utilities.MergeMaps(r.resource.GetLabels(), utilities.StewardLabels(tenantControlPlane.GetName(), r.GetName())))
+		r.resource.Data = map[string]string{
+			builder.AuthorizationConfigurationFileName: string(data),
+		}
+
+		checksumData[builder.AuthorizationConfigurationFileName] = string(data)
+		utilities.SetObjectChecksum(r.resource, checksumData)
+
+		return ctrl.SetControllerReference(tenantControlPlane, r.resource, r.Client.Scheme())
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package resources_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	apiserverv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	builder "github.com/butlerdotdev/steward/internal/builders/controlplane"
+	"github.com/butlerdotdev/steward/internal/resources"
+	"github.com/butlerdotdev/steward/internal/utilities"
+)
+
+var _ = Describe("AuthorizationConfiguration", func() {
+	var (
+		ctx        context.Context
+		fakeClient client.Client
+		tcp        *stewardv1alpha1.TenantControlPlane
+		secret     *corev1.Secret
+	)
+
+	handle := func() error {
+		resource := &resources.AuthorizationConfiguration{Client: fakeClient}
+
+		if _, err := resources.Handle(ctx, resource, tcp); err != nil {
+			return err
+		}
+
+		return resource.UpdateTenantControlPlaneStatus(ctx, tcp)
+	}
+
+	getConfiguration := func() *apiserverv1.AuthorizationConfiguration {
+		configMap := &corev1.ConfigMap{}
+		Expect(fakeClient.Get(ctx, k8stypes.NamespacedName{Namespace: tcp.Namespace, Name: tcp.Status.Authorization.ConfigMapName}, configMap)).To(Succeed())
+
+		configuration := &apiserverv1.AuthorizationConfiguration{}
+		Expect(utilities.DecodeFromYAML(configMap.Data[builder.AuthorizationConfigurationFileName], configuration)).To(Succeed())
+
+		return configuration
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "opa", Namespace: "default"},
+			Data:       map[string][]byte{"kubeconfig": []byte("kubeconfig")},
+		}
+
+		fakeClient = fake.NewClientBuilder().WithScheme(runtimeScheme).WithObjects(secret).Build()
+
+		tcp = &stewardv1alpha1.TenantControlPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-tcp",
+				Namespace: "default",
+				UID:       "test-uid",
+			},
+			Spec: stewardv1alpha1.TenantControlPlaneSpec{
+				Kubernetes: stewardv1alpha1.KubernetesSpec{
+					Version: "v1.33.0",
+					Authorization: &stewardv1alpha1.AuthorizationSpec{
+						Authorizers: []stewardv1alpha1.AuthorizerSpec{
+							{Type: stewardv1alpha1.AuthorizerTypeNode, Name: "node"},
+							{
+								Type: stewardv1alpha1.AuthorizerTypeWebhook,
+								Name: "opa",
+								Webhook: &stewardv1alpha1.WebhookAuthorizerSpec{
+									KubeconfigSecretRef: corev1.SecretKeySelector{
+										LocalObjectReference: corev1.LocalObjectReference{Name: "opa"},
+										Key:                  "kubeconfig",
+									},
+									Timeout:       metav1.Duration{Duration: 3 * time.Second},
+									FailurePolicy: "Deny",
+								},
+							},
+							{Type: stewardv1alpha1.AuthorizerTypeRBAC, Name: "rbac"},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	It("should render the ordered chain of authorizers", func() {
+		Expect(handle()).To(Succeed())
+
+		Expect(tcp.Status.Authorization.ConfigMapName).To(Equal("test-tcp-authorization-configuration"))
+		Expect(tcp.Status.Authorization.Checksum).ToNot(BeEmpty())
+
+		configuration := getConfiguration()
+		Expect(configuration.APIVersion).To(Equal("apiserver.config.k8s.io/v1"))
+		Expect(configuration.Authorizers).To(HaveLen(3))
+		Expect(configuration.Authorizers[0].Type).To(Equal("Node"))
+		Expect(configuration.Authorizers[1].Webhook).ToNot(BeNil())
+		Expect(configuration.Authorizers[1].Webhook.FailurePolicy).To(Equal("Deny"))
+		Expect(*configuration.Authorizers[1].Webhook.ConnectionInfo.KubeConfigFile).To(Equal(builder.AuthorizationWebhookKubeconfigPath("opa")))
+		Expect(configuration.Authorizers[2].Type).To(Equal("RBAC"))
+	})
+
+	It("should change the checksum upon webhook kubeconfig changes", func() {
+		Expect(handle()).To(Succeed())
+		checksum := tcp.Status.Authorization.Checksum
+
+		secret.Data["kubeconfig"] = []byte("updated")
+		Expect(fakeClient.Update(ctx, secret)).To(Succeed())
+
+		Expect(handle()).To(Succeed())
+		Expect(tcp.Status.Authorization.Checksum).ToNot(Equal(checksum))
+	})
+
+	It("should fail when the webhook kubeconfig Secret is missing", func() {
+		Expect(fakeClient.Delete(ctx, secret)).To(Succeed())
+
+		Expect(handle()).ToNot(Succeed())
+	})
+})
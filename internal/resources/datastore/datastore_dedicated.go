@@ -0,0 +1,91 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package datastore
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	kubeerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/internal/builders/etcd"
+	"github.com/butlerdotdev/steward/internal/resources"
+)
+
+// DedicatedEtcd reports the failures of the etcd cluster dedicated to the Tenant Control Plane, operated by its own controller,
+// and removes it along with its certificates and volumes: the volumes are removed explicitly, since they could outlive the StatefulSet.
+type DedicatedEtcd struct {
+	Client client.Client
+
+	cluster etcd.Cluster
+}
+
+func (d *DedicatedEtcd) GetHistogram() prometheus.Histogram {
+	dedicatedCollector = resources.LazyLoadHistogramFromResource(dedicatedCollector, d)
+
+	return dedicatedCollector
+}
+
+func (d *DedicatedEtcd) GetName() string {
+	return "datastore-dedicated"
+}
+
+func (d *DedicatedEtcd) Define(_ context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) error {
+	d.cluster = etcd.DedicatedCluster(*tenantControlPlane)
+
+	return nil
+}
+
+func (d *DedicatedEtcd) ShouldCleanup(*stewardv1alpha1.TenantControlPlane) bool {
+	return false
+}
+
+func (d *DedicatedEtcd) CleanUp(context.Context, *stewardv1alpha1.TenantControlPlane) (bool, error) {
+	return false, nil
+}
+
+func (d *DedicatedEtcd) CreateOrUpdate(_ context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) (controllerutil.OperationResult, error) {
+	if status := tenantControlPlane.Status.Storage.Dedicated; status != nil && status.Failure != "" {
+		return controllerutil.OperationResultNone, errors.Errorf("the dedicated etcd cluster failed: %s", status.Failure)
+	}
+
+	return controllerutil.OperationResultNone, nil
+}
+
+func (d *DedicatedEtcd) ShouldStatusBeUpdated(context.Context, *stewardv1alpha1.TenantControlPlane) bool {
+	return false
+}
+
+func (d *DedicatedEtcd) UpdateTenantControlPlaneStatus(context.Context, *stewardv1alpha1.TenantControlPlane) error {
+	return nil
+}
+
+func (d *DedicatedEtcd) Delete(ctx context.Context, _ *stewardv1alpha1.TenantControlPlane) error {
+	objects := []client.Object{
+		&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: d.cluster.Name, Namespace: d.cluster.Namespace}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: d.cluster.Name, Namespace: d.cluster.Namespace}},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: d.cluster.CASecretName(), Namespace: d.cluster.Namespace}},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: d.cluster.CertificatesSecretName(), Namespace: d.cluster.Namespace}},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: d.cluster.ClientSecretName(), Namespace: d.cluster.Namespace}},
+	}
+
+	for _, object := range objects {
+		if err := d.Client.Delete(ctx, object); err != nil && !kubeerrors.IsNotFound(err) {
+			return errors.Wrapf(err, "cannot delete the dedicated etcd %s", object.GetName())
+		}
+	}
+
+	if err := d.Client.DeleteAllOf(ctx, &corev1.PersistentVolumeClaim{}, client.InNamespace(d.cluster.Namespace), client.MatchingLabels(d.cluster.Labels())); err != nil {
+		return errors.Wrap(err, "cannot delete the dedicated etcd volumes")
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package datastore_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/internal/resources/datastore"
+)
+
+var _ = Describe("DedicatedEtcd", func() {
+	var tcp *stewardv1alpha1.TenantControlPlane
+
+	BeforeEach(func() {
+		tcp = &stewardv1alpha1.TenantControlPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "tcp",
+				Namespace: "default",
+			},
+			Spec: stewardv1alpha1.TenantControlPlaneSpec{
+				DataStoreMode: stewardv1alpha1.DataStoreModeDedicated,
+			},
+		}
+	})
+
+	It("should report the failure of the etcd cluster", func() {
+		tcp.Status.Storage.Dedicated = &stewardv1alpha1.EtcdClusterStatus{Members: 3, Failure: "cannot add the etcd member"}
+
+		result, err := (&datastore.DedicatedEtcd{}).CreateOrUpdate(context.Background(), tcp)
+		Expect(err).To(MatchError(ContainSubstring("cannot add the etcd member")))
+		Expect(result).To(Equal(controllerutil.OperationResultNone))
+	})
+
+	It("should succeed when the etcd cluster has been operated successfully", func() {
+		tcp.Status.Storage.Dedicated = &stewardv1alpha1.EtcdClusterStatus{Members: 3, ReadyMembers: 3, Bootstrapped: true}
+
+		result, err := (&datastore.DedicatedEtcd{}).CreateOrUpdate(context.Background(), tcp)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(controllerutil.OperationResultNone))
+	})
+})
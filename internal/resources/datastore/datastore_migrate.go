@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -19,6 +20,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/internal/datastore"
 	stewarderrors "github.com/butlerdotdev/steward/internal/errors"
 	"github.com/butlerdotdev/steward/internal/resources"
 	"github.com/butlerdotdev/steward/internal/utilities"
@@ -37,6 +39,7 @@ type Migrate struct {
 	job              *batchv1.Job
 
 	inProgress bool
+	started    bool
 }
 
 func (d *Migrate) GetHistogram() prometheus.Histogram {
@@ -67,14 +70,15 @@ func (d *Migrate) Define(ctx context.Context, tenantControlPlane *stewardv1alpha
 		}
 	}
 
-	d.actualDatastore = &stewardv1alpha1.DataStore{}
-	if err := d.Client.Get(ctx, types.NamespacedName{Name: tenantControlPlane.Status.Storage.DataStoreName}, d.actualDatastore); err != nil {
+	var err error
+	// The dedicated etcd cluster is resolved to a DataStore as well, migrating from, and to, a shared one.
+	if d.actualDatastore, err = datastore.GetDataStore(ctx, d.Client, *tenantControlPlane, tenantControlPlane.Status.Storage.DataStoreName); err != nil {
 		return err
 	}
 
-	d.desiredDatastore = &stewardv1alpha1.DataStore{}
+	d.desiredDatastore, err = datastore.GetDataStore(ctx, d.Client, *tenantControlPlane, datastore.DesiredDataStoreName(*tenantControlPlane))
 
-	return d.Client.Get(ctx, types.NamespacedName{Name: tenantControlPlane.Spec.DataStore}, d.desiredDatastore)
+	return err
 }
 
 func (d *Migrate) ShouldCleanup(tcp *stewardv1alpha1.TenantControlPlane) bool {
@@ -121,13 +125,30 @@ func (d *Migrate) CreateOrUpdate(ctx context.Context, tenantControlPlane *stewar
 		d.job.Spec.Template.Spec.Containers[0].Args = []string{
 			"migrate",
 			fmt.Sprintf("--tenant-control-plane=%s/%s", tenantControlPlane.GetNamespace(), tenantControlPlane.GetName()),
-			fmt.Sprintf("--target-datastore=%s", tenantControlPlane.Spec.DataStore),
+			fmt.Sprintf("--target-datastore=%s", datastore.DesiredDataStoreName(*tenantControlPlane)),
+			fmt.Sprintf("--mode=%s", migrationMode(tenantControlPlane)),
+			"--job-uid=$(JOB_UID)",
+		}
+		// The Job UID scopes the migration progress reported in the Tenant Control Plane status.
+		d.job.Spec.Template.Spec.Containers[0].Env = []corev1.EnvVar{
+			{
+				Name: "JOB_UID",
+				ValueFrom: &corev1.EnvVarSource{
+					FieldRef: &corev1.ObjectFieldSelector{
+						APIVersion: "v1",
+						FieldPath:  fmt.Sprintf("metadata.labels['%s']", batchv1.ControllerUidLabel),
+					},
+				},
+			},
 		}
 
 		if annotations := tenantControlPlane.GetAnnotations(); annotations != nil {
 			v, _ := strconv.ParseBool(annotations["steward.butlerlabs.dev/cleanup-prior-migration"])
 			d.job.Spec.Template.Spec.Containers[0].Args = append(d.job.Spec.Template.Spec.Containers[0].Args, fmt.Sprintf("--cleanup-prior-migration=%t", v))
 
+			crossDriver, _ := strconv.ParseBool(annotations[stewardv1alpha1.CrossDriverMigrationAnnotation])
+			d.job.Spec.Template.Spec.Containers[0].Args = append(d.job.Spec.Template.Spec.Containers[0].Args, fmt.Sprintf("--allow-cross-driver=%t", crossDriver))
+
 			if timeout, tErr := time.ParseDuration(annotations["steward.butlerlabs.dev/migration-timeout"]); tErr == nil {
 				d.job.Spec.Template.Spec.Containers[0].Args = append(d.job.Spec.Template.Spec.Containers[0].Args, fmt.Sprintf("--timeout=%s", timeout.String()))
 			}
@@ -163,6 +184,10 @@ func (d *Migrate) CreateOrUpdate(ctx context.Context, tenantControlPlane *stewar
 		}
 
 		d.inProgress = true
+		// The live migration asks for the writes to be blocked once the keyspace has been copied.
+		if d.frozen(tenantControlPlane) && (tenantControlPlane.Status.Kubernetes.Version.Status == nil || *tenantControlPlane.Status.Kubernetes.Version.Status != stewardv1alpha1.VersionMigrating) {
+			return resources.OperationResultEnqueueBack, nil
+		}
 
 		return controllerutil.OperationResultNone, stewarderrors.MigrationInProcessError{}
 	default:
@@ -170,6 +195,31 @@ func (d *Migrate) CreateOrUpdate(ctx context.Context, tenantControlPlane *stewar
 	}
 }
 
+// migrationMode returns the mode requested by the Tenant Control Plane annotation, Freeze by default.
+func migrationMode(tenantControlPlane *stewardv1alpha1.TenantControlPlane) stewardv1alpha1.DataStoreMigrationMode {
+	if strings.EqualFold(tenantControlPlane.GetAnnotations()[stewardv1alpha1.MigrationModeAnnotation], string(stewardv1alpha1.DataStoreMigrationModeLive)) {
+		return stewardv1alpha1.DataStoreMigrationModeLive
+	}
+
+	return stewardv1alpha1.DataStoreMigrationModeFreeze
+}
+
+// frozen returns true when the migration requires the writes to be blocked:
+// the live migration copies the keyspace while the writes continue, blocking them only to replay the changes.
+func (d *Migrate) frozen(tenantControlPlane *stewardv1alpha1.TenantControlPlane) bool {
+	if migrationMode(tenantControlPlane) != stewardv1alpha1.DataStoreMigrationModeLive {
+		return true
+	}
+
+	// The progress reported by a former Job, such as a failed one, doesn't block the writes.
+	migration := tenantControlPlane.Status.Storage.Migration
+	if migration == nil || migration.DataStore != datastore.DesiredDataStoreName(*tenantControlPlane) || d.job.UID == "" || migration.JobUID != string(d.job.UID) {
+		return false
+	}
+
+	return migration.Phase == stewardv1alpha1.DataStoreMigrationPhaseFreezing || migration.Phase == stewardv1alpha1.DataStoreMigrationPhaseReplaying
+}
+
 func (d *Migrate) GetName() string {
 	return "migrate"
 }
@@ -179,9 +229,28 @@ func (d *Migrate) ShouldStatusBeUpdated(context.Context, *stewardv1alpha1.Tenant
 }
 
 func (d *Migrate) UpdateTenantControlPlaneStatus(_ context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) error {
-	if d.inProgress {
+	d.started = false
+
+	if d.inProgress && d.frozen(tenantControlPlane) {
+		status := tenantControlPlane.Status.Kubernetes.Version.Status
+		d.started = status == nil || *status != stewardv1alpha1.VersionMigrating
+
 		tenantControlPlane.Status.Kubernetes.Version.Status = &stewardv1alpha1.VersionMigrating
 	}
 
 	return nil
 }
+
+// Started returns true when the last status update moved the Tenant Control Plane into the Migrating phase.
+func (d *Migrate) Started() bool {
+	return d.started
+}
+
+// DataStore returns the name of the DataStore the Tenant Control Plane is migrated to.
+func (d *Migrate) DataStore() string {
+	if d.desiredDatastore == nil {
+		return ""
+	}
+
+	return d.desiredDatastore.GetName()
+}
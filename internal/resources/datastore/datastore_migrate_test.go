@@ -0,0 +1,137 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package datastore_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	stewarderrors "github.com/butlerdotdev/steward/internal/errors"
+	"github.com/butlerdotdev/steward/internal/resources"
+	"github.com/butlerdotdev/steward/internal/resources/datastore"
+)
+
+var _ = Describe("DatastoreMigrate", func() {
+	var (
+		ctx     context.Context
+		tcp     *stewardv1alpha1.TenantControlPlane
+		job     *batchv1.Job
+		migrate *datastore.Migrate
+		// reconcile runs the migration resource as the Tenant Control Plane reconciliation does.
+		reconcile func() (controllerutil.OperationResult, error)
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		tcp = &stewardv1alpha1.TenantControlPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "tcp", Namespace: "default", UID: "tcp-uid"},
+			Spec:       stewardv1alpha1.TenantControlPlaneSpec{DataStore: "target"},
+		}
+		tcp.Status.Storage.DataStoreName = "origin"
+		tcp.Status.Kubernetes.Version.Status = &stewardv1alpha1.VersionReady
+		// The Job is already running, the fake client doesn't assign the UID upon creation.
+		job = &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "migrate-tcp-uid", Namespace: "steward-system", UID: "job-uid"}}
+
+		Expect(stewardv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(batchv1.AddToScheme(scheme)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		fakeClient = fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			&stewardv1alpha1.DataStore{ObjectMeta: metav1.ObjectMeta{Name: "origin"}, Spec: stewardv1alpha1.DataStoreSpec{Driver: stewardv1alpha1.EtcdDriver}},
+			&stewardv1alpha1.DataStore{ObjectMeta: metav1.ObjectMeta{Name: "target"}, Spec: stewardv1alpha1.DataStoreSpec{Driver: stewardv1alpha1.EtcdDriver}},
+			job,
+		).Build()
+
+		reconcile = func() (controllerutil.OperationResult, error) {
+			migrate = &datastore.Migrate{Client: fakeClient, StewardNamespace: "steward-system", MigrateImage: "steward:latest"}
+
+			Expect(migrate.Define(ctx, tcp)).To(Succeed())
+
+			result, err := migrate.CreateOrUpdate(ctx, tcp)
+			if err == nil && migrate.ShouldStatusBeUpdated(ctx, tcp) {
+				Expect(migrate.UpdateTenantControlPlaneStatus(ctx, tcp)).To(Succeed())
+			}
+
+			return result, err
+		}
+		// Aligning the running Job to the desired spec.
+		result, err := reconcile()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(resources.OperationResultEnqueueBack))
+
+		tcp.Status.Kubernetes.Version.Status = &stewardv1alpha1.VersionReady
+	})
+
+	It("should scope the migration progress to the Job", func() {
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(job), job)).To(Succeed())
+
+		container := job.Spec.Template.Spec.Containers[0]
+		Expect(container.Args).To(ContainElement("--job-uid=$(JOB_UID)"))
+		Expect(container.Env).To(ConsistOf(corev1.EnvVar{
+			Name: "JOB_UID",
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{APIVersion: "v1", FieldPath: fmt.Sprintf("metadata.labels['%s']", batchv1.ControllerUidLabel)},
+			},
+		}))
+	})
+
+	It("should block the writes for the whole migration", func() {
+		result, err := reconcile()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(resources.OperationResultEnqueueBack))
+		Expect(*tcp.Status.Kubernetes.Version.Status).To(Equal(stewardv1alpha1.VersionMigrating))
+		Expect(migrate.Started()).To(BeTrue())
+		Expect(migrate.DataStore()).To(Equal("target"))
+		// Once blocked, the reconciliation waits for the Job completion.
+		_, err = reconcile()
+		Expect(err).To(MatchError(stewarderrors.MigrationInProcessError{}))
+		Expect(migrate.Started()).To(BeFalse())
+	})
+
+	When("the migration is live", func() {
+		BeforeEach(func() {
+			tcp.SetAnnotations(map[string]string{stewardv1alpha1.MigrationModeAnnotation: string(stewardv1alpha1.DataStoreMigrationModeLive)})
+		})
+
+		It("should allow the writes while copying the keyspace", func() {
+			tcp.Status.Storage.Migration = &stewardv1alpha1.DataStoreMigrationStatus{DataStore: "target", Phase: stewardv1alpha1.DataStoreMigrationPhaseCopying, JobUID: "job-uid"}
+
+			_, err := reconcile()
+			Expect(err).To(MatchError(stewarderrors.MigrationInProcessError{}))
+			Expect(*tcp.Status.Kubernetes.Version.Status).To(Equal(stewardv1alpha1.VersionReady))
+			Expect(migrate.Started()).To(BeFalse())
+		})
+
+		It("should block the writes upon the Freezing phase of the Job", func() {
+			tcp.Status.Storage.Migration = &stewardv1alpha1.DataStoreMigrationStatus{DataStore: "target", Phase: stewardv1alpha1.DataStoreMigrationPhaseFreezing, JobUID: "job-uid"}
+
+			result, err := reconcile()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(resources.OperationResultEnqueueBack))
+			Expect(*tcp.Status.Kubernetes.Version.Status).To(Equal(stewardv1alpha1.VersionMigrating))
+			Expect(migrate.Started()).To(BeTrue())
+		})
+
+		It("should ignore the phase left by a former Job", func() {
+			tcp.Status.Storage.Migration = &stewardv1alpha1.DataStoreMigrationStatus{DataStore: "target", Phase: stewardv1alpha1.DataStoreMigrationPhaseReplaying, JobUID: "failed-job-uid"}
+
+			_, err := reconcile()
+			Expect(err).To(MatchError(stewarderrors.MigrationInProcessError{}))
+			Expect(*tcp.Status.Kubernetes.Version.Status).To(Equal(stewardv1alpha1.VersionReady))
+		})
+	})
+})
@@ -0,0 +1,243 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/internal/backup"
+	stewarderrors "github.com/butlerdotdev/steward/internal/errors"
+	"github.com/butlerdotdev/steward/internal/resources"
+	"github.com/butlerdotdev/steward/internal/utilities"
+)
+
+// restoreJobTTL is how long the finished restore Jobs are retained, allowing to inspect their logs.
+const restoreJobTTL = 24 * time.Hour
+
+// Restore runs the Job loading the archive of a TenantControlPlaneRestore into the DataStore of the Tenant Control Plane:
+// the restore must be in the Restoring phase, with the Tenant Control Plane writes blocked.
+type Restore struct {
+	Client                client.Client
+	StewardNamespace      string
+	StewardServiceAccount string
+	RestoreImage          string
+
+	restore *stewardv1alpha1.TenantControlPlaneRestore
+	source  *backup.RestoreSource
+	job     *batchv1.Job
+
+	inProgress bool
+}
+
+func (d *Restore) GetHistogram() prometheus.Histogram {
+	restoreCollector = resources.LazyLoadHistogramFromResource(restoreCollector, d)
+
+	return restoreCollector
+}
+
+func (d *Restore) Define(ctx context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) error {
+	d.restore, d.source, d.job = nil, nil, nil
+
+	if len(tenantControlPlane.Status.Storage.DataStoreName) == 0 {
+		return nil
+	}
+
+	if permissions := tenantControlPlane.Spec.WritePermissions; !permissions.BlockCreate || !permissions.BlockUpdate || !permissions.BlockDelete {
+		return nil
+	}
+
+	var restoreList stewardv1alpha1.TenantControlPlaneRestoreList
+	if err := d.Client.List(ctx, &restoreList, client.InNamespace(tenantControlPlane.GetNamespace())); err != nil {
+		return err
+	}
+
+	for i := range restoreList.Items {
+		if item := restoreList.Items[i]; item.Spec.TenantControlPlane == tenantControlPlane.GetName() && item.Status.Phase == stewardv1alpha1.RestorePhaseRestoring {
+			d.restore = &item
+
+			break
+		}
+	}
+
+	if d.restore == nil {
+		return nil
+	}
+
+	source, err := backup.ResolveRestoreSource(ctx, d.Client, *d.restore)
+	if err != nil {
+		// The source backup has been deleted, or failed, after the restore started:
+		// the restore cannot proceed, and must not prevent the Tenant Control Plane reconciliation.
+		failErr := d.fail(ctx, err.Error())
+		d.restore = nil
+
+		return failErr
+	}
+
+	d.source = source
+
+	d.job = &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("restore-%s", d.restore.GetUID()),
+			Namespace: d.StewardNamespace,
+		},
+	}
+
+	if err = d.Client.Get(ctx, types.NamespacedName{Name: d.job.GetName(), Namespace: d.job.GetNamespace()}, d.job); err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *Restore) ShouldCleanup(*stewardv1alpha1.TenantControlPlane) bool {
+	return false
+}
+
+func (d *Restore) CleanUp(context.Context, *stewardv1alpha1.TenantControlPlane) (bool, error) {
+	return false, nil
+}
+
+func (d *Restore) CreateOrUpdate(ctx context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) (controllerutil.OperationResult, error) {
+	d.inProgress = false
+
+	if d.restore == nil {
+		return controllerutil.OperationResultNone, nil
+	}
+
+	res, err := utilities.CreateOrUpdateWithConflict(ctx, d.Client, d.job, func() error {
+		d.job.SetLabels(map[string]string{
+			"tcp.steward.butlerlabs.dev/name":        tenantControlPlane.GetName(),
+			"tcp.steward.butlerlabs.dev/namespace":   tenantControlPlane.GetNamespace(),
+			"tcprestore.steward.butlerlabs.dev/name": d.restore.GetName(),
+			"steward.butlerlabs.dev/component":       "restore",
+		})
+
+		// The restore command records the failure in the restore status: retrying would overwrite it.
+		d.job.Spec.BackoffLimit = ptr.To(int32(0))
+		d.job.Spec.TTLSecondsAfterFinished = ptr.To(int32(restoreJobTTL.Seconds()))
+		d.job.Spec.Template.Spec.ServiceAccountName = d.StewardServiceAccount
+		d.job.Spec.Template.Spec.RestartPolicy = corev1.RestartPolicyNever
+		if len(d.job.Spec.Template.Spec.Containers) == 0 {
+			d.job.Spec.Template.Spec.Containers = append(d.job.Spec.Template.Spec.Containers, corev1.Container{})
+		}
+		d.job.Spec.Template.Spec.Containers[0].Name = "restore"
+		d.job.Spec.Template.Spec.Containers[0].Image = d.RestoreImage
+		d.job.Spec.Template.Spec.Containers[0].Args = []string{
+			"restore",
+			fmt.Sprintf("--tenant-control-plane-restore=%s/%s", d.restore.GetNamespace(), d.restore.GetName()),
+		}
+
+		// The archive is imported from a local copy, verified beforehand.
+		d.job.Spec.Template.Spec.Containers[0].Args = append(d.job.Spec.Template.Spec.Containers[0].Args, fmt.Sprintf("--work-dir=%s", backup.RestoreWorkDir))
+		d.job.Spec.Template.Spec.Volumes = []corev1.Volume{
+			{
+				Name:         "workdir",
+				VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+			},
+		}
+		d.job.Spec.Template.Spec.Containers[0].VolumeMounts = []corev1.VolumeMount{
+			{
+				Name:      "workdir",
+				MountPath: backup.RestoreWorkDir,
+			},
+		}
+
+		if pvc := d.source.Storage.PersistentVolumeClaim; pvc != nil {
+			d.job.Spec.Template.Spec.Volumes = append(d.job.Spec.Template.Spec.Volumes, corev1.Volume{
+				Name: "backups",
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: pvc.ClaimName, ReadOnly: true},
+				},
+			})
+			d.job.Spec.Template.Spec.Containers[0].VolumeMounts = append(d.job.Spec.Template.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+				Name:      "backups",
+				MountPath: backup.VolumeMountPath,
+				ReadOnly:  true,
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		// Jobs are immutable, except for a tiny subset of fields:
+		// if the Job has a UUID, it means it's an update, and we're expecting that error.
+		if errors.IsForbidden(err) && d.job.UID != "" {
+			_ = d.Client.Delete(ctx, d.job)
+
+			return controllerutil.OperationResultNone, fmt.Errorf("restore job must be created back due to immutable fields")
+		}
+
+		return res, fmt.Errorf("unable to launch restore job: %w", err)
+	}
+
+	switch res {
+	case controllerutil.OperationResultCreated, controllerutil.OperationResultUpdated:
+		d.inProgress = true
+
+		return resources.OperationResultEnqueueBack, nil
+	case controllerutil.OperationResultNone:
+		// Note: job.Status.Conditions can contain more than one condition on Kubernetes versions greater than v1.30
+		for _, condition := range d.job.Status.Conditions {
+			if condition.Type == batchv1.JobFailed && condition.Status == corev1.ConditionTrue {
+				return controllerutil.OperationResultNone, d.fail(ctx, fmt.Sprintf("the restore Job failed: %s", condition.Message))
+			}
+		}
+		// The restore is still in progress, or its completion has not been recorded yet in the restore status.
+		d.inProgress = true
+
+		return controllerutil.OperationResultNone, stewarderrors.RestoreInProcessError{}
+	default:
+		return controllerutil.OperationResultNone, fmt.Errorf("unexpected status %s from the restore job", res)
+	}
+}
+
+// fail marks the restore as failed, such as when the Job has been terminated before recording the outcome.
+func (d *Restore) fail(ctx context.Context, message string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var restore stewardv1alpha1.TenantControlPlaneRestore
+		if err := d.Client.Get(ctx, types.NamespacedName{Namespace: d.restore.GetNamespace(), Name: d.restore.GetName()}, &restore); err != nil {
+			return err
+		}
+
+		if restore.Status.Phase != stewardv1alpha1.RestorePhaseRestoring {
+			return nil
+		}
+
+		restore.Status.Phase, restore.Status.Message, restore.Status.CompletionTime = stewardv1alpha1.RestorePhaseFailed, message, &metav1.Time{Time: time.Now()}
+
+		return d.Client.Status().Update(ctx, &restore)
+	})
+}
+
+func (d *Restore) GetName() string {
+	return "restore"
+}
+
+func (d *Restore) ShouldStatusBeUpdated(context.Context, *stewardv1alpha1.TenantControlPlane) bool {
+	return d.inProgress
+}
+
+func (d *Restore) UpdateTenantControlPlaneStatus(_ context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) error {
+	if d.inProgress {
+		tenantControlPlane.Status.Kubernetes.Version.Status = &stewardv1alpha1.VersionRestoring
+	}
+
+	return nil
+}
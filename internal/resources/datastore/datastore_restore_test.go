@@ -0,0 +1,126 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package datastore_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/internal/backup"
+	stewarderrors "github.com/butlerdotdev/steward/internal/errors"
+	"github.com/butlerdotdev/steward/internal/resources"
+	"github.com/butlerdotdev/steward/internal/resources/datastore"
+)
+
+var _ = Describe("DatastoreRestore", func() {
+	var (
+		ctx        context.Context
+		tcp        *stewardv1alpha1.TenantControlPlane
+		tcpRestore *stewardv1alpha1.TenantControlPlaneRestore
+		restore    *datastore.Restore
+		job        func() *batchv1.Job
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		tcp = &stewardv1alpha1.TenantControlPlane{ObjectMeta: metav1.ObjectMeta{Name: "tcp", Namespace: "default"}}
+		tcp.Spec.WritePermissions = stewardv1alpha1.Permissions{BlockCreate: true, BlockUpdate: true, BlockDelete: true}
+		tcp.Status.Storage.DataStoreName = "default"
+
+		tcpRestore = &stewardv1alpha1.TenantControlPlaneRestore{
+			ObjectMeta: metav1.ObjectMeta{Name: "restore", Namespace: "default", UID: "restore-uid"},
+			Spec: stewardv1alpha1.TenantControlPlaneRestoreSpec{
+				TenantControlPlane: "tcp",
+				Source: stewardv1alpha1.RestoreSource{Archive: &stewardv1alpha1.RestoreArchive{
+					Storage: stewardv1alpha1.BackupStorage{PersistentVolumeClaim: &stewardv1alpha1.BackupPersistentVolumeClaimStorage{ClaimName: "backups"}},
+					Name:    "tcp.jsonl.gz",
+				}},
+			},
+			Status: stewardv1alpha1.TenantControlPlaneRestoreStatus{Phase: stewardv1alpha1.RestorePhaseRestoring},
+		}
+
+		Expect(stewardv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(batchv1.AddToScheme(scheme)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		fakeClient = fake.NewClientBuilder().WithScheme(scheme).WithObjects(tcpRestore).WithStatusSubresource(tcpRestore).Build()
+
+		restore = &datastore.Restore{Client: fakeClient, StewardNamespace: "steward-system", StewardServiceAccount: "steward", RestoreImage: "steward:latest"}
+
+		job = func() *batchv1.Job {
+			var j batchv1.Job
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "restore-restore-uid", Namespace: "steward-system"}, &j)).To(Succeed())
+
+			return &j
+		}
+	})
+
+	It("should run the restore Job with a scratch volume for the verified archive", func() {
+		Expect(restore.Define(ctx, tcp)).To(Succeed())
+		Expect(restore.CreateOrUpdate(ctx, tcp)).To(Equal(resources.OperationResultEnqueueBack))
+		Expect(restore.ShouldStatusBeUpdated(ctx, tcp)).To(BeTrue())
+
+		spec := job().Spec
+		Expect(spec.BackoffLimit).To(Equal(ptr.To(int32(0))))
+		Expect(spec.Template.Spec.ServiceAccountName).To(Equal("steward"))
+
+		container := spec.Template.Spec.Containers[0]
+		Expect(container.Args).To(Equal([]string{"restore", "--tenant-control-plane-restore=default/restore", "--work-dir=" + backup.RestoreWorkDir}))
+		Expect(container.VolumeMounts).To(ConsistOf(
+			corev1.VolumeMount{Name: "workdir", MountPath: backup.RestoreWorkDir},
+			corev1.VolumeMount{Name: "backups", MountPath: backup.VolumeMountPath, ReadOnly: true},
+		))
+		Expect(spec.Template.Spec.Volumes).To(ConsistOf(
+			corev1.Volume{Name: "workdir", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+			corev1.Volume{Name: "backups", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "backups", ReadOnly: true}}},
+		))
+	})
+
+	It("should wait for the restore Job completion", func() {
+		Expect(restore.Define(ctx, tcp)).To(Succeed())
+		_, err := restore.CreateOrUpdate(ctx, tcp)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(restore.Define(ctx, tcp)).To(Succeed())
+		_, err = restore.CreateOrUpdate(ctx, tcp)
+		Expect(err).To(MatchError(stewarderrors.RestoreInProcessError{}))
+	})
+
+	It("should fail the restore upon the Job failure", func() {
+		Expect(restore.Define(ctx, tcp)).To(Succeed())
+		_, err := restore.CreateOrUpdate(ctx, tcp)
+		Expect(err).ToNot(HaveOccurred())
+
+		failed := job()
+		failed.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Message: "BackoffLimitExceeded"}}
+		Expect(fakeClient.Status().Update(ctx, failed)).To(Succeed())
+
+		Expect(restore.Define(ctx, tcp)).To(Succeed())
+		_, err = restore.CreateOrUpdate(ctx, tcp)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(tcpRestore), tcpRestore)).To(Succeed())
+		Expect(tcpRestore.Status.Phase).To(Equal(stewardv1alpha1.RestorePhaseFailed))
+		Expect(tcpRestore.Status.Message).To(ContainSubstring("BackoffLimitExceeded"))
+	})
+
+	It("should not run without the writes blocked", func() {
+		tcp.Spec.WritePermissions.BlockDelete = false
+
+		Expect(restore.Define(ctx, tcp)).To(Succeed())
+		Expect(restore.CreateOrUpdate(ctx, tcp)).To(Equal(controllerutil.OperationResultNone))
+	})
+})
@@ -0,0 +1,117 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package datastore
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/internal/resources"
+	"github.com/butlerdotdev/steward/internal/utilities"
+)
+
+// SQLiteVolume is the PersistentVolumeClaim storing the embedded SQLite database of the Tenant Control Plane:
+// it's owned by the Tenant Control Plane, and the data is deleted along with it.
+type SQLiteVolume struct {
+	resource  *corev1.PersistentVolumeClaim
+	Client    client.Client
+	DataStore stewardv1alpha1.DataStore
+}
+
+func (r *SQLiteVolume) GetHistogram() prometheus.Histogram {
+	sqliteVolumeCollector = resources.LazyLoadHistogramFromResource(sqliteVolumeCollector, r)
+
+	return sqliteVolumeCollector
+}
+
+func (r *SQLiteVolume) Define(_ context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) error {
+	r.resource = &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      utilities.AddTenantPrefix(r.GetName(), tenantControlPlane),
+			Namespace: tenantControlPlane.GetNamespace(),
+		},
+	}
+
+	return nil
+}
+
+// ShouldCleanup is always false: the Tenant Control Plane cannot be migrated from a SQLite DataStore,
+// and the database is never deleted unless the Tenant Control Plane is.
+func (r *SQLiteVolume) ShouldCleanup(*stewardv1alpha1.TenantControlPlane) bool {
+	return false
+}
+
+func (r *SQLiteVolume) CleanUp(context.Context, *stewardv1alpha1.TenantControlPlane) (bool, error) {
+	return false, nil
+}
+
+func (r *SQLiteVolume) CreateOrUpdate(ctx context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) (controllerutil.OperationResult, error) {
+	if r.DataStore.Spec.Driver != stewardv1alpha1.KineSQLiteDriver {
+		return controllerutil.OperationResultNone, nil
+	}
+
+	return utilities.CreateOrUpdateWithConflict(ctx, r.Client, r.resource, r.mutate(tenantControlPlane))
+}
+
+func (r *SQLiteVolume) GetName() string {
+	return "kine-sqlite"
+}
+
+func (r *SQLiteVolume) ShouldStatusBeUpdated(_ context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) bool {
+	if r.DataStore.Spec.Driver != stewardv1alpha1.KineSQLiteDriver {
+		return tenantControlPlane.Status.Storage.Volume != nil
+	}
+
+	return tenantControlPlane.Status.Storage.Volume == nil || tenantControlPlane.Status.Storage.Volume.ClaimName != r.resource.GetName()
+}
+
+func (r *SQLiteVolume) UpdateTenantControlPlaneStatus(_ context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) error {
+	if r.DataStore.Spec.Driver != stewardv1alpha1.KineSQLiteDriver {
+		tenantControlPlane.Status.Storage.Volume = nil
+
+		return nil
+	}
+
+	tenantControlPlane.Status.Storage.Volume = &stewardv1alpha1.DataStoreVolumeStatus{
+		ClaimName: r.resource.GetName(),
+	}
+
+	return nil
+}
+
+func (r *SQLiteVolume) mutate(tenantControlPlane *stewardv1alpha1.TenantControlPlane) controllerutil.MutateFn {
+	return func() error {
+		size := resource.MustParse("1Gi")
+
+		if config := r.DataStore.Spec.SQLite; config != nil {
+			if !config.Size.IsZero() {
+				size = config.Size
+			}
+			// The StorageClass is immutable, it's set only upon creation.
+			if r.resource.CreationTimestamp.IsZero() {
+				r.resource.Spec.StorageClassName = config.StorageClassName
+			}
+		}
+
+		r.resource.Spec.AccessModes = []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}
+		// The volume can be expanded, although not shrunk.
+		if current, ok := r.resource.Spec.Resources.Requests[corev1.ResourceStorage]; !ok || size.Cmp(current) > 0 {
+			r.resource.Spec.Resources.Requests = corev1.ResourceList{
+				corev1.ResourceStorage: size,
+			}
+		}
+
+		r.resource.SetLabels(utilities.MergeMaps(r.resource.GetLabels(), utilities.StewardLabels(tenantControlPlane.GetName(), r.GetName())))
+
+		return ctrl.SetControllerReference(tenantControlPlane, r.resource, r.Client.Scheme())
+	}
+}
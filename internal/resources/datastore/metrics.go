@@ -9,8 +9,11 @@ import (
 
 var (
 	certificateCollector  prometheus.Histogram
+	dedicatedCollector    prometheus.Histogram
 	migrateCollector      prometheus.Histogram
 	multiTenancyCollector prometheus.Histogram
+	restoreCollector      prometheus.Histogram
 	setupCollector        prometheus.Histogram
+	sqliteVolumeCollector prometheus.Histogram
 	storageCollector      prometheus.Histogram
 )
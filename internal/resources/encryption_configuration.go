@@ -0,0 +1,482 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package resources
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	apiserverv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	builder "github.com/butlerdotdev/steward/internal/builders/controlplane"
+	"github.com/butlerdotdev/steward/internal/constants"
+	"github.com/butlerdotdev/steward/internal/utilities"
+)
+
+const (
+	encryptionConfigurationKind       = "EncryptionConfiguration"
+	encryptionConfigurationAPIVersion = "apiserver.config.k8s.io/v1"
+	encryptionProviderIdentity        = "identity"
+)
+
+// encryptionKey is the flattened representation of a provider and its key:
+// the first element of a list is the one used to encrypt data, the remaining ones are used only for decryption.
+type encryptionKey struct {
+	Provider string
+	Key      apiserverv1.Key
+	KMS      *apiserverv1.KMSConfiguration
+}
+
+// EncryptionConfiguration manages the EncryptionConfiguration consumed by the Tenant Control Plane API Server,
+// along with the key rotation which is performed in the following phases:
+//  1. the new key is added as a read-only one, and the API Server instances are rolled out;
+//  2. the new key is promoted as the write one, and the API Server instances are rolled out;
+//  3. the Secrets are rewritten through the tenant API a page at a time, tracking the progress in the status,
+//     and the old key is dropped.
+//
+// The very same phases are used when the encryption is enabled on, or disabled from,
+// a running Tenant Control Plane, considering the identity provider as the old, or new, key.
+type EncryptionConfiguration struct {
+	resource *corev1.Secret
+	Client   client.Client
+
+	deploymentFound bool
+	rolledOut       bool
+	rewritten       bool
+	deleted         bool
+	secretsRewrite  *stewardv1alpha1.EncryptionSecretsRewriteStatus
+}
+
+func (r *EncryptionConfiguration) GetHistogram() prometheus.Histogram {
+	encryptionconfigurationCollector = LazyLoadHistogramFromResource(encryptionconfigurationCollector, r)
+
+	return encryptionconfigurationCollector
+}
+
+func (r *EncryptionConfiguration) Define(_ context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) error {
+	r.resource = &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      utilities.AddTenantPrefix(r.GetName(), tenantControlPlane),
+			Namespace: tenantControlPlane.GetNamespace(),
+		},
+	}
+
+	return nil
+}
+
+func (r *EncryptionConfiguration) ShouldCleanup(tenantControlPlane *stewardv1alpha1.TenantControlPlane) bool {
+	return tenantControlPlane.Spec.Kubernetes.EncryptionAtRest == nil && len(tenantControlPlane.Status.EncryptionAtRest.SecretName) > 0
+}
+
+func (r *EncryptionConfiguration) CleanUp(ctx context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) (bool, error) {
+	logger := log.FromContext(ctx, "resource", r.GetName())
+
+	if err := r.Client.Get(ctx, k8stypes.NamespacedName{Namespace: r.resource.GetNamespace(), Name: r.resource.GetName()}, r.resource); err != nil {
+		if k8serrors.IsNotFound(err) {
+			r.deleted = true
+
+			return true, nil
+		}
+
+		return false, err
+	}
+
+	keys, err := r.decodeKeys()
+	if err != nil {
+		return false, err
+	}
+	// The stored Secrets have been decrypted, the EncryptionConfiguration is no more required.
+	if r.rotationPhase() == "" && len(keys) == 1 && keys[0].Provider == encryptionProviderIdentity {
+		if err = r.Client.Delete(ctx, r.resource); err != nil && !k8serrors.IsNotFound(err) {
+			logger.Error(err, "cannot delete the requested resource")
+
+			return false, err
+		}
+
+		r.deleted = true
+
+		return true, nil
+	}
+
+	res, err := r.reconcile(ctx, tenantControlPlane, encryptionProviderIdentity)
+
+	return res != controllerutil.OperationResultNone, err
+}
+
+func (r *EncryptionConfiguration) CreateOrUpdate(ctx context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) (controllerutil.OperationResult, error) {
+	if tenantControlPlane.Spec.Kubernetes.EncryptionAtRest == nil {
+		return controllerutil.OperationResultNone, nil
+	}
+
+	return r.reconcile(ctx, tenantControlPlane, string(tenantControlPlane.Spec.Kubernetes.EncryptionAtRest.Provider))
+}
+
+func (r *EncryptionConfiguration) GetName() string {
+	return "encryption-configuration"
+}
+
+func (r *EncryptionConfiguration) ShouldStatusBeUpdated(_ context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) bool {
+	if r.deleted {
+		return len(tenantControlPlane.Status.EncryptionAtRest.SecretName) > 0
+	}
+
+	return tenantControlPlane.Status.EncryptionAtRest.SecretName != r.resource.GetName() ||
+		tenantControlPlane.Status.EncryptionAtRest.Checksum != utilities.GetObjectChecksum(r.resource) ||
+		tenantControlPlane.Status.EncryptionAtRest.KeyRotationPhase != r.rotationPhase() ||
+		!equality.Semantic.DeepEqual(tenantControlPlane.Status.EncryptionAtRest.SecretsRewrite, r.secretsRewrite)
+}
+
+func (r *EncryptionConfiguration) UpdateTenantControlPlaneStatus(_ context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) error {
+	tenantControlPlane.Status.EncryptionAtRest = stewardv1alpha1.EncryptionAtRestStatus{}
+
+	if r.deleted {
+		return nil
+	}
+
+	keys, err := r.decodeKeys()
+	if err != nil {
+		return err
+	}
+
+	if len(keys) > 0 {
+		tenantControlPlane.Status.EncryptionAtRest.Provider = keys[0].Provider
+	}
+
+	tenantControlPlane.Status.EncryptionAtRest.SecretName = r.resource.GetName()
+	tenantControlPlane.Status.EncryptionAtRest.Checksum = utilities.GetObjectChecksum(r.resource)
+	tenantControlPlane.Status.EncryptionAtRest.LastUpdate = metav1.Now()
+	tenantControlPlane.Status.EncryptionAtRest.KeyRotationPhase = r.rotationPhase()
+	tenantControlPlane.Status.EncryptionAtRest.SecretsRewrite = r.secretsRewrite
+
+	return nil
+}
+
+func (r *EncryptionConfiguration) reconcile(ctx context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane, provider string) (controllerutil.OperationResult, error) {
+	logger := log.FromContext(ctx, "resource", r.GetName())
+
+	if err := r.Client.Get(ctx, k8stypes.NamespacedName{Namespace: r.resource.GetNamespace(), Name: r.resource.GetName()}, r.resource); err != nil && !k8serrors.IsNotFound(err) {
+		return controllerutil.OperationResultNone, err
+	}
+
+	if err := r.checkRollout(ctx, tenantControlPlane); err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+
+	if r.rotationPhase() == stewardv1alpha1.EncryptionKeyRotationKeyPromoted {
+		// The progress is retained while waiting for the rollout, such as for a sleeping Tenant Control Plane.
+		r.secretsRewrite = tenantControlPlane.Status.EncryptionAtRest.SecretsRewrite.DeepCopy()
+	}
+
+	if r.rotationPhase() == stewardv1alpha1.EncryptionKeyRotationKeyPromoted && r.rolledOut {
+		if r.secretsRewrite == nil {
+			logger.Info("rewriting the tenant Secrets with the promoted encryption key")
+
+			r.secretsRewrite = &stewardv1alpha1.EncryptionSecretsRewriteStatus{}
+		}
+
+		dynamicClient, err := utilities.GetTenantDynamicClient(ctx, r.Client, tenantControlPlane)
+		if err != nil {
+			return controllerutil.OperationResultNone, errors.Wrap(err, "cannot create the tenant client")
+		}
+
+		count, done, err := utilities.RewritePage(ctx, dynamicClient, corev1.SchemeGroupVersion.WithResource("secrets"), &r.secretsRewrite.Continue)
+		if err != nil {
+			return controllerutil.OperationResultNone, err
+		}
+
+		r.secretsRewrite.Rewritten += int64(count)
+		r.rewritten = done
+		// The next page is rewritten in the next reconciliation, without holding the current one.
+		if !r.rewritten {
+			return OperationResultEnqueueBack, nil
+		}
+
+		logger.Info("the tenant Secrets have been rewritten", "count", r.secretsRewrite.Rewritten)
+
+		r.secretsRewrite = nil
+	}
+
+	return utilities.CreateOrUpdateWithConflict(ctx, r.Client, r.resource, r.mutate(tenantControlPlane, provider))
+}
+
+// checkRollout verifies if all the API Server instances are running with the current EncryptionConfiguration.
+func (r *EncryptionConfiguration) checkRollout(ctx context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) error {
+	deployment := &appsv1.Deployment{}
+	if err := r.Client.Get(ctx, k8stypes.NamespacedName{Namespace: tenantControlPlane.GetNamespace(), Name: tenantControlPlane.GetName()}, deployment); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+
+		return errors.Wrap(err, "cannot retrieve the Tenant Control Plane Deployment")
+	}
+
+	r.deploymentFound = true
+
+	if deployment.Spec.Template.GetLabels()[builder.EncryptionConfigurationTemplateLabel] != utilities.GetObjectChecksum(r.resource) {
+		return nil
+	}
+	// A sleeping Tenant Control Plane cannot progress with the rotation since the API Server is not available.
+	replicas := ptr.Deref(deployment.Spec.Replicas, 0)
+
+	r.rolledOut = replicas > 0 &&
+		deployment.Status.ObservedGeneration == deployment.GetGeneration() &&
+		deployment.Status.UnavailableReplicas == 0 &&
+		deployment.Status.UpdatedReplicas == replicas &&
+		deployment.Status.ReadyReplicas == replicas &&
+		deployment.Status.Replicas == replicas
+
+	return nil
+}
+
+func (r *EncryptionConfiguration) mutate(tenantControlPlane *stewardv1alpha1.TenantControlPlane, provider string) controllerutil.MutateFn {
+	return func() error {
+		keys, err := r.decodeKeys()
+		if err != nil {
+			return err
+		}
+
+		annotations := r.resource.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+
+		v, ok := annotations[constants.EncryptionKeyRotationRequest]
+		isRotationRequested := ok && v == ""
+
+		switch r.rotationPhase() {
+		case stewardv1alpha1.EncryptionKeyRotationKeyAdded:
+			if r.rolledOut {
+				keys = []encryptionKey{keys[len(keys)-1], keys[0]}
+				annotations[constants.EncryptionKeyRotationPhase] = string(stewardv1alpha1.EncryptionKeyRotationKeyPromoted)
+			}
+		case stewardv1alpha1.EncryptionKeyRotationKeyPromoted:
+			if r.rewritten {
+				keys = keys[:1]
+				delete(annotations, constants.EncryptionKeyRotationPhase)
+
+				if isRotationRequested {
+					annotations[constants.EncryptionKeyRotationRequest] = metav1.Now().Format(time.RFC3339)
+				}
+			}
+		default:
+			switch {
+			case len(keys) == 0 && !r.deploymentFound:
+				// Brand-new Tenant Control Plane, no data has been stored yet.
+				if keys, err = r.generateKeys(tenantControlPlane, provider); err != nil {
+					return err
+				}
+			case len(keys) == 0:
+				// Enabling the encryption on a running Tenant Control Plane: the stored Secrets are in plain text.
+				newKeys, kErr := r.generateKeys(tenantControlPlane, provider)
+				if kErr != nil {
+					return kErr
+				}
+
+				keys = append([]encryptionKey{{Provider: encryptionProviderIdentity}}, newKeys...)
+				annotations[constants.EncryptionKeyRotationPhase] = string(stewardv1alpha1.EncryptionKeyRotationKeyAdded)
+			case !r.isProviderInUse(keys[0], tenantControlPlane, provider),
+				isRotationRequested && provider != encryptionProviderIdentity && provider != string(stewardv1alpha1.EncryptionProviderKMS):
+				newKeys, kErr := r.generateKeys(tenantControlPlane, provider)
+				if kErr != nil {
+					return kErr
+				}
+
+				keys = append(keys[:1], newKeys...)
+				annotations[constants.EncryptionKeyRotationPhase] = string(stewardv1alpha1.EncryptionKeyRotationKeyAdded)
+			case isRotationRequested && provider == string(stewardv1alpha1.EncryptionProviderKMS):
+				// KMS v2 keys are rotated by the plugin itself:
+				// the stored Secrets are just rewritten in order to be encrypted with the current remote key.
+				annotations[constants.EncryptionKeyRotationPhase] = string(stewardv1alpha1.EncryptionKeyRotationKeyPromoted)
+			case keys[0].KMS != nil:
+				// Aligning the KMS plugin settings, such as the timeout.
+				kmsKeys, kErr := r.generateKeys(tenantControlPlane, provider)
+				if kErr != nil {
+					return kErr
+				}
+
+				keys[0] = kmsKeys[0]
+			}
+		}
+
+		configuration, err := utilities.EncodeToYaml(r.encodeKeys(keys))
+		if err != nil {
+			return errors.Wrap(err, "cannot encode the EncryptionConfiguration")
+		}
+
+		r.resource.SetAnnotations(annotations)
+		r.resource.SetLabels(utilities.MergeMaps(r.resource.GetLabels(), utilities.StewardLabels(tenantControlPlane.GetName(), r.GetName())))
+		r.resource.Data = map[string][]byte{
+			builder.EncryptionConfigurationFileName: configuration,
+		}
+
+		utilities.SetObjectChecksum(r.resource, r.resource.Data)
+
+		return ctrl.SetControllerReference(tenantControlPlane, r.resource, r.Client.Scheme())
+	}
+}
+
+func (r *EncryptionConfiguration) rotationPhase() stewardv1alpha1.EncryptionKeyRotationPhase {
+	return stewardv1alpha1.EncryptionKeyRotationPhase(r.resource.GetAnnotations()[constants.EncryptionKeyRotationPhase])
+}
+
+// isProviderInUse returns true if the given key is the one expected for the desired provider:
+// for the kms one, the KMS plugin name is part of the stored data, and must match.
+func (r *EncryptionConfiguration) isProviderInUse(key encryptionKey, tenantControlPlane *stewardv1alpha1.TenantControlPlane, provider string) bool {
+	if key.Provider != provider {
+		return false
+	}
+
+	if plugin := tenantControlPlane.Spec.ControlPlane.Deployment.KMSPlugin; key.KMS != nil && plugin != nil {
+		return key.KMS.Name == plugin.Name
+	}
+
+	return true
+}
+
+func (r *EncryptionConfiguration) generateKeys(tenantControlPlane *stewardv1alpha1.TenantControlPlane, provider string) ([]encryptionKey, error) {
+	switch provider {
+	case encryptionProviderIdentity:
+		return []encryptionKey{{Provider: provider}}, nil
+	case string(stewardv1alpha1.EncryptionProviderKMS):
+		plugin := tenantControlPlane.Spec.ControlPlane.Deployment.KMSPlugin
+		if plugin == nil {
+			return nil, errors.New("the kms encryption provider requires the KMS plugin to be declared")
+		}
+
+		kms := &apiserverv1.KMSConfiguration{
+			APIVersion: "v2",
+			Name:       plugin.Name,
+			Endpoint:   builder.KMSPluginEndpoint,
+		}
+
+		if plugin.Timeout != nil {
+			kms.Timeout = &metav1.Duration{Duration: plugin.Timeout.Duration}
+		}
+
+		return []encryptionKey{{Provider: provider, KMS: kms}}, nil
+	}
+	// All the supported providers can work with 32 bytes keys.
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, errors.Wrap(err, "cannot generate the encryption key")
+	}
+
+	return []encryptionKey{
+		{
+			Provider: provider,
+			Key: apiserverv1.Key{
+				Name:   fmt.Sprintf("key-%d", time.Now().Unix()),
+				Secret: base64.StdEncoding.EncodeToString(secret),
+			},
+		},
+	}, nil
+}
+
+func (r *EncryptionConfiguration) decodeKeys() ([]encryptionKey, error) {
+	data, ok := r.resource.Data[builder.EncryptionConfigurationFileName]
+	if !ok {
+		return nil, nil
+	}
+
+	var configuration apiserverv1.EncryptionConfiguration
+	if err := utilities.DecodeFromYAML(string(data), &configuration); err != nil {
+		return nil, errors.Wrap(err, "cannot decode the EncryptionConfiguration")
+	}
+
+	var keys []encryptionKey
+
+	for _, resource := range configuration.Resources {
+		for _, p := range resource.Providers {
+			switch {
+			case p.AESCBC != nil:
+				for _, key := range p.AESCBC.Keys {
+					keys = append(keys, encryptionKey{Provider: string(stewardv1alpha1.EncryptionProviderAESCBC), Key: key})
+				}
+			case p.AESGCM != nil:
+				for _, key := range p.AESGCM.Keys {
+					keys = append(keys, encryptionKey{Provider: string(stewardv1alpha1.EncryptionProviderAESGCM), Key: key})
+				}
+			case p.Secretbox != nil:
+				for _, key := range p.Secretbox.Keys {
+					keys = append(keys, encryptionKey{Provider: string(stewardv1alpha1.EncryptionProviderSecretbox), Key: key})
+				}
+			case p.KMS != nil:
+				keys = append(keys, encryptionKey{Provider: string(stewardv1alpha1.EncryptionProviderKMS), KMS: p.KMS})
+			case p.Identity != nil:
+				keys = append(keys, encryptionKey{Provider: encryptionProviderIdentity})
+			}
+		}
+	}
+
+	return keys, nil
+}
+
+// encodeKeys renders the EncryptionConfiguration, grouping the subsequent keys of the same static provider.
+func (r *EncryptionConfiguration) encodeKeys(keys []encryptionKey) *apiserverv1.EncryptionConfiguration {
+	providers := make([]apiserverv1.ProviderConfiguration, 0, len(keys))
+
+	for index, key := range keys {
+		if index > 0 && keys[index-1].Provider == key.Provider && key.Provider != encryptionProviderIdentity && key.KMS == nil {
+			last := &providers[len(providers)-1]
+
+			switch stewardv1alpha1.EncryptionProvider(key.Provider) {
+			case stewardv1alpha1.EncryptionProviderAESCBC:
+				last.AESCBC.Keys = append(last.AESCBC.Keys, key.Key)
+			case stewardv1alpha1.EncryptionProviderAESGCM:
+				last.AESGCM.Keys = append(last.AESGCM.Keys, key.Key)
+			case stewardv1alpha1.EncryptionProviderSecretbox:
+				last.Secretbox.Keys = append(last.Secretbox.Keys, key.Key)
+			}
+
+			continue
+		}
+
+		var provider apiserverv1.ProviderConfiguration
+
+		switch key.Provider {
+		case string(stewardv1alpha1.EncryptionProviderAESCBC):
+			provider.AESCBC = &apiserverv1.AESConfiguration{Keys: []apiserverv1.Key{key.Key}}
+		case string(stewardv1alpha1.EncryptionProviderAESGCM):
+			provider.AESGCM = &apiserverv1.AESConfiguration{Keys: []apiserverv1.Key{key.Key}}
+		case string(stewardv1alpha1.EncryptionProviderSecretbox):
+			provider.Secretbox = &apiserverv1.SecretboxConfiguration{Keys: []apiserverv1.Key{key.Key}}
+		case string(stewardv1alpha1.EncryptionProviderKMS):
+			provider.KMS = key.KMS
+		case encryptionProviderIdentity:
+			provider.Identity = &apiserverv1.IdentityConfiguration{}
+		}
+
+		providers = append(providers, provider)
+	}
+
+	return &apiserverv1.EncryptionConfiguration{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       encryptionConfigurationKind,
+			APIVersion: encryptionConfigurationAPIVersion,
+		},
+		Resources: []apiserverv1.ResourceConfiguration{
+			{
+				Resources: []string{"secrets"},
+				Providers: providers,
+			},
+		},
+	}
+}
@@ -0,0 +1,216 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package resources_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	apiserverv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	builder "github.com/butlerdotdev/steward/internal/builders/controlplane"
+	"github.com/butlerdotdev/steward/internal/constants"
+	"github.com/butlerdotdev/steward/internal/resources"
+	"github.com/butlerdotdev/steward/internal/utilities"
+)
+
+var _ = Describe("EncryptionConfiguration", func() {
+	var (
+		ctx        context.Context
+		fakeClient client.Client
+		tcp        *stewardv1alpha1.TenantControlPlane
+	)
+
+	handle := func() {
+		resource := &resources.EncryptionConfiguration{Client: fakeClient}
+
+		_, err := resources.Handle(ctx, resource, tcp)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resource.UpdateTenantControlPlaneStatus(ctx, tcp)).To(Succeed())
+	}
+
+	getConfiguration := func() (*corev1.Secret, *apiserverv1.EncryptionConfiguration) {
+		secret := &corev1.Secret{}
+		Expect(fakeClient.Get(ctx, k8stypes.NamespacedName{Namespace: tcp.Namespace, Name: tcp.Status.EncryptionAtRest.SecretName}, secret)).To(Succeed())
+
+		configuration := &apiserverv1.EncryptionConfiguration{}
+		Expect(utilities.DecodeFromYAML(string(secret.Data[builder.EncryptionConfigurationFileName]), configuration)).To(Succeed())
+		Expect(configuration.Resources).To(HaveLen(1))
+		Expect(configuration.Resources[0].Resources).To(ConsistOf("secrets"))
+
+		return secret, configuration
+	}
+
+	rollout := func() {
+		deployment := &appsv1.Deployment{}
+		Expect(fakeClient.Get(ctx, k8stypes.NamespacedName{Namespace: tcp.Namespace, Name: tcp.Name}, deployment)).To(Succeed())
+
+		deployment.Spec.Template.Labels = map[string]string{builder.EncryptionConfigurationTemplateLabel: tcp.Status.EncryptionAtRest.Checksum}
+		Expect(fakeClient.Update(ctx, deployment)).To(Succeed())
+
+		deployment.Status = appsv1.DeploymentStatus{
+			ObservedGeneration: deployment.Generation,
+			Replicas:           1,
+			UpdatedReplicas:    1,
+			ReadyReplicas:      1,
+		}
+		Expect(fakeClient.Status().Update(ctx, deployment)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		fakeClient = fake.NewClientBuilder().
+			WithScheme(runtimeScheme).
+			WithStatusSubresource(&appsv1.Deployment{}).
+			Build()
+
+		tcp = &stewardv1alpha1.TenantControlPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-tcp",
+				Namespace: "default",
+				UID:       "test-uid",
+			},
+			Spec: stewardv1alpha1.TenantControlPlaneSpec{
+				Kubernetes: stewardv1alpha1.KubernetesSpec{
+					EncryptionAtRest: &stewardv1alpha1.EncryptionAtRestSpec{
+						Provider: stewardv1alpha1.EncryptionProviderAESCBC,
+					},
+				},
+			},
+		}
+	})
+
+	It("should generate a single write key for a brand-new Tenant Control Plane", func() {
+		handle()
+
+		Expect(tcp.Status.EncryptionAtRest.SecretName).To(Equal("test-tcp-encryption-configuration"))
+		Expect(tcp.Status.EncryptionAtRest.Checksum).ToNot(BeEmpty())
+		Expect(tcp.Status.EncryptionAtRest.Provider).To(Equal("aescbc"))
+		Expect(tcp.Status.EncryptionAtRest.KeyRotationPhase).To(BeEmpty())
+
+		_, configuration := getConfiguration()
+		Expect(configuration.Resources[0].Providers).To(HaveLen(1))
+		Expect(configuration.Resources[0].Providers[0].AESCBC).ToNot(BeNil())
+		Expect(configuration.Resources[0].Providers[0].AESCBC.Keys).To(HaveLen(1))
+	})
+
+	It("should encrypt an already running Tenant Control Plane by phases", func() {
+		Expect(fakeClient.Create(ctx, &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: tcp.Name, Namespace: tcp.Namespace},
+			Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(int32(1))},
+		})).To(Succeed())
+
+		handle()
+
+		Expect(tcp.Status.EncryptionAtRest.KeyRotationPhase).To(Equal(stewardv1alpha1.EncryptionKeyRotationKeyAdded))
+		_, configuration := getConfiguration()
+		Expect(configuration.Resources[0].Providers).To(HaveLen(2))
+		Expect(configuration.Resources[0].Providers[0].Identity).ToNot(BeNil())
+		Expect(configuration.Resources[0].Providers[1].AESCBC).ToNot(BeNil())
+
+		By("waiting for the rollout before promoting the key")
+		handle()
+		Expect(tcp.Status.EncryptionAtRest.KeyRotationPhase).To(Equal(stewardv1alpha1.EncryptionKeyRotationKeyAdded))
+
+		rollout()
+		handle()
+
+		Expect(tcp.Status.EncryptionAtRest.KeyRotationPhase).To(Equal(stewardv1alpha1.EncryptionKeyRotationKeyPromoted))
+		Expect(tcp.Status.EncryptionAtRest.Provider).To(Equal("aescbc"))
+		_, configuration = getConfiguration()
+		Expect(configuration.Resources[0].Providers).To(HaveLen(2))
+		Expect(configuration.Resources[0].Providers[0].AESCBC).ToNot(BeNil())
+		Expect(configuration.Resources[0].Providers[1].Identity).ToNot(BeNil())
+	})
+
+	It("should add a new read-only key upon rotation request", func() {
+		handle()
+
+		Expect(fakeClient.Create(ctx, &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: tcp.Name, Namespace: tcp.Namespace},
+			Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(int32(1))},
+		})).To(Succeed())
+
+		secret, configuration := getConfiguration()
+		writeKey := configuration.Resources[0].Providers[0].AESCBC.Keys[0]
+
+		secret.Annotations[constants.EncryptionKeyRotationRequest] = ""
+		Expect(fakeClient.Update(ctx, secret)).To(Succeed())
+
+		handle()
+
+		Expect(tcp.Status.EncryptionAtRest.KeyRotationPhase).To(Equal(stewardv1alpha1.EncryptionKeyRotationKeyAdded))
+		_, configuration = getConfiguration()
+		Expect(configuration.Resources[0].Providers).To(HaveLen(1))
+		Expect(configuration.Resources[0].Providers[0].AESCBC.Keys).To(HaveLen(2))
+		Expect(configuration.Resources[0].Providers[0].AESCBC.Keys[0]).To(Equal(writeKey))
+	})
+
+	It("should rotate the key when the provider is changed", func() {
+		handle()
+
+		Expect(fakeClient.Create(ctx, &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: tcp.Name, Namespace: tcp.Namespace},
+			Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(int32(1))},
+		})).To(Succeed())
+
+		tcp.Spec.Kubernetes.EncryptionAtRest.Provider = stewardv1alpha1.EncryptionProviderSecretbox
+
+		handle()
+
+		Expect(tcp.Status.EncryptionAtRest.KeyRotationPhase).To(Equal(stewardv1alpha1.EncryptionKeyRotationKeyAdded))
+		_, configuration := getConfiguration()
+		Expect(configuration.Resources[0].Providers).To(HaveLen(2))
+		Expect(configuration.Resources[0].Providers[0].AESCBC).ToNot(BeNil())
+		Expect(configuration.Resources[0].Providers[1].Secretbox).ToNot(BeNil())
+	})
+
+	It("should generate the kms provider from the KMS plugin", func() {
+		tcp.Spec.Kubernetes.EncryptionAtRest.Provider = stewardv1alpha1.EncryptionProviderKMS
+		tcp.Spec.ControlPlane.Deployment.KMSPlugin = &stewardv1alpha1.KMSPluginSpec{
+			Name:    "vault",
+			Image:   "kms-plugin:latest",
+			Timeout: &metav1.Duration{Duration: 5 * time.Second},
+		}
+
+		handle()
+
+		Expect(tcp.Status.EncryptionAtRest.Provider).To(Equal("kms"))
+		_, configuration := getConfiguration()
+		Expect(configuration.Resources[0].Providers).To(HaveLen(1))
+		Expect(configuration.Resources[0].Providers[0].KMS).ToNot(BeNil())
+		Expect(configuration.Resources[0].Providers[0].KMS.APIVersion).To(Equal("v2"))
+		Expect(configuration.Resources[0].Providers[0].KMS.Name).To(Equal("vault"))
+		Expect(configuration.Resources[0].Providers[0].KMS.Endpoint).To(Equal(builder.KMSPluginEndpoint))
+		Expect(configuration.Resources[0].Providers[0].KMS.Timeout.Duration).To(Equal(5 * time.Second))
+
+		By("rewriting the stored data upon rotation request, without adding keys")
+		Expect(fakeClient.Create(ctx, &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: tcp.Name, Namespace: tcp.Namespace},
+			Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(int32(1))},
+		})).To(Succeed())
+
+		secret, _ := getConfiguration()
+		secret.Annotations[constants.EncryptionKeyRotationRequest] = ""
+		Expect(fakeClient.Update(ctx, secret)).To(Succeed())
+
+		handle()
+
+		Expect(tcp.Status.EncryptionAtRest.KeyRotationPhase).To(Equal(stewardv1alpha1.EncryptionKeyRotationKeyPromoted))
+		_, configuration = getConfiguration()
+		Expect(configuration.Resources[0].Providers).To(HaveLen(1))
+		Expect(configuration.Resources[0].Providers[0].KMS).ToNot(BeNil())
+	})
+})
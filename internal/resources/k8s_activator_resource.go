@@ -0,0 +1,263 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/internal/utilities"
+)
+
+// ActivatorManagedBy is the EndpointSlice manager value for the slices routing a Service to the Steward activator.
+const ActivatorManagedBy = "activator.steward.butlerlabs.dev"
+
+// ActivatorPorts allocates the activator ports from the given range, and it's owned by the Tenant Control Plane reconciler:
+// it keeps track of the ports allocated in the current reconciliations, since they're persisted in the Tenant Control Plane
+// status only once its resource has been handled. Upon the first allocation, it's rebuilt from the existing activator
+// EndpointSlices, retaining the ports routed before a restart of the manager and not persisted yet.
+type ActivatorPorts struct {
+	Range utilnet.PortRange
+
+	mu        sync.Mutex
+	allocated map[int32]k8stypes.UID
+}
+
+// rebuild collects the ports of the existing activator EndpointSlices, keyed by the owner Tenant Control Plane.
+func (p *ActivatorPorts) rebuild(ctx context.Context, c client.Client) error {
+	var slices discoveryv1.EndpointSliceList
+	if err := c.List(ctx, &slices, client.MatchingLabels{discoveryv1.LabelManagedBy: ActivatorManagedBy}); err != nil {
+		return errors.Wrap(err, "cannot list the activator EndpointSlices")
+	}
+
+	p.allocated = make(map[int32]k8stypes.UID, len(slices.Items))
+
+	for _, slice := range slices.Items {
+		owner := metav1.GetControllerOf(&slice)
+		if owner == nil || len(slice.Ports) == 0 || slice.Ports[0].Port == nil {
+			continue
+		}
+
+		p.allocated[*slice.Ports[0].Port] = owner.UID
+	}
+
+	return nil
+}
+
+// allocate returns the first activator port not used by other Tenant Control Planes.
+func (p *ActivatorPorts) allocate(ctx context.Context, c client.Client, tenantControlPlane *stewardv1alpha1.TenantControlPlane) (int32, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.allocated == nil {
+		if err := p.rebuild(ctx, c); err != nil {
+			return 0, err
+		}
+	}
+
+	var tcpList stewardv1alpha1.TenantControlPlaneList
+	if err := c.List(ctx, &tcpList); err != nil {
+		return 0, errors.Wrap(err, "cannot list the Tenant Control Planes")
+	}
+
+	used, existing := make(map[int32]k8stypes.UID), make(map[k8stypes.UID]struct{}, len(tcpList.Items))
+
+	for _, tcp := range tcpList.Items {
+		existing[tcp.GetUID()] = struct{}{}
+
+		if port := tcp.Status.Kubernetes.Service.ActivatorPort; port != 0 {
+			used[port] = tcp.GetUID()
+		}
+	}
+
+	for port, uid := range p.allocated {
+		if _, ok := existing[uid]; !ok {
+			delete(p.allocated, port)
+
+			continue
+		}
+
+		used[port] = uid
+	}
+
+	for offset := 0; offset < p.Range.Size; offset++ {
+		port := int32(p.Range.Base + offset) //nolint:gosec
+
+		if uid, ok := used[port]; ok && uid != tenantControlPlane.GetUID() {
+			continue
+		}
+
+		p.allocated[port] = tenantControlPlane.GetUID()
+
+		return port, nil
+	}
+
+	return 0, errors.Errorf("no activator ports available in the range %s", p.Range.String())
+}
+
+func (p *ActivatorPorts) release(port int32) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.allocated, port)
+}
+
+// KubernetesActivatorResource routes the Service of a sleeping Tenant Control Plane to the Steward activator,
+// which wakes it up upon the first connection: each Tenant Control Plane gets a dedicated activator port,
+// and the routing is kept until the woken up Tenant Control Plane is ready to serve the incoming connections.
+type KubernetesActivatorResource struct {
+	resource *discoveryv1.EndpointSlice
+	Client   client.Client
+	// Namespace and Service are referring to the Service of the Steward activator Pods.
+	Namespace string
+	Service   string
+	Ports     *ActivatorPorts
+
+	port int32
+}
+
+func (r *KubernetesActivatorResource) GetHistogram() prometheus.Histogram {
+	activatorCollector = LazyLoadHistogramFromResource(activatorCollector, r)
+
+	return activatorCollector
+}
+
+func (r *KubernetesActivatorResource) ShouldStatusBeUpdated(_ context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) bool {
+	return tenantControlPlane.Status.Kubernetes.Service.ActivatorPort != r.port
+}
+
+func (r *KubernetesActivatorResource) ShouldCleanup(tenantControlPlane *stewardv1alpha1.TenantControlPlane) bool {
+	if len(r.Service) == 0 || tenantControlPlane.Spec.Hibernation == nil || !tenantControlPlane.Spec.Hibernation.WakeOnRequest {
+		return true
+	}
+
+	if ptr.Deref(tenantControlPlane.Spec.ControlPlane.Deployment.Replicas, 2) == 0 {
+		return false
+	}
+	// The woken up Tenant Control Plane is still routed to the activator until its API Server is ready.
+	return tenantControlPlane.Status.Kubernetes.Service.ActivatorPort == 0 ||
+		ptr.Deref(tenantControlPlane.Status.Kubernetes.Version.Status, stewardv1alpha1.VersionUnknown) == stewardv1alpha1.VersionReady
+}
+
+func (r *KubernetesActivatorResource) CleanUp(ctx context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) (bool, error) {
+	if tenantControlPlane.Status.Kubernetes.Service.ActivatorPort == 0 {
+		return false, nil
+	}
+
+	if err := r.Client.Delete(ctx, r.resource); err != nil && !k8serrors.IsNotFound(err) {
+		return false, errors.Wrap(err, "cannot delete the activator EndpointSlice")
+	}
+
+	r.Ports.release(tenantControlPlane.Status.Kubernetes.Service.ActivatorPort)
+
+	r.port = 0
+
+	return true, nil
+}
+
+func (r *KubernetesActivatorResource) UpdateTenantControlPlaneStatus(_ context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) error {
+	tenantControlPlane.Status.Kubernetes.Service.ActivatorPort = r.port
+
+	return nil
+}
+
+func (r *KubernetesActivatorResource) Define(_ context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) error {
+	r.resource = &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-activator", tenantControlPlane.GetName()),
+			Namespace: tenantControlPlane.GetNamespace(),
+		},
+	}
+	r.port = tenantControlPlane.Status.Kubernetes.Service.ActivatorPort
+
+	return nil
+}
+
+func (r *KubernetesActivatorResource) CreateOrUpdate(ctx context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) (controllerutil.OperationResult, error) {
+	if r.port == 0 {
+		port, err := r.Ports.allocate(ctx, r.Client, tenantControlPlane)
+		if err != nil {
+			return controllerutil.OperationResultNone, err
+		}
+
+		r.port = port
+	}
+
+	var slices discoveryv1.EndpointSliceList
+	if err := r.Client.List(ctx, &slices, client.InNamespace(r.Namespace), client.MatchingLabels{discoveryv1.LabelServiceName: r.Service}); err != nil {
+		return controllerutil.OperationResultNone, errors.Wrap(err, "cannot list the activator EndpointSlices")
+	}
+
+	return utilities.CreateOrUpdateWithConflict(ctx, r.Client, r.resource, r.mutate(tenantControlPlane, slices.Items))
+}
+
+func (r *KubernetesActivatorResource) mutate(tenantControlPlane *stewardv1alpha1.TenantControlPlane, slices []discoveryv1.EndpointSlice) controllerutil.MutateFn {
+	return func() error {
+		r.resource.SetLabels(utilities.MergeMaps(
+			r.resource.GetLabels(),
+			utilities.StewardLabels(tenantControlPlane.GetName(), r.GetName()),
+			map[string]string{
+				discoveryv1.LabelServiceName: tenantControlPlane.GetName(),
+				discoveryv1.LabelManagedBy:   ActivatorManagedBy,
+			},
+		))
+		// The address type is immutable, and the activator Pods are expected to share the same IP family.
+		if len(r.resource.AddressType) == 0 {
+			r.resource.AddressType = discoveryv1.AddressTypeIPv4
+			if len(slices) > 0 {
+				r.resource.AddressType = slices[0].AddressType
+			}
+		}
+
+		endpoints := make([]discoveryv1.Endpoint, 0)
+
+		for _, slice := range slices {
+			if slice.AddressType != r.resource.AddressType {
+				continue
+			}
+
+			for _, endpoint := range slice.Endpoints {
+				if !ptr.Deref(endpoint.Conditions.Ready, true) {
+					continue
+				}
+
+				endpoints = append(endpoints, discoveryv1.Endpoint{
+					Addresses:  endpoint.Addresses,
+					Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(true)},
+					NodeName:   endpoint.NodeName,
+					Zone:       endpoint.Zone,
+				})
+			}
+		}
+
+		r.resource.Endpoints = endpoints
+		r.resource.Ports = []discoveryv1.EndpointPort{
+			{
+				Name:     ptr.To("kube-apiserver"),
+				Protocol: ptr.To(corev1.ProtocolTCP),
+				Port:     ptr.To(r.port),
+			},
+		}
+
+		return controllerutil.SetControllerReference(tenantControlPlane, r.resource, r.Client.Scheme())
+	}
+}
+
+func (r *KubernetesActivatorResource) GetName() string {
+	return "activator"
+}
@@ -0,0 +1,167 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package resources_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/internal/resources"
+)
+
+var _ = Describe("KubernetesActivatorResource", func() {
+	var (
+		ctx        context.Context
+		fakeClient client.Client
+		tcp        *stewardv1alpha1.TenantControlPlane
+		ports      *resources.ActivatorPorts
+		objects    []client.Object
+	)
+
+	handle := func(service string) error {
+		resource := &resources.KubernetesActivatorResource{
+			Client:    fakeClient,
+			Namespace: "steward-system",
+			Service:   service,
+			Ports:     ports,
+		}
+
+		if _, err := resources.Handle(ctx, resource, tcp); err != nil {
+			return err
+		}
+
+		return resource.UpdateTenantControlPlaneStatus(ctx, tcp)
+	}
+
+	getEndpointSlice := func() (*discoveryv1.EndpointSlice, error) {
+		slice := &discoveryv1.EndpointSlice{}
+
+		return slice, fakeClient.Get(ctx, k8stypes.NamespacedName{Namespace: tcp.Namespace, Name: tcp.Name + "-activator"}, slice)
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		tcp = &stewardv1alpha1.TenantControlPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "dev", Namespace: "default", UID: "dev-uid"},
+			Spec: stewardv1alpha1.TenantControlPlaneSpec{
+				Hibernation: &stewardv1alpha1.HibernationSpec{WakeOnRequest: true},
+				ControlPlane: stewardv1alpha1.ControlPlane{
+					Deployment: stewardv1alpha1.DeploymentSpec{Replicas: ptr.To(int32(0))},
+				},
+			},
+		}
+
+		other := &stewardv1alpha1.TenantControlPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default", UID: "test-uid"},
+			Status: stewardv1alpha1.TenantControlPlaneStatus{
+				Kubernetes: stewardv1alpha1.KubernetesStatus{
+					Service: stewardv1alpha1.KubernetesServiceStatus{ActivatorPort: 20000},
+				},
+			},
+		}
+
+		activator := &discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "steward-activator-abcde",
+				Namespace: "steward-system",
+				Labels:    map[string]string{discoveryv1.LabelServiceName: "steward-activator"},
+			},
+			AddressType: discoveryv1.AddressTypeIPv4,
+			Endpoints: []discoveryv1.Endpoint{
+				{Addresses: []string{"10.0.0.1"}, Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(true)}},
+				{Addresses: []string{"10.0.0.2"}, Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(false)}},
+			},
+		}
+
+		objects = []client.Object{tcp, other, activator}
+		ports = &resources.ActivatorPorts{Range: utilnet.PortRange{Base: 20000, Size: 10}}
+	})
+
+	JustBeforeEach(func() {
+		fakeClient = fake.NewClientBuilder().
+			WithScheme(runtimeScheme).
+			WithObjects(objects...).
+			WithStatusSubresource(&stewardv1alpha1.TenantControlPlane{}).
+			Build()
+	})
+
+	It("should route the sleeping Tenant Control Plane to the ready activator Pods on a free port", func() {
+		Expect(handle("steward-activator")).To(Succeed())
+		Expect(tcp.Status.Kubernetes.Service.ActivatorPort).To(Equal(int32(20001)))
+
+		slice, err := getEndpointSlice()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(slice.GetLabels()).To(HaveKeyWithValue(discoveryv1.LabelServiceName, "dev"))
+		Expect(slice.GetLabels()).To(HaveKeyWithValue(discoveryv1.LabelManagedBy, resources.ActivatorManagedBy))
+		Expect(slice.Endpoints).To(HaveLen(1))
+		Expect(slice.Endpoints[0].Addresses).To(ConsistOf("10.0.0.1"))
+		Expect(slice.Ports).To(HaveLen(1))
+		Expect(*slice.Ports[0].Port).To(Equal(int32(20001)))
+	})
+
+	It("should not reuse the ports routed before a restart and not persisted yet", func() {
+		routed := &stewardv1alpha1.TenantControlPlane{ObjectMeta: metav1.ObjectMeta{Name: "prod", Namespace: "default", UID: "prod-uid"}}
+		routedSlice := &discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "prod-activator",
+				Namespace: "default",
+				Labels:    map[string]string{discoveryv1.LabelManagedBy: resources.ActivatorManagedBy},
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: stewardv1alpha1.GroupVersion.String(),
+					Kind:       "TenantControlPlane",
+					Name:       "prod",
+					UID:        "prod-uid",
+					Controller: ptr.To(true),
+				}},
+			},
+			AddressType: discoveryv1.AddressTypeIPv4,
+			Ports:       []discoveryv1.EndpointPort{{Port: ptr.To(int32(20001))}},
+		}
+
+		fakeClient = fake.NewClientBuilder().
+			WithScheme(runtimeScheme).
+			WithObjects(append(objects, routed, routedSlice)...).
+			WithStatusSubresource(&stewardv1alpha1.TenantControlPlane{}).
+			Build()
+
+		Expect(handle("steward-activator")).To(Succeed())
+		Expect(tcp.Status.Kubernetes.Service.ActivatorPort).To(Equal(int32(20002)))
+	})
+
+	It("should keep the routing until the woken up Tenant Control Plane is ready", func() {
+		Expect(handle("steward-activator")).To(Succeed())
+
+		tcp.Spec.ControlPlane.Deployment.Replicas = ptr.To(int32(2))
+		tcp.Status.Kubernetes.Version.Status = ptr.To(stewardv1alpha1.VersionNotReady)
+		Expect(handle("steward-activator")).To(Succeed())
+		Expect(tcp.Status.Kubernetes.Service.ActivatorPort).To(Equal(int32(20001)))
+
+		tcp.Status.Kubernetes.Version.Status = ptr.To(stewardv1alpha1.VersionReady)
+		Expect(handle("steward-activator")).To(Succeed())
+		Expect(tcp.Status.Kubernetes.Service.ActivatorPort).To(BeZero())
+
+		_, err := getEndpointSlice()
+		Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should not route the Tenant Control Plane when the activator is not enabled", func() {
+		Expect(handle("")).To(Succeed())
+		Expect(tcp.Status.Kubernetes.Service.ActivatorPort).To(BeZero())
+
+		_, err := getEndpointSlice()
+		Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+	})
+})
@@ -38,7 +38,7 @@ func (r *KubernetesDeploymentResource) isStatusEqual(tenantControlPlane *steward
 
 func (r *KubernetesDeploymentResource) ShouldStatusBeUpdated(_ context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) bool {
 	return !r.isStatusEqual(tenantControlPlane) ||
-		tenantControlPlane.Spec.Kubernetes.Version != tenantControlPlane.Status.Kubernetes.Version.Version ||
+		tenantControlPlane.GetKubernetesVersion() != tenantControlPlane.Status.Kubernetes.Version.Version ||
 		*r.computeStatus(tenantControlPlane) != ptr.Deref(tenantControlPlane.Status.Kubernetes.Version.Status, stewardv1alpha1.VersionUnknown)
 }
 
@@ -88,7 +88,7 @@ func (r *KubernetesDeploymentResource) computeStatus(tenantControlPlane *steward
 		return &stewardv1alpha1.VersionSleeping
 	case r.isNotReady():
 		return &stewardv1alpha1.VersionNotReady
-	case tenantControlPlane.Spec.WritePermissions.HasAnyLimitation():
+	case r.isWriteLimited(tenantControlPlane):
 		return &stewardv1alpha1.VersionWriteLimited
 	case !r.isProgressingUpgrade():
 		return &stewardv1alpha1.VersionReady
@@ -105,7 +105,7 @@ func (r *KubernetesDeploymentResource) UpdateTenantControlPlaneStatus(_ context.
 	tenantControlPlane.Status.Kubernetes.Version.Status = r.computeStatus(tenantControlPlane)
 	if *tenantControlPlane.Status.Kubernetes.Version.Status == stewardv1alpha1.VersionReady ||
 		*tenantControlPlane.Status.Kubernetes.Version.Status == stewardv1alpha1.VersionSleeping {
-		tenantControlPlane.Status.Kubernetes.Version.Version = tenantControlPlane.Spec.Kubernetes.Version
+		tenantControlPlane.Status.Kubernetes.Version.Version = tenantControlPlane.GetKubernetesVersion()
 	}
 
 	tenantControlPlane.Status.Kubernetes.Deployment = stewardv1alpha1.KubernetesDeploymentStatus{
@@ -147,7 +147,7 @@ func (r *KubernetesDeploymentResource) isProgressingUpgrade() bool {
 
 func (r *KubernetesDeploymentResource) isUpgrading(tenantControlPlane *stewardv1alpha1.TenantControlPlane) bool {
 	return len(tenantControlPlane.Status.Kubernetes.Version.Version) > 0 &&
-		tenantControlPlane.Spec.Kubernetes.Version != tenantControlPlane.Status.Kubernetes.Version.Version &&
+		tenantControlPlane.GetKubernetesVersion() != tenantControlPlane.Status.Kubernetes.Version.Version &&
 		r.isProgressingUpgrade()
 }
 
@@ -158,3 +158,9 @@ func (r *KubernetesDeploymentResource) isProvisioning(tenantControlPlane *stewar
 func (r *KubernetesDeploymentResource) isNotReady() bool {
 	return r.resource.Status.ReadyReplicas == 0
 }
+
+func (r *KubernetesDeploymentResource) isWriteLimited(tenantControlPlane *stewardv1alpha1.TenantControlPlane) bool {
+	permissions := tenantControlPlane.GetWritePermissions()
+
+	return permissions.HasAnyLimitation()
+}
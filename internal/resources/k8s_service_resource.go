@@ -113,6 +113,10 @@ func (r *KubernetesServiceResource) mutate(ctx context.Context, tenantControlPla
 		r.resource.Spec.Selector = map[string]string{
 			"steward.butlerlabs.dev/name": tenantControlPlane.GetName(),
 		}
+		// While routed to the activator the endpoints are managed by Steward, rather than selecting the Tenant Control Plane Pods.
+		if tenantControlPlane.Status.Kubernetes.Service.ActivatorPort != 0 {
+			r.resource.Spec.Selector = nil
+		}
 
 		if r.resource.Spec.Ports == nil {
 			r.resource.Spec.Ports = make([]corev1.ServicePort, 1)
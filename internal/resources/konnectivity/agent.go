@@ -43,7 +43,7 @@ func (r *Agent) agentVersion(tcp *stewardv1alpha1.TenantControlPlane) string {
 		return tcp.Spec.Addons.Konnectivity.KonnectivityAgentSpec.Version
 	}
 
-	version, parsedErr := semver.ParseTolerant(tcp.Spec.Kubernetes.Version)
+	version, parsedErr := semver.ParseTolerant(tcp.GetKubernetesVersion())
 	if parsedErr != nil {
 		return ""
 	}
@@ -123,7 +123,7 @@ func (r *KubeadmConfigResource) mutate(ctx context.Context, tenantControlPlane *
 			TenantControlPlaneClusterDomain: tenantControlPlane.Spec.NetworkProfile.ClusterDomain,
 			TenantControlPlanePodCIDR:       tenantControlPlane.Spec.NetworkProfile.PodCIDR,
 			TenantControlPlaneServiceCIDR:   tenantControlPlane.Spec.NetworkProfile.ServiceCIDR,
-			TenantControlPlaneVersion:       tenantControlPlane.Spec.Kubernetes.Version,
+			TenantControlPlaneVersion:       tenantControlPlane.GetKubernetesVersion(),
 			ETCDs:                           r.ETCDs,
 			CertificatesDir:                 r.TmpDirectory,
 		}
@@ -6,9 +6,15 @@ package resources
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/version"
 	"k8s.io/kubernetes/cmd/kubeadm/app/phases/upgrade"
 	"k8s.io/utils/ptr"
@@ -24,6 +30,14 @@ import (
 type KubernetesUpgrade struct {
 	Client  client.Client
 	upgrade upgrade.Upgrade
+	// plan is the sequential upgrade path, nil when the desired version is reached directly.
+	plan *stewardv1alpha1.KubernetesUpgradeStatus
+	// skippedPreflights are the preflight checks skipped by the Tenant Control Plane annotation.
+	skippedPreflights sets.Set[stewardupgrade.PreflightCheck]
+	// lastKnownGood references the rendered control plane recorded when the upgrade is started.
+	lastKnownGood *stewardv1alpha1.KubernetesLastKnownGoodStatus
+	// rolledBack is the rollback pinning the last-known-good version, retained until the desired version changes.
+	rolledBack *stewardv1alpha1.KubernetesRollbackStatus
 
 	inProgress bool
 }
@@ -35,18 +49,71 @@ func (k *KubernetesUpgrade) GetHistogram() prometheus.Histogram {
 }
 
 func (k *KubernetesUpgrade) Define(_ context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) error {
+	plan, err := k.planUpgrade(tenantControlPlane)
+	if err != nil {
+		return err
+	}
+
+	k.plan = plan
+
+	after := tenantControlPlane.Spec.Kubernetes.Version
+	if plan != nil {
+		after = plan.Current
+	}
+
+	k.rolledBack = tenantControlPlane.Status.Kubernetes.Version.RolledBack
+	if k.rolledBack != nil {
+		switch k.rolledBack.FailedVersion {
+		case after:
+			after = k.rolledBack.Version
+		default:
+			k.rolledBack = nil
+		}
+	}
+
 	k.upgrade = upgrade.Upgrade{
 		Before: upgrade.ClusterState{
 			KubeVersion: tenantControlPlane.Status.Kubernetes.Version.Version,
 		},
 		After: upgrade.ClusterState{
-			KubeVersion: tenantControlPlane.Spec.Kubernetes.Version,
+			KubeVersion: after,
 		},
 	}
 
 	return nil
 }
 
+// planUpgrade computes the path of a sequential upgrade, moving to its next version once the current one is running:
+// the running version is reported only once the Tenant Control Plane Deployment has been rolled out.
+func (k *KubernetesUpgrade) planUpgrade(tenantControlPlane *stewardv1alpha1.TenantControlPlane) (*stewardv1alpha1.KubernetesUpgradeStatus, error) {
+	running, target := tenantControlPlane.Status.Kubernetes.Version.Version, tenantControlPlane.Spec.Kubernetes.Version
+
+	strategy := tenantControlPlane.Spec.Kubernetes.UpgradeStrategy
+	if strategy == nil || strategy.Type != stewardv1alpha1.UpgradeStrategySequential || running == "" || running == target {
+		return nil, nil //nolint:nilnil
+	}
+
+	plan := tenantControlPlane.Status.Kubernetes.Version.Upgrade.DeepCopy()
+	if plan == nil || plan.Target != target {
+		path, err := stewardupgrade.SequentialPath(running, target, strategy.IntermediateVersions)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot plan the sequential upgrade")
+		}
+
+		return &stewardv1alpha1.KubernetesUpgradeStatus{Target: target, Path: path, Current: path[0]}, nil
+	}
+
+	if running != plan.Current || ptr.Deref(tenantControlPlane.Status.Kubernetes.Version.Status, stewardv1alpha1.VersionUnknown) != stewardv1alpha1.VersionReady {
+		return plan, nil
+	}
+
+	if next := slices.Index(plan.Path, plan.Current) + 1; next < len(plan.Path) {
+		plan.Current = plan.Path[next]
+	}
+
+	return plan, nil
+}
+
 func (k *KubernetesUpgrade) ShouldCleanup(*stewardv1alpha1.TenantControlPlane) bool {
 	return false
 }
@@ -63,11 +130,20 @@ func (k *KubernetesUpgrade) CreateOrUpdate(ctx context.Context, tenantControlPla
 		return controllerutil.OperationResultNone, nil
 	}
 	// No version change, no need to upgrade
-	if tenantControlPlane.Status.Kubernetes.Version.Version == tenantControlPlane.Spec.Kubernetes.Version {
+	if tenantControlPlane.Status.Kubernetes.Version.Version == k.upgrade.After.KubeVersion {
 		k.inProgress = false
 
 		return controllerutil.OperationResultNone, nil
 	}
+	// The upgrade did not make progress within the rollback deadline, reverting to the last-known-good version
+	rollback, err := k.shouldRollback(ctx, tenantControlPlane)
+	if err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+
+	if rollback {
+		return k.rollback(ctx, tenantControlPlane)
+	}
 	// An upgrade is in progress, let it go
 	if status := tenantControlPlane.Status.Kubernetes.Version.Status; status != nil && *status == stewardv1alpha1.VersionUpgrading {
 		return controllerutil.OperationResultNone, nil
@@ -92,8 +168,19 @@ func (k *KubernetesUpgrade) CreateOrUpdate(ctx context.Context, tenantControlPla
 	if err = k.isUpgradable(); err != nil {
 		return controllerutil.OperationResultNone, fmt.Errorf("the required upgrade plan is not available")
 	}
+	// The preflight checks require the tenant API Server, which is not running while sleeping.
+	k.skippedPreflights = stewardupgrade.ParseSkippedPreflights(tenantControlPlane.GetAnnotations()[stewardv1alpha1.SkipUpgradePreflightsAnnotation])
+	if status := tenantControlPlane.Status.Kubernetes.Version.Status; status == nil || *status != stewardv1alpha1.VersionSleeping {
+		if err = stewardupgrade.RunPreflights(ctx, clientSet, versionGetter, k.upgrade.After.KubeVersion, k.skippedPreflights); err != nil {
+			return controllerutil.OperationResultNone, err
+		}
+	}
 
 	if ptr.Deref(tenantControlPlane.Spec.ControlPlane.Deployment.Replicas, 0) > 0 {
+		if err = k.recordLastKnownGood(ctx, tenantControlPlane); err != nil {
+			return controllerutil.OperationResultNone, err
+		}
+
 		k.inProgress = true
 	}
 
@@ -104,13 +191,21 @@ func (k *KubernetesUpgrade) GetName() string {
 	return "upgrade"
 }
 
-func (k *KubernetesUpgrade) ShouldStatusBeUpdated(context.Context, *stewardv1alpha1.TenantControlPlane) bool {
-	return k.inProgress
+func (k *KubernetesUpgrade) ShouldStatusBeUpdated(_ context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) bool {
+	return k.inProgress ||
+		!equality.Semantic.DeepEqual(tenantControlPlane.Status.Kubernetes.Version.Upgrade, k.plan) ||
+		!equality.Semantic.DeepEqual(tenantControlPlane.Status.Kubernetes.Version.RolledBack, k.rolledBack)
 }
 
 func (k *KubernetesUpgrade) UpdateTenantControlPlaneStatus(_ context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) error {
+	tenantControlPlane.Status.Kubernetes.Version.Upgrade = k.plan
+	tenantControlPlane.Status.Kubernetes.Version.RolledBack = k.rolledBack
+
 	if k.inProgress {
 		tenantControlPlane.Status.Kubernetes.Version.Status = &stewardv1alpha1.VersionUpgrading
+		tenantControlPlane.Status.Kubernetes.Version.LastKnownGood = k.lastKnownGood
+
+		meta.SetStatusCondition(&tenantControlPlane.Status.Conditions, k.preflightsCondition(tenantControlPlane))
 	}
 
 	if tenantControlPlane.Spec.Kubernetes.Version == tenantControlPlane.Status.Kubernetes.Version.Version {
@@ -120,6 +215,32 @@ func (k *KubernetesUpgrade) UpdateTenantControlPlaneStatus(_ context.Context, te
 	return nil
 }
 
+// preflightsCondition reports the preflight checks passed by the upgrade being started, mentioning the skipped ones:
+// the failed checks are reported by the Tenant Control Plane controller, since they block the reconciliation.
+func (k *KubernetesUpgrade) preflightsCondition(tenantControlPlane *stewardv1alpha1.TenantControlPlane) metav1.Condition {
+	condition := metav1.Condition{
+		Type:               stewardv1alpha1.UpgradePreflightsPassedCondition,
+		Status:             metav1.ConditionTrue,
+		Reason:             stewardv1alpha1.PreflightsPassedReason,
+		Message:            fmt.Sprintf("the preflight checks for the upgrade to %s passed", k.upgrade.After.KubeVersion),
+		ObservedGeneration: tenantControlPlane.Generation,
+	}
+
+	if k.skippedPreflights.Len() > 0 {
+		skipped := make([]string, 0, k.skippedPreflights.Len())
+		for _, check := range stewardupgrade.PreflightChecks {
+			if k.skippedPreflights.Has(check) {
+				skipped = append(skipped, string(check))
+			}
+		}
+
+		condition.Reason = stewardv1alpha1.PreflightsSkippedReason
+		condition.Message += fmt.Sprintf(", skipping %s", strings.Join(skipped, ", "))
+	}
+
+	return condition
+}
+
 func (k *KubernetesUpgrade) isUpgradable() error {
 	newK8sVersion, err := version.ParseSemantic(k.upgrade.After.KubeVersion)
 	if err != nil {
@@ -0,0 +1,187 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package resources
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/internal/utilities"
+)
+
+const (
+	// LastKnownGoodDeploymentKey is the key of the last-known-good Secret storing the control plane Deployment spec.
+	LastKnownGoodDeploymentKey = "deployment"
+	// LastKnownGoodKubeadmConfigKey is the key of the last-known-good Secret storing the kubeadm configuration.
+	LastKnownGoodKubeadmConfigKey = "kubeadmconfig"
+	// deploymentProgressDeadlineExceeded is the reason of the Progressing condition set by the Deployment controller
+	// when the rollout does not make progress within the progress deadline.
+	deploymentProgressDeadlineExceeded = "ProgressDeadlineExceeded"
+)
+
+// recordLastKnownGood stores the control plane Deployment spec, and the kubeadm configuration, rendered
+// for the running version before they're updated by the upgrade: nothing is recorded with no rollback deadline.
+func (k *KubernetesUpgrade) recordLastKnownGood(ctx context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) error {
+	k.lastKnownGood = nil
+
+	if strategy := tenantControlPlane.Spec.Kubernetes.UpgradeStrategy; strategy == nil || strategy.RollbackDeadline == nil {
+		return nil
+	}
+
+	var deployment appsv1.Deployment
+	if err := k.Client.Get(ctx, k8stypes.NamespacedName{Namespace: tenantControlPlane.GetNamespace(), Name: tenantControlPlane.GetName()}, &deployment); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+
+		return errors.Wrap(err, "cannot retrieve the control plane Deployment")
+	}
+
+	var kubeadmConfig corev1.ConfigMap
+	if err := k.Client.Get(ctx, k8stypes.NamespacedName{Namespace: tenantControlPlane.GetNamespace(), Name: tenantControlPlane.Status.KubeadmConfig.ConfigmapName}, &kubeadmConfig); err != nil {
+		return errors.Wrap(err, "cannot retrieve the kubeadm configuration")
+	}
+
+	deploymentSpec, err := json.Marshal(deployment.Spec)
+	if err != nil {
+		return errors.Wrap(err, "cannot encode the control plane Deployment spec")
+	}
+
+	kubeadmConfigData, err := json.Marshal(kubeadmConfig.Data)
+	if err != nil {
+		return errors.Wrap(err, "cannot encode the kubeadm configuration")
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      utilities.AddTenantPrefix("last-known-good", tenantControlPlane),
+			Namespace: tenantControlPlane.GetNamespace(),
+		},
+	}
+
+	if _, err = utilities.CreateOrUpdateWithConflict(ctx, k.Client, secret, func() error {
+		secret.SetLabels(utilities.StewardLabels(tenantControlPlane.GetName(), "last-known-good"))
+		secret.Data = map[string][]byte{
+			LastKnownGoodDeploymentKey:    deploymentSpec,
+			LastKnownGoodKubeadmConfigKey: kubeadmConfigData,
+		}
+
+		return controllerutil.SetControllerReference(tenantControlPlane, secret, k.Client.Scheme())
+	}); err != nil {
+		return errors.Wrap(err, "cannot record the last-known-good control plane")
+	}
+
+	k.lastKnownGood = &stewardv1alpha1.KubernetesLastKnownGoodStatus{
+		Version:    tenantControlPlane.Status.Kubernetes.Version.Version,
+		SecretName: secret.GetName(),
+		RecordedAt: metav1.Now(),
+	}
+
+	return nil
+}
+
+// shouldRollback returns true when the control plane Deployment exceeded its progress deadline, set to the rollback one,
+// while rolling out the upgrade started from the recorded last-known-good version.
+func (k *KubernetesUpgrade) shouldRollback(ctx context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) (bool, error) {
+	lastKnownGood := tenantControlPlane.Status.Kubernetes.Version.LastKnownGood
+
+	if strategy := tenantControlPlane.Spec.Kubernetes.UpgradeStrategy; strategy == nil || strategy.RollbackDeadline == nil {
+		return false, nil
+	}
+
+	if lastKnownGood == nil || lastKnownGood.Version != tenantControlPlane.Status.Kubernetes.Version.Version {
+		return false, nil
+	}
+
+	var deployment appsv1.Deployment
+	if err := k.Client.Get(ctx, k8stypes.NamespacedName{Namespace: tenantControlPlane.GetNamespace(), Name: tenantControlPlane.GetName()}, &deployment); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return false, nil
+		}
+
+		return false, errors.Wrap(err, "cannot retrieve the control plane Deployment")
+	}
+
+	for _, condition := range deployment.Status.Conditions {
+		if condition.Type != appsv1.DeploymentProgressing || condition.Status != corev1.ConditionFalse || condition.Reason != deploymentProgressDeadlineExceeded {
+			continue
+		}
+		// Ignoring a deadline exceeded before the upgrade started.
+		return !condition.LastUpdateTime.Before(&lastKnownGood.RecordedAt), nil
+	}
+
+	return false, nil
+}
+
+// rollback restores the last-known-good control plane Deployment spec, and kubeadm configuration, pinning its version:
+// the data is still compatible, since the storage version migration is not performed until the upgrade is completed.
+func (k *KubernetesUpgrade) rollback(ctx context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) (controllerutil.OperationResult, error) {
+	lastKnownGood := tenantControlPlane.Status.Kubernetes.Version.LastKnownGood
+
+	var secret corev1.Secret
+	if err := k.Client.Get(ctx, k8stypes.NamespacedName{Namespace: tenantControlPlane.GetNamespace(), Name: lastKnownGood.SecretName}, &secret); err != nil {
+		return controllerutil.OperationResultNone, errors.Wrap(err, "cannot retrieve the last-known-good control plane")
+	}
+
+	var deploymentSpec appsv1.DeploymentSpec
+	if err := json.Unmarshal(secret.Data[LastKnownGoodDeploymentKey], &deploymentSpec); err != nil {
+		return controllerutil.OperationResultNone, errors.Wrap(err, "cannot decode the last-known-good control plane Deployment spec")
+	}
+
+	var kubeadmConfigData map[string]string
+	if err := json.Unmarshal(secret.Data[LastKnownGoodKubeadmConfigKey], &kubeadmConfigData); err != nil {
+		return controllerutil.OperationResultNone, errors.Wrap(err, "cannot decode the last-known-good kubeadm configuration")
+	}
+
+	kubeadmConfig := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      tenantControlPlane.Status.KubeadmConfig.ConfigmapName,
+			Namespace: tenantControlPlane.GetNamespace(),
+		},
+	}
+
+	if _, err := utilities.CreateOrUpdateWithConflict(ctx, k.Client, kubeadmConfig, func() error {
+		kubeadmConfig.Data = kubeadmConfigData
+
+		return nil
+	}); err != nil {
+		return controllerutil.OperationResultNone, errors.Wrap(err, "cannot restore the last-known-good kubeadm configuration")
+	}
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      tenantControlPlane.GetName(),
+			Namespace: tenantControlPlane.GetNamespace(),
+		},
+	}
+
+	if _, err := utilities.CreateOrUpdateWithConflict(ctx, k.Client, deployment, func() error {
+		// The replicas are retained, since the Tenant Control Plane could have been scaled meanwhile.
+		replicas := deployment.Spec.Replicas
+		deployment.Spec = deploymentSpec
+		deployment.Spec.Replicas = replicas
+
+		return nil
+	}); err != nil {
+		return controllerutil.OperationResultNone, errors.Wrap(err, "cannot restore the last-known-good control plane Deployment")
+	}
+
+	k.rolledBack = &stewardv1alpha1.KubernetesRollbackStatus{
+		FailedVersion: k.upgrade.After.KubeVersion,
+		Version:       lastKnownGood.Version,
+		RolledBackAt:  metav1.Now(),
+	}
+	k.inProgress = false
+
+	return controllerutil.OperationResultUpdated, nil
+}
@@ -0,0 +1,255 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package resources_test
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/internal/resources"
+)
+
+var _ = Describe("KubernetesUpgrade", func() {
+	var (
+		ctx context.Context
+		tcp *stewardv1alpha1.TenantControlPlane
+	)
+
+	plan := func() {
+		resource := &resources.KubernetesUpgrade{}
+
+		Expect(resource.Define(ctx, tcp)).To(Succeed())
+		Expect(resource.UpdateTenantControlPlaneStatus(ctx, tcp)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		tcp = &stewardv1alpha1.TenantControlPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "dev", Namespace: "default"},
+			Spec: stewardv1alpha1.TenantControlPlaneSpec{
+				Kubernetes: stewardv1alpha1.KubernetesSpec{
+					Version:         "v1.34.1",
+					UpgradeStrategy: &stewardv1alpha1.UpgradeStrategy{Type: stewardv1alpha1.UpgradeStrategySequential},
+				},
+			},
+		}
+		tcp.Status.Kubernetes.Version = stewardv1alpha1.KubernetesVersion{
+			Version: "v1.31.4",
+			Status:  ptr.To(stewardv1alpha1.VersionReady),
+		}
+	})
+
+	It("should plan the upgrade through each intermediate minor release", func() {
+		plan()
+
+		Expect(tcp.Status.Kubernetes.Version.Upgrade).To(Equal(&stewardv1alpha1.KubernetesUpgradeStatus{
+			Target:  "v1.34.1",
+			Path:    []string{"v1.32.0", "v1.33.0", "v1.34.1"},
+			Current: "v1.32.0",
+		}))
+		Expect(tcp.GetKubernetesVersion()).To(Equal("v1.32.0"))
+	})
+
+	It("should use the pinned intermediate versions", func() {
+		tcp.Spec.Kubernetes.UpgradeStrategy.IntermediateVersions = []string{"v1.33.6"}
+
+		plan()
+
+		Expect(tcp.Status.Kubernetes.Version.Upgrade.Path).To(Equal([]string{"v1.32.0", "v1.33.6", "v1.34.1"}))
+	})
+
+	It("should ignore the pinned versions already passed when the target changes", func() {
+		tcp.Spec.Kubernetes.UpgradeStrategy.IntermediateVersions = []string{"v1.32.9"}
+		plan()
+
+		tcp.Status.Kubernetes.Version.Version = "v1.33.0"
+		tcp.Spec.Kubernetes.Version = "v1.35.0"
+		plan()
+
+		Expect(tcp.Status.Kubernetes.Version.Upgrade.Path).To(Equal([]string{"v1.34.0", "v1.35.0"}))
+	})
+
+	It("should move to the next version once the current one is ready", func() {
+		plan()
+
+		tcp.Status.Kubernetes.Version.Version, tcp.Status.Kubernetes.Version.Status = "v1.32.0", ptr.To(stewardv1alpha1.VersionUpgrading)
+		plan()
+		Expect(tcp.GetKubernetesVersion()).To(Equal("v1.32.0"))
+
+		tcp.Status.Kubernetes.Version.Status = ptr.To(stewardv1alpha1.VersionReady)
+		plan()
+		Expect(tcp.GetKubernetesVersion()).To(Equal("v1.33.0"))
+	})
+
+	It("should clear the plan once the target version is running", func() {
+		plan()
+
+		tcp.Status.Kubernetes.Version.Version = "v1.34.1"
+		plan()
+
+		Expect(tcp.Status.Kubernetes.Version.Upgrade).To(BeNil())
+		Expect(tcp.GetKubernetesVersion()).To(Equal("v1.34.1"))
+	})
+
+	It("should release the rolled back version once the desired one changes", func() {
+		tcp.Status.Kubernetes.Version.RolledBack = &stewardv1alpha1.KubernetesRollbackStatus{FailedVersion: "v1.32.0", Version: "v1.31.4"}
+
+		plan()
+		Expect(tcp.GetKubernetesVersion()).To(Equal("v1.31.4"))
+
+		tcp.Spec.Kubernetes.Version = "v1.32.1"
+		tcp.Status.Kubernetes.Version.Upgrade = nil
+
+		plan()
+		Expect(tcp.Status.Kubernetes.Version.RolledBack).To(BeNil())
+		Expect(tcp.GetKubernetesVersion()).To(Equal("v1.32.1"))
+	})
+
+	It("should not plan the upgrade with the Direct strategy", func() {
+		tcp.Spec.Kubernetes.UpgradeStrategy = nil
+
+		plan()
+
+		Expect(tcp.Status.Kubernetes.Version.Upgrade).To(BeNil())
+		Expect(tcp.GetKubernetesVersion()).To(Equal("v1.34.1"))
+	})
+})
+
+var _ = Describe("KubernetesUpgrade rollback", func() {
+	var (
+		ctx        context.Context
+		fakeClient client.Client
+		tcp        *stewardv1alpha1.TenantControlPlane
+		deployment *appsv1.Deployment
+	)
+
+	deploymentSpec := func(version string) appsv1.DeploymentSpec {
+		return appsv1.DeploymentSpec{
+			Replicas: ptr.To(int32(2)),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "kube-apiserver", Image: "registry.k8s.io/kube-apiserver:" + version}},
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		tcp = &stewardv1alpha1.TenantControlPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "dev", Namespace: "default", UID: "dev-uid"},
+			Spec: stewardv1alpha1.TenantControlPlaneSpec{
+				Kubernetes: stewardv1alpha1.KubernetesSpec{
+					Version:         "v1.32.0",
+					UpgradeStrategy: &stewardv1alpha1.UpgradeStrategy{RollbackDeadline: &metav1.Duration{Duration: 5 * time.Minute}},
+				},
+			},
+		}
+		tcp.Status.KubeadmConfig.ConfigmapName = "dev-kubeadmconfig"
+		tcp.Status.Kubernetes.Version = stewardv1alpha1.KubernetesVersion{
+			Version: "v1.31.4",
+			Status:  ptr.To(stewardv1alpha1.VersionUpgrading),
+			LastKnownGood: &stewardv1alpha1.KubernetesLastKnownGoodStatus{
+				Version:    "v1.31.4",
+				SecretName: "dev-last-known-good",
+				RecordedAt: metav1.NewTime(time.Now().Add(-10 * time.Minute)),
+			},
+		}
+
+		lastKnownGoodDeployment, err := json.Marshal(deploymentSpec("v1.31.4"))
+		Expect(err).ToNot(HaveOccurred())
+
+		lastKnownGoodKubeadmConfig, err := json.Marshal(map[string]string{"kubernetesVersion": "v1.31.4"})
+		Expect(err).ToNot(HaveOccurred())
+
+		deployment = &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "dev", Namespace: "default"},
+			Spec:       deploymentSpec("v1.32.0"),
+			Status: appsv1.DeploymentStatus{
+				Conditions: []appsv1.DeploymentCondition{{
+					Type:           appsv1.DeploymentProgressing,
+					Status:         corev1.ConditionFalse,
+					Reason:         "ProgressDeadlineExceeded",
+					LastUpdateTime: metav1.Now(),
+				}},
+			},
+		}
+		// The Tenant Control Plane has been scaled meanwhile.
+		deployment.Spec.Replicas = ptr.To(int32(3))
+
+		fakeClient = fake.NewClientBuilder().WithScheme(runtimeScheme).WithObjects(
+			deployment,
+			&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "dev-kubeadmconfig", Namespace: "default"},
+				Data:       map[string]string{"kubernetesVersion": "v1.32.0"},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "dev-last-known-good", Namespace: "default"},
+				Data: map[string][]byte{
+					resources.LastKnownGoodDeploymentKey:    lastKnownGoodDeployment,
+					resources.LastKnownGoodKubeadmConfigKey: lastKnownGoodKubeadmConfig,
+				},
+			},
+		).Build()
+	})
+
+	handle := func() controllerutil.OperationResult {
+		resource := &resources.KubernetesUpgrade{Client: fakeClient}
+
+		result, err := resources.Handle(ctx, resource, tcp)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resource.UpdateTenantControlPlaneStatus(ctx, tcp)).To(Succeed())
+
+		return result
+	}
+
+	It("should revert to the last-known-good control plane once the rollback deadline is exceeded", func() {
+		Expect(handle()).To(Equal(controllerutil.OperationResultUpdated))
+
+		Expect(tcp.Status.Kubernetes.Version.RolledBack).ToNot(BeNil())
+		Expect(tcp.Status.Kubernetes.Version.RolledBack.FailedVersion).To(Equal("v1.32.0"))
+		Expect(tcp.Status.Kubernetes.Version.RolledBack.Version).To(Equal("v1.31.4"))
+		Expect(tcp.GetKubernetesVersion()).To(Equal("v1.31.4"))
+
+		Expect(fakeClient.Get(ctx, k8stypes.NamespacedName{Namespace: "default", Name: "dev"}, deployment)).To(Succeed())
+		Expect(deployment.Spec.Template.Spec.Containers[0].Image).To(Equal("registry.k8s.io/kube-apiserver:v1.31.4"))
+		Expect(deployment.Spec.Replicas).To(Equal(ptr.To(int32(3))))
+
+		configMap := &corev1.ConfigMap{}
+		Expect(fakeClient.Get(ctx, k8stypes.NamespacedName{Namespace: "default", Name: "dev-kubeadmconfig"}, configMap)).To(Succeed())
+		Expect(configMap.Data).To(HaveKeyWithValue("kubernetesVersion", "v1.31.4"))
+		// The failed version is not rolled out again.
+		Expect(handle()).To(Equal(controllerutil.OperationResultNone))
+		Expect(tcp.GetKubernetesVersion()).To(Equal("v1.31.4"))
+	})
+
+	It("should ignore a deadline exceeded before the upgrade started", func() {
+		tcp.Status.Kubernetes.Version.LastKnownGood.RecordedAt = metav1.NewTime(time.Now().Add(time.Minute))
+
+		Expect(handle()).To(Equal(controllerutil.OperationResultNone))
+		Expect(tcp.Status.Kubernetes.Version.RolledBack).To(BeNil())
+	})
+
+	It("should not roll back with no rollback deadline", func() {
+		tcp.Spec.Kubernetes.UpgradeStrategy = nil
+
+		Expect(handle()).To(Equal(controllerutil.OperationResultNone))
+		Expect(tcp.Status.Kubernetes.Version.RolledBack).To(BeNil())
+	})
+})
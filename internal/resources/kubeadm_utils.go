@@ -45,7 +45,7 @@ func GetKubeadmManifestDeps(ctx context.Context, client client.Client, tenantCon
 	config.Parameters = kubeadm.Parameters{
 		TenantControlPlaneName:         tenantControlPlane.GetName(),
 		TenantDNSServiceIPs:            tenantControlPlane.Spec.NetworkProfile.DNSServiceIPs,
-		TenantControlPlaneVersion:      tenantControlPlane.Spec.Kubernetes.Version,
+		TenantControlPlaneVersion:      tenantControlPlane.GetKubernetesVersion(),
 		TenantControlPlanePodCIDR:      tenantControlPlane.Spec.NetworkProfile.PodCIDR,
 		TenantControlPlaneAddress:      address,
 		TenantControlPlaneCertSANs:     tenantControlPlane.Spec.NetworkProfile.CertSANs,
@@ -77,7 +77,7 @@ func GetKubeadmManifestDeps(ctx context.Context, client client.Client, tenantCon
 		if len(kubeProxy.ImageTag) > 0 {
 			config.Parameters.KubeProxyOptions.Tag = kubeProxy.ImageTag
 		} else {
-			config.Parameters.KubeProxyOptions.Tag = tenantControlPlane.Spec.Kubernetes.Version
+			config.Parameters.KubeProxyOptions.Tag = tenantControlPlane.GetKubernetesVersion()
 		}
 	}
 
@@ -201,7 +201,7 @@ func KubeadmPhaseCreate(ctx context.Context, r KubeadmPhaseResource, logger logr
 	config.Parameters = kubeadm.Parameters{
 		TenantControlPlaneName:         tenantControlPlane.GetName(),
 		TenantDNSServiceIPs:            tenantControlPlane.Spec.NetworkProfile.DNSServiceIPs,
-		TenantControlPlaneVersion:      tenantControlPlane.Spec.Kubernetes.Version,
+		TenantControlPlaneVersion:      tenantControlPlane.GetKubernetesVersion(),
 		TenantControlPlanePodCIDR:      tenantControlPlane.Spec.NetworkProfile.PodCIDR,
 		TenantControlPlaneAddress:      address,
 		TenantControlPlaneCertSANs:     tenantControlPlane.Spec.NetworkProfile.CertSANs,
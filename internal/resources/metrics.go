@@ -9,19 +9,25 @@ import (
 )
 
 var (
-	apiservercertificateCollector      prometheus.Histogram
-	clientcertificateCollector         prometheus.Histogram
-	certificateauthorityCollector      prometheus.Histogram
-	frontproxycertificateCollector     prometheus.Histogram
-	frontproxycaCollector              prometheus.Histogram
-	deploymentCollector                prometheus.Histogram
-	ingressCollector                   prometheus.Histogram
-	gatewayCollector                   prometheus.Histogram
-	serviceCollector                   prometheus.Histogram
-	kubeadmconfigCollector             prometheus.Histogram
-	kubeadmupgradeCollector            prometheus.Histogram
-	kubeconfigCollector                prometheus.Histogram
-	serviceaccountcertificateCollector prometheus.Histogram
+	apiservercertificateCollector        prometheus.Histogram
+	clientcertificateCollector           prometheus.Histogram
+	certificateauthorityCollector        prometheus.Histogram
+	frontproxycertificateCollector       prometheus.Histogram
+	frontproxycaCollector                prometheus.Histogram
+	deploymentCollector                  prometheus.Histogram
+	encryptionconfigurationCollector     prometheus.Histogram
+	auditpolicyCollector                 prometheus.Histogram
+	authenticationconfigurationCollector prometheus.Histogram
+	authorizationconfigurationCollector  prometheus.Histogram
+	ingressCollector                     prometheus.Histogram
+	gatewayCollector                     prometheus.Histogram
+	serviceCollector                     prometheus.Histogram
+	activatorCollector                   prometheus.Histogram
+	kubeadmconfigCollector               prometheus.Histogram
+	kubeadmupgradeCollector              prometheus.Histogram
+	kubeconfigCollector                  prometheus.Histogram
+	serviceaccountcertificateCollector   prometheus.Histogram
+	storageversionmigrationCollector     prometheus.Histogram
 
 	kubeadmphaseUploadConfigKubeadmCollector prometheus.Histogram
 	kubeadmphaseUploadConfigKubeletCollector prometheus.Histogram
@@ -0,0 +1,205 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package resources
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	stewardupgrade "github.com/butlerdotdev/steward/internal/upgrade"
+	"github.com/butlerdotdev/steward/internal/utilities"
+)
+
+// StorageVersionMigration rewrites the objects of the tenant cluster through its API, to store them in the DataStore
+// encoded with the current storage version: the following releases could stop serving the former versions.
+// A page of objects is migrated per reconciliation, tracking the progress in the Tenant Control Plane status.
+type StorageVersionMigration struct {
+	Client client.Client
+
+	status *stewardv1alpha1.StorageVersionMigrationStatus
+}
+
+func (r *StorageVersionMigration) GetHistogram() prometheus.Histogram {
+	storageversionmigrationCollector = LazyLoadHistogramFromResource(storageversionmigrationCollector, r)
+
+	return storageversionmigrationCollector
+}
+
+func (r *StorageVersionMigration) Define(_ context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) error {
+	r.status = tenantControlPlane.Status.StorageVersionMigration.DeepCopy()
+
+	return nil
+}
+
+func (r *StorageVersionMigration) ShouldCleanup(*stewardv1alpha1.TenantControlPlane) bool {
+	return false
+}
+
+func (r *StorageVersionMigration) CleanUp(context.Context, *stewardv1alpha1.TenantControlPlane) (bool, error) {
+	return false, nil
+}
+
+func (r *StorageVersionMigration) CreateOrUpdate(ctx context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) (controllerutil.OperationResult, error) {
+	// The objects are migrated once the upgrade is completed: until then, a rollback requires the former storage versions.
+	if ptr.Deref(tenantControlPlane.Status.Kubernetes.Version.Status, stewardv1alpha1.VersionUnknown) != stewardv1alpha1.VersionReady {
+		return controllerutil.OperationResultNone, nil
+	}
+
+	config, err := utilities.GetRESTClientConfig(ctx, r.Client, tenantControlPlane)
+	if err != nil {
+		return controllerutil.OperationResultNone, errors.Wrap(err, "cannot create the tenant REST client configuration")
+	}
+
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return controllerutil.OperationResultNone, errors.Wrap(err, "cannot create the tenant discovery client")
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return controllerutil.OperationResultNone, errors.Wrap(err, "cannot create the tenant dynamic client")
+	}
+
+	storageResources, err := stewardupgrade.DiscoverStorageResources(discoveryClient)
+	if err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+
+	if r.status == nil {
+		r.status = &stewardv1alpha1.StorageVersionMigrationStatus{}
+	}
+
+	if r.status.Phase != stewardv1alpha1.StorageVersionMigrationPhaseMigrating {
+		value, requested := tenantControlPlane.GetAnnotations()[stewardv1alpha1.StorageVersionMigrationRequestAnnotation]
+
+		switch changed := stewardupgrade.ChangedStorageVersions(storageResources, r.status.StorageVersionHashes); {
+		case requested && value == "":
+			r.start(stewardv1alpha1.StorageVersionMigrationTriggerRequested, slices.Sorted(maps.Keys(storageResources)))
+		case len(changed) > 0:
+			r.start(stewardv1alpha1.StorageVersionMigrationTriggerStorageVersionChanged, changed)
+		default:
+			// Nothing to migrate: the first discovery, and the new resources, are recorded as they're stored.
+			r.recordNewHashes(storageResources)
+
+			return controllerutil.OperationResultNone, nil
+		}
+	}
+
+	if pending := r.pendingResource(); pending != "" {
+		// A resource which is not served anymore has no objects to migrate.
+		if resource, ok := storageResources[pending]; ok {
+			_, migrated, migrateErr := utilities.RewritePage(ctx, dynamicClient, resource.GroupVersionResource, &r.status.Continue)
+			if migrateErr != nil {
+				return controllerutil.OperationResultNone, migrateErr
+			}
+			// The next page is migrated in the next reconciliation, without holding the current one.
+			if !migrated {
+				return controllerutil.OperationResultUpdated, nil
+			}
+
+			r.status.StorageVersionHashes[pending] = resource.StorageVersionHash
+		}
+
+		r.status.Continue = ""
+		r.status.MigratedResources = append(r.status.MigratedResources, pending)
+	}
+
+	if r.pendingResource() == "" {
+		if err = r.complete(ctx, tenantControlPlane, storageResources); err != nil {
+			return controllerutil.OperationResultNone, err
+		}
+	}
+
+	return controllerutil.OperationResultUpdated, nil
+}
+
+func (r *StorageVersionMigration) start(trigger stewardv1alpha1.StorageVersionMigrationTrigger, resources []string) {
+	r.status.Phase = stewardv1alpha1.StorageVersionMigrationPhaseMigrating
+	r.status.Trigger = trigger
+	r.status.Resources = resources
+	r.status.MigratedResources = nil
+	r.status.Continue = ""
+	r.status.StartTime = ptr.To(metav1.Now())
+	r.status.CompletionTime = nil
+
+	if r.status.StorageVersionHashes == nil {
+		r.status.StorageVersionHashes = map[string]string{}
+	}
+}
+
+// complete marks the migration as completed, replacing the value of the annotation requesting it with the completion timestamp.
+func (r *StorageVersionMigration) complete(ctx context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane, storageResources map[string]stewardupgrade.StorageResource) error {
+	r.status.Phase = stewardv1alpha1.StorageVersionMigrationPhaseCompleted
+	r.status.CompletionTime = ptr.To(metav1.Now())
+	r.recordNewHashes(storageResources)
+
+	if r.status.Trigger != stewardv1alpha1.StorageVersionMigrationTriggerRequested {
+		return nil
+	}
+
+	if value, ok := tenantControlPlane.GetAnnotations()[stewardv1alpha1.StorageVersionMigrationRequestAnnotation]; !ok || value != "" {
+		return nil
+	}
+
+	original := tenantControlPlane.DeepCopy()
+
+	annotations := tenantControlPlane.GetAnnotations()
+	annotations[stewardv1alpha1.StorageVersionMigrationRequestAnnotation] = r.status.CompletionTime.Format(time.RFC3339)
+	tenantControlPlane.SetAnnotations(annotations)
+
+	if err := r.Client.Patch(ctx, tenantControlPlane, client.MergeFrom(original)); err != nil {
+		return errors.Wrap(err, "cannot mark the storage version migration request as completed")
+	}
+
+	return nil
+}
+
+func (r *StorageVersionMigration) pendingResource() string {
+	for _, resource := range r.status.Resources {
+		if !slices.Contains(r.status.MigratedResources, resource) {
+			return resource
+		}
+	}
+
+	return ""
+}
+
+func (r *StorageVersionMigration) recordNewHashes(storageResources map[string]stewardupgrade.StorageResource) {
+	if r.status.StorageVersionHashes == nil {
+		r.status.StorageVersionHashes = make(map[string]string, len(storageResources))
+	}
+
+	for name, resource := range storageResources {
+		if _, ok := r.status.StorageVersionHashes[name]; !ok {
+			r.status.StorageVersionHashes[name] = resource.StorageVersionHash
+		}
+	}
+}
+
+func (r *StorageVersionMigration) GetName() string {
+	return "storage-version-migration"
+}
+
+func (r *StorageVersionMigration) ShouldStatusBeUpdated(_ context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) bool {
+	return !equality.Semantic.DeepEqual(tenantControlPlane.Status.StorageVersionMigration, r.status)
+}
+
+func (r *StorageVersionMigration) UpdateTenantControlPlaneStatus(_ context.Context, tenantControlPlane *stewardv1alpha1.TenantControlPlane) error {
+	tenantControlPlane.Status.StorageVersionMigration = r.status
+
+	return nil
+}
@@ -0,0 +1,283 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package rollout
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+)
+
+// Apply sets the rollout version, and the addon image tags, to the Tenant Control Plane spec:
+// the upgrade is then performed by the Tenant Control Plane reconciliation, according to its upgrade strategy.
+func Apply(spec stewardv1alpha1.TenantControlPlaneRolloutSpec, tcp *stewardv1alpha1.TenantControlPlane) {
+	tcp.Spec.Kubernetes.Version = spec.Version
+
+	if spec.Addons == nil {
+		return
+	}
+
+	addons := &tcp.Spec.Addons
+
+	if addons.CoreDNS != nil && spec.Addons.CoreDNSImageTag != "" {
+		addons.CoreDNS.ImageTag = spec.Addons.CoreDNSImageTag
+	}
+
+	if addons.KubeProxy != nil && spec.Addons.KubeProxyImageTag != "" {
+		addons.KubeProxy.ImageTag = spec.Addons.KubeProxyImageTag
+	}
+
+	if addons.Konnectivity != nil && spec.Addons.KonnectivityVersion != "" {
+		addons.Konnectivity.KonnectivityServerSpec.Version = spec.Addons.KonnectivityVersion
+		addons.Konnectivity.KonnectivityAgentSpec.Version = spec.Addons.KonnectivityVersion
+	}
+}
+
+// IsApplied returns true when the Tenant Control Plane spec already matches the rollout one.
+func IsApplied(spec stewardv1alpha1.TenantControlPlaneRolloutSpec, tcp stewardv1alpha1.TenantControlPlane) bool {
+	applied := tcp.DeepCopy()
+	Apply(spec, applied)
+
+	return equality.Semantic.DeepEqual(applied.Spec, tcp.Spec)
+}
+
+// Observe returns the phase of the Tenant Control Plane upgrade started at the given time, if known,
+// along with the reason of its failure: the failed preflight checks, or the rollback to the last-known-good version.
+func Observe(spec stewardv1alpha1.TenantControlPlaneRolloutSpec, tcp stewardv1alpha1.TenantControlPlane, startTime *metav1.Time) (stewardv1alpha1.RolloutTenantControlPlanePhase, string) {
+	if !IsApplied(spec, tcp) {
+		return stewardv1alpha1.RolloutTenantControlPlanePhasePending, ""
+	}
+
+	version := tcp.Status.Kubernetes.Version
+
+	if rollback := version.RolledBack; rollback != nil && (startTime == nil || !rollback.RolledBackAt.Before(startTime)) {
+		return stewardv1alpha1.RolloutTenantControlPlanePhaseFailed, fmt.Sprintf("the upgrade to %s has been rolled back to %s", rollback.FailedVersion, rollback.Version)
+	}
+
+	if condition := meta.FindStatusCondition(tcp.Status.Conditions, stewardv1alpha1.UpgradePreflightsPassedCondition); condition != nil &&
+		condition.Status == metav1.ConditionFalse && condition.ObservedGeneration == tcp.GetGeneration() {
+		return stewardv1alpha1.RolloutTenantControlPlanePhaseFailed, condition.Message
+	}
+
+	if version.Version == spec.Version && ptr.Deref(version.Status, stewardv1alpha1.VersionUnknown) == stewardv1alpha1.VersionReady {
+		return stewardv1alpha1.RolloutTenantControlPlanePhaseUpgraded, ""
+	}
+
+	return stewardv1alpha1.RolloutTenantControlPlanePhaseUpgrading, ""
+}
+
+// Plan computes the rollout progress from the selected Tenant Control Planes, in the namespace and name order,
+// returning the indexes of the ones whose upgrade must be started, already marked as upgrading,
+// and the time to wait before starting the next batch, when soaking.
+func Plan(rollout stewardv1alpha1.TenantControlPlaneRollout, tcps []stewardv1alpha1.TenantControlPlane, now time.Time) (stewardv1alpha1.TenantControlPlaneRolloutStatus, []int, time.Duration) {
+	spec := rollout.Spec
+
+	status := rollout.Status.DeepCopy()
+	// A new target version starts the rollout from the beginning.
+	if status.Version != spec.Version {
+		status = &stewardv1alpha1.TenantControlPlaneRolloutStatus{Version: spec.Version}
+	}
+
+	previous := make(map[string]stewardv1alpha1.RolloutTenantControlPlaneStatus, len(status.TenantControlPlanes))
+	for _, item := range status.TenantControlPlanes {
+		previous[item.Namespace+"/"+item.Name] = item
+	}
+
+	status.TenantControlPlanes = make([]stewardv1alpha1.RolloutTenantControlPlaneStatus, 0, len(tcps))
+
+	for _, tcp := range tcps {
+		item, ok := previous[tcp.GetNamespace()+"/"+tcp.GetName()]
+		if !ok {
+			item = stewardv1alpha1.RolloutTenantControlPlaneStatus{Namespace: tcp.GetNamespace(), Name: tcp.GetName()}
+		}
+
+		phase, message := Observe(spec, tcp, item.StartTime)
+		// A rejected upgrade is not applied to the Tenant Control Plane, thus observed as pending:
+		// it's kept as failed, rather than retried, until the Tenant Control Plane spec changes.
+		if phase == stewardv1alpha1.RolloutTenantControlPlanePhasePending && item.Phase == stewardv1alpha1.RolloutTenantControlPlanePhaseFailed &&
+			item.RejectedGeneration != 0 && item.RejectedGeneration == tcp.GetGeneration() {
+			phase, message = item.Phase, item.Message
+		} else {
+			item.RejectedGeneration = 0
+		}
+
+		item.Phase, item.Message = phase, message
+
+		switch item.Phase {
+		case stewardv1alpha1.RolloutTenantControlPlanePhaseUpgraded:
+			if item.CompletionTime == nil {
+				item.CompletionTime = &metav1.Time{Time: now}
+			}
+		default:
+			item.CompletionTime = nil
+		}
+
+		status.TenantControlPlanes = append(status.TenantControlPlanes, item)
+	}
+
+	starts, requeueAfter := next(spec, status, now)
+
+	Summarize(spec, status)
+
+	return *status, starts, requeueAfter
+}
+
+func next(spec stewardv1alpha1.TenantControlPlaneRolloutSpec, status *stewardv1alpha1.TenantControlPlaneRolloutStatus, now time.Time) ([]int, time.Duration) {
+	status.Phase = stewardv1alpha1.RolloutPhaseProgressing
+
+	if spec.Paused || (ptr.Deref(spec.PauseOnFailure, true) && count(status, stewardv1alpha1.RolloutTenantControlPlanePhaseFailed) > 0) {
+		return nil, 0
+	}
+
+	var batch []int
+
+	if status.CurrentBatch != nil {
+		batch = members(status, status.CurrentBatch)
+
+		if !finished(status, batch) {
+			return start(spec, status, batch, now), 0
+		}
+
+		if status.BatchCompletionTime == nil {
+			status.BatchCompletionTime = &metav1.Time{Time: now}
+		}
+
+		if spec.SoakTime != nil {
+			if wait := status.BatchCompletionTime.Add(spec.SoakTime.Duration).Sub(now); wait > 0 {
+				status.Phase = stewardv1alpha1.RolloutPhaseSoaking
+
+				return nil, wait
+			}
+		}
+	}
+
+	batch = nil
+
+	for i, item := range status.TenantControlPlanes {
+		if item.Phase == stewardv1alpha1.RolloutTenantControlPlanePhasePending && item.Batch == nil && len(batch) < int(spec.BatchSize) {
+			batch = append(batch, i)
+		}
+	}
+
+	if len(batch) == 0 {
+		return nil, 0
+	}
+
+	current := int32(0)
+	if status.CurrentBatch != nil {
+		current = *status.CurrentBatch + 1
+	}
+
+	status.CurrentBatch = ptr.To(current)
+	status.BatchCompletionTime = nil
+
+	for _, i := range batch {
+		status.TenantControlPlanes[i].Batch = ptr.To(current)
+	}
+
+	return start(spec, status, batch, now), 0
+}
+
+// start marks as upgrading the pending members of the batch, within the max unavailable ones.
+func start(spec stewardv1alpha1.TenantControlPlaneRolloutSpec, status *stewardv1alpha1.TenantControlPlaneRolloutStatus, batch []int, now time.Time) []int {
+	available := int(ptr.Deref(spec.MaxUnavailable, spec.BatchSize))
+
+	for _, i := range batch {
+		if status.TenantControlPlanes[i].Phase == stewardv1alpha1.RolloutTenantControlPlanePhaseUpgrading {
+			available--
+		}
+	}
+
+	var starts []int
+
+	for _, i := range batch {
+		if available <= 0 {
+			break
+		}
+
+		item := &status.TenantControlPlanes[i]
+		if item.Phase != stewardv1alpha1.RolloutTenantControlPlanePhasePending {
+			continue
+		}
+
+		item.Phase = stewardv1alpha1.RolloutTenantControlPlanePhaseUpgrading
+		item.StartTime = &metav1.Time{Time: now}
+
+		starts = append(starts, i)
+		available--
+	}
+
+	return starts
+}
+
+// Reject marks as failed the Tenant Control Plane whose upgrade couldn't be started,
+// such as when rejected by the admission webhooks.
+func Reject(item *stewardv1alpha1.RolloutTenantControlPlaneStatus, tcp stewardv1alpha1.TenantControlPlane, err error) {
+	item.Phase, item.Message = stewardv1alpha1.RolloutTenantControlPlanePhaseFailed, err.Error()
+	item.RejectedGeneration = tcp.GetGeneration()
+}
+
+// Summarize updates the counters of the rollout status, and its phase when not progressing.
+func Summarize(spec stewardv1alpha1.TenantControlPlaneRolloutSpec, status *stewardv1alpha1.TenantControlPlaneRolloutStatus) {
+	status.Total = int32(len(status.TenantControlPlanes)) //nolint:gosec
+	status.Upgraded = count(status, stewardv1alpha1.RolloutTenantControlPlanePhaseUpgraded)
+	status.Failed = count(status, stewardv1alpha1.RolloutTenantControlPlanePhaseFailed)
+	status.Message = ""
+
+	pending := status.Total - status.Upgraded - status.Failed
+
+	switch {
+	case pending == 0 && status.Failed == 0:
+		status.Phase = stewardv1alpha1.RolloutPhaseCompleted
+	case status.Failed > 0 && ptr.Deref(spec.PauseOnFailure, true):
+		status.Phase = stewardv1alpha1.RolloutPhaseFailed
+		status.Message = fmt.Sprintf("the upgrade of %d Tenant Control Planes failed, no new upgrades are started until fixed", status.Failed)
+	case pending == 0:
+		status.Phase = stewardv1alpha1.RolloutPhaseCompleted
+		status.Message = fmt.Sprintf("the upgrade of %d Tenant Control Planes failed", status.Failed)
+	case spec.Paused:
+		status.Phase = stewardv1alpha1.RolloutPhasePaused
+	}
+}
+
+func members(status *stewardv1alpha1.TenantControlPlaneRolloutStatus, batch *int32) []int {
+	var indexes []int
+
+	for i, item := range status.TenantControlPlanes {
+		if item.Batch != nil && *item.Batch == *batch {
+			indexes = append(indexes, i)
+		}
+	}
+
+	return indexes
+}
+
+func finished(status *stewardv1alpha1.TenantControlPlaneRolloutStatus, batch []int) bool {
+	for _, i := range batch {
+		switch status.TenantControlPlanes[i].Phase {
+		case stewardv1alpha1.RolloutTenantControlPlanePhasePending, stewardv1alpha1.RolloutTenantControlPlanePhaseUpgrading:
+			return false
+		}
+	}
+
+	return true
+}
+
+func count(status *stewardv1alpha1.TenantControlPlaneRolloutStatus, phase stewardv1alpha1.RolloutTenantControlPlanePhase) int32 {
+	var n int32
+
+	for _, item := range status.TenantControlPlanes {
+		if item.Phase == phase {
+			n++
+		}
+	}
+
+	return n
+}
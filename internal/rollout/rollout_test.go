@@ -0,0 +1,210 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package rollout_test
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/internal/rollout"
+)
+
+var _ = Describe("TenantControlPlaneRollout", func() {
+	var (
+		now         time.Time
+		tcpRollout  stewardv1alpha1.TenantControlPlaneRollout
+		tcps        []stewardv1alpha1.TenantControlPlane
+		phases      func(status stewardv1alpha1.TenantControlPlaneRolloutStatus) []stewardv1alpha1.RolloutTenantControlPlanePhase
+		plan        func() []int
+		upgrade     func(indexes ...int)
+		requeueTime time.Duration
+	)
+
+	BeforeEach(func() {
+		now = time.Now()
+
+		tcpRollout = stewardv1alpha1.TenantControlPlaneRollout{
+			ObjectMeta: metav1.ObjectMeta{Name: "v1-33"},
+			Spec: stewardv1alpha1.TenantControlPlaneRolloutSpec{
+				Version:   "v1.33.5",
+				BatchSize: 2,
+			},
+		}
+
+		tcps = nil
+		for i := range 5 {
+			tcp := stewardv1alpha1.TenantControlPlane{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("tenant-%d", i), Namespace: "default", Generation: 1}}
+			tcp.Spec.Kubernetes.Version = "v1.32.9"
+			tcp.Spec.Addons.CoreDNS = &stewardv1alpha1.AddonSpec{}
+			tcp.Status.Kubernetes.Version = stewardv1alpha1.KubernetesVersion{Version: "v1.32.9", Status: ptr.To(stewardv1alpha1.VersionReady)}
+
+			tcps = append(tcps, tcp)
+		}
+
+		phases = func(status stewardv1alpha1.TenantControlPlaneRolloutStatus) []stewardv1alpha1.RolloutTenantControlPlanePhase {
+			var items []stewardv1alpha1.RolloutTenantControlPlanePhase
+			for _, item := range status.TenantControlPlanes {
+				items = append(items, item.Phase)
+			}
+
+			return items
+		}
+		// plan mimics the controller, applying the rollout to the started Tenant Control Planes.
+		plan = func() []int {
+			status, starts, requeueAfter := rollout.Plan(tcpRollout, tcps, now)
+			for _, i := range starts {
+				rollout.Apply(tcpRollout.Spec, &tcps[i])
+				tcps[i].Generation++
+			}
+
+			tcpRollout.Status, requeueTime = status, requeueAfter
+
+			return starts
+		}
+
+		upgrade = func(indexes ...int) {
+			for _, i := range indexes {
+				tcps[i].Status.Kubernetes.Version.Version = tcps[i].Spec.Kubernetes.Version
+			}
+		}
+	})
+
+	var (
+		pending   = stewardv1alpha1.RolloutTenantControlPlanePhasePending
+		upgrading = stewardv1alpha1.RolloutTenantControlPlanePhaseUpgrading
+		upgraded  = stewardv1alpha1.RolloutTenantControlPlanePhaseUpgraded
+		failed    = stewardv1alpha1.RolloutTenantControlPlanePhaseFailed
+	)
+
+	It("should upgrade the Tenant Control Planes in batches", func() {
+		Expect(plan()).To(Equal([]int{0, 1}))
+		Expect(phases(tcpRollout.Status)).To(Equal([]stewardv1alpha1.RolloutTenantControlPlanePhase{upgrading, upgrading, pending, pending, pending}))
+		Expect(tcpRollout.Status.CurrentBatch).To(Equal(ptr.To(int32(0))))
+		Expect(tcps[0].Spec.Kubernetes.Version).To(Equal("v1.33.5"))
+		// The next batch is not started until the current one is upgraded.
+		upgrade(0)
+		Expect(plan()).To(BeEmpty())
+
+		upgrade(1)
+		Expect(plan()).To(Equal([]int{2, 3}))
+		Expect(tcpRollout.Status.TenantControlPlanes[2].Batch).To(Equal(ptr.To(int32(1))))
+
+		upgrade(2, 3)
+		Expect(plan()).To(Equal([]int{4}))
+
+		upgrade(4)
+		Expect(plan()).To(BeEmpty())
+		Expect(tcpRollout.Status.Phase).To(Equal(stewardv1alpha1.RolloutPhaseCompleted))
+		Expect(tcpRollout.Status.Upgraded).To(Equal(int32(5)))
+	})
+
+	It("should limit the Tenant Control Planes upgraded at the same time", func() {
+		tcpRollout.Spec.BatchSize, tcpRollout.Spec.MaxUnavailable = 3, ptr.To(int32(1))
+
+		Expect(plan()).To(Equal([]int{0}))
+		Expect(plan()).To(BeEmpty())
+
+		upgrade(0)
+		Expect(plan()).To(Equal([]int{1}))
+	})
+
+	It("should wait for the soak time before starting the next batch", func() {
+		tcpRollout.Spec.SoakTime = &metav1.Duration{Duration: 10 * time.Minute}
+
+		plan()
+		upgrade(0, 1)
+
+		Expect(plan()).To(BeEmpty())
+		Expect(tcpRollout.Status.Phase).To(Equal(stewardv1alpha1.RolloutPhaseSoaking))
+		Expect(requeueTime).To(Equal(10 * time.Minute))
+
+		now = now.Add(10 * time.Minute)
+		Expect(plan()).To(Equal([]int{2, 3}))
+		Expect(tcpRollout.Status.Phase).To(Equal(stewardv1alpha1.RolloutPhaseProgressing))
+	})
+
+	It("should pause on a rolled back upgrade", func() {
+		plan()
+
+		tcps[0].Status.Kubernetes.Version.RolledBack = &stewardv1alpha1.KubernetesRollbackStatus{FailedVersion: "v1.33.5", Version: "v1.32.9", RolledBackAt: metav1.NewTime(now.Add(time.Minute))}
+		upgrade(1)
+
+		Expect(plan()).To(BeEmpty())
+		Expect(phases(tcpRollout.Status)[:2]).To(Equal([]stewardv1alpha1.RolloutTenantControlPlanePhase{failed, upgraded}))
+		Expect(tcpRollout.Status.Phase).To(Equal(stewardv1alpha1.RolloutPhaseFailed))
+		Expect(tcpRollout.Status.TenantControlPlanes[0].Message).To(ContainSubstring("rolled back to v1.32.9"))
+		// Without pausing, the next batch is started despite the failure.
+		tcpRollout.Spec.PauseOnFailure = ptr.To(false)
+
+		Expect(plan()).To(Equal([]int{2, 3}))
+		Expect(tcpRollout.Status.Failed).To(Equal(int32(1)))
+	})
+
+	It("should pause on a rejected upgrade until the Tenant Control Plane changes", func() {
+		status, starts, _ := rollout.Plan(tcpRollout, tcps, now)
+		Expect(starts).To(Equal([]int{0, 1}))
+		// The upgrade of the first Tenant Control Plane is rejected by the admission webhooks.
+		rollout.Reject(&status.TenantControlPlanes[0], tcps[0], fmt.Errorf("upgrading by more than one minor version is not supported"))
+		rollout.Apply(tcpRollout.Spec, &tcps[1])
+		tcpRollout.Status = status
+
+		upgrade(1)
+
+		Expect(plan()).To(BeEmpty())
+		Expect(phases(tcpRollout.Status)[:2]).To(Equal([]stewardv1alpha1.RolloutTenantControlPlanePhase{failed, upgraded}))
+		Expect(tcpRollout.Status.TenantControlPlanes[0].Message).To(ContainSubstring("more than one minor version"))
+		Expect(tcpRollout.Status.Phase).To(Equal(stewardv1alpha1.RolloutPhaseFailed))
+		Expect(plan()).To(BeEmpty())
+		Expect(tcpRollout.Status.Failed).To(Equal(int32(1)))
+		// Changing the Tenant Control Plane spec retries its upgrade.
+		tcps[0].Generation++
+
+		Expect(plan()).To(Equal([]int{0}))
+		Expect(tcpRollout.Status.TenantControlPlanes[0].Phase).To(Equal(upgrading))
+	})
+
+	It("should report the failed preflight checks", func() {
+		plan()
+
+		tcps[1].Status.Conditions = []metav1.Condition{{
+			Type:               stewardv1alpha1.UpgradePreflightsPassedCondition,
+			Status:             metav1.ConditionFalse,
+			Message:            "upgrade preflight checks failed",
+			ObservedGeneration: tcps[1].Generation,
+		}}
+
+		plan()
+		Expect(tcpRollout.Status.TenantControlPlanes[1].Phase).To(Equal(failed))
+		Expect(tcpRollout.Status.TenantControlPlanes[1].Message).To(Equal("upgrade preflight checks failed"))
+	})
+
+	It("should apply the addon image tags to the enabled addons", func() {
+		tcpRollout.Spec.Addons = &stewardv1alpha1.TenantControlPlaneRolloutAddons{CoreDNSImageTag: "v1.12.0", KubeProxyImageTag: "v1.33.5"}
+
+		Expect(rollout.IsApplied(tcpRollout.Spec, tcps[0])).To(BeFalse())
+
+		rollout.Apply(tcpRollout.Spec, &tcps[0])
+		Expect(tcps[0].Spec.Addons.CoreDNS.ImageTag).To(Equal("v1.12.0"))
+		Expect(tcps[0].Spec.Addons.KubeProxy).To(BeNil())
+		Expect(rollout.IsApplied(tcpRollout.Spec, tcps[0])).To(BeTrue())
+	})
+
+	It("should start from the beginning when the version changes", func() {
+		plan()
+		upgrade(0, 1)
+		plan()
+
+		tcpRollout.Spec.Version = "v1.33.6"
+
+		Expect(plan()).To(Equal([]int{0, 1}))
+		Expect(tcpRollout.Status.Version).To(Equal("v1.33.6"))
+		Expect(tcpRollout.Status.CurrentBatch).To(Equal(ptr.To(int32(0))))
+	})
+})
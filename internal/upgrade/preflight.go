@@ -0,0 +1,313 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package upgrade
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/common/model"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	versionutil "k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/kubernetes/cmd/kubeadm/app/phases/upgrade"
+	"k8s.io/utils/ptr"
+)
+
+// PreflightCheck is a check run against the tenant cluster before upgrading its Kubernetes version.
+type PreflightCheck string
+
+const (
+	// PreflightDeprecatedAPIs fails when the tenant cluster requested APIs removed in the target release.
+	PreflightDeprecatedAPIs PreflightCheck = "DeprecatedAPIs"
+	// PreflightKubeletVersionSkew fails when the kubelet of any node would not be supported by the target release.
+	PreflightKubeletVersionSkew PreflightCheck = "KubeletVersionSkew"
+	// PreflightWebhookAvailability fails when any admission webhook failing closed has no ready endpoints.
+	PreflightWebhookAvailability PreflightCheck = "WebhookAvailability"
+)
+
+// PreflightChecks is the ordered list of the checks run before a Kubernetes upgrade.
+var PreflightChecks = []PreflightCheck{PreflightDeprecatedAPIs, PreflightKubeletVersionSkew, PreflightWebhookAvailability}
+
+// DeprecatedAPIsMetric is the API Server metric reporting the deprecated APIs requested since its start,
+// labelled with the release removing them, if any.
+const DeprecatedAPIsMetric = "apiserver_requested_deprecated_apis"
+
+// MaxKubeletMinorSkew is the number of minor releases the kubelet can be older than the API Server.
+const MaxKubeletMinorSkew = 3
+
+// ParseSkippedPreflights returns the checks skipped by the given annotation value:
+// all of them when it's true, or the ones of the comma-separated list, otherwise.
+func ParseSkippedPreflights(value string) sets.Set[PreflightCheck] {
+	if value == "true" {
+		return sets.New(PreflightChecks...)
+	}
+
+	skipped := sets.New[PreflightCheck]()
+
+	for _, item := range strings.Split(value, ",") {
+		if check := PreflightCheck(strings.TrimSpace(item)); slices.Contains(PreflightChecks, check) {
+			skipped.Insert(check)
+		}
+	}
+
+	return skipped
+}
+
+// PreflightFailure is the reason a preflight check failed.
+type PreflightFailure struct {
+	Check   PreflightCheck
+	Message string
+}
+
+// PreflightError reports the failed preflight checks, blocking the upgrade.
+type PreflightError struct {
+	Failures []PreflightFailure
+}
+
+func (e *PreflightError) Error() string {
+	messages := make([]string, 0, len(e.Failures))
+	for _, failure := range e.Failures {
+		messages = append(messages, fmt.Sprintf("%s: %s", failure.Check, failure.Message))
+	}
+
+	return fmt.Sprintf("upgrade preflight checks failed, %s", strings.Join(messages, "; "))
+}
+
+// RunPreflights runs the checks not skipped against the tenant cluster, before upgrading it to the target version:
+// a check unable to retrieve the required information is considered failed, and a PreflightError is returned.
+func RunPreflights(ctx context.Context, clientSet kubernetes.Interface, versionGetter upgrade.VersionGetter, target string, skipped sets.Set[PreflightCheck]) error {
+	targetVersion, err := versionutil.ParseSemantic(target)
+	if err != nil {
+		return errors.Wrapf(err, "unable to parse the target version %q", target)
+	}
+
+	checks := map[PreflightCheck]func() error{
+		PreflightDeprecatedAPIs: func() error {
+			metrics, mErr := clientSet.CoreV1().RESTClient().Get().AbsPath("/metrics").DoRaw(ctx)
+			if mErr != nil {
+				return errors.Wrap(mErr, "cannot retrieve the API Server metrics")
+			}
+
+			return CheckRemovedAPIs(bytes.NewReader(metrics), targetVersion)
+		},
+		PreflightKubeletVersionSkew: func() error {
+			versions, vErr := versionGetter.KubeletVersions()
+			if vErr != nil {
+				return errors.Wrap(vErr, "cannot retrieve the kubelet versions")
+			}
+
+			return CheckKubeletVersionSkew(versions, targetVersion)
+		},
+		PreflightWebhookAvailability: func() error {
+			return CheckWebhookAvailability(ctx, clientSet)
+		},
+	}
+
+	var failures []PreflightFailure
+
+	for _, check := range PreflightChecks {
+		if skipped.Has(check) {
+			continue
+		}
+
+		if checkErr := checks[check](); checkErr != nil {
+			failures = append(failures, PreflightFailure{Check: check, Message: checkErr.Error()})
+		}
+	}
+
+	if len(failures) > 0 {
+		return &PreflightError{Failures: failures}
+	}
+
+	return nil
+}
+
+// CheckRemovedAPIs fails if the API Server metrics, in the Prometheus text format, report requests
+// to APIs removed in the target release, or in a previous one: the metric is reset upon the API Server restart.
+func CheckRemovedAPIs(metrics io.Reader, target *versionutil.Version) error {
+	parser := expfmt.NewTextParser(model.UTF8Validation)
+
+	families, err := parser.TextToMetricFamilies(metrics)
+	if err != nil {
+		return errors.Wrap(err, "cannot parse the API Server metrics")
+	}
+
+	var removed []string
+
+	for _, metric := range families[DeprecatedAPIsMetric].GetMetric() {
+		labels := make(map[string]string, len(metric.GetLabel()))
+		for _, label := range metric.GetLabel() {
+			labels[label.GetName()] = label.GetValue()
+		}
+
+		if labels["removed_release"] == "" || metric.GetGauge().GetValue() == 0 {
+			continue
+		}
+
+		release, parseErr := versionutil.ParseGeneric(labels["removed_release"])
+		if parseErr != nil {
+			return errors.Wrapf(parseErr, "unable to parse the removed release %q", labels["removed_release"])
+		}
+
+		if release.Major() != target.Major() || release.Minor() > target.Minor() {
+			continue
+		}
+
+		groupVersion := labels["version"]
+		if labels["group"] != "" {
+			groupVersion = labels["group"] + "/" + groupVersion
+		}
+
+		resource := labels["resource"]
+		if labels["subresource"] != "" {
+			resource += "/" + labels["subresource"]
+		}
+
+		removed = append(removed, fmt.Sprintf("%s %s (removed in v%s)", groupVersion, resource, labels["removed_release"]))
+	}
+
+	if len(removed) > 0 {
+		slices.Sort(removed)
+
+		return fmt.Errorf("the tenant cluster requested APIs removed by v%d.%d: %s", target.Major(), target.Minor(), strings.Join(removed, ", "))
+	}
+
+	return nil
+}
+
+// CheckKubeletVersionSkew fails if the kubelet of any node, grouped by version, is newer than the target release,
+// or older than the skew supported by it.
+func CheckKubeletVersionSkew(versions map[string][]string, target *versionutil.Version) error {
+	var unsupported []string
+
+	for kubeletVersion, nodes := range versions {
+		version, err := versionutil.ParseSemantic(kubeletVersion)
+		if err != nil {
+			return errors.Wrapf(err, "unable to parse the kubelet version %q", kubeletVersion)
+		}
+
+		switch {
+		case version.Major() != target.Major():
+			unsupported = append(unsupported, fmt.Sprintf("%s (nodes %s) is a different major release", kubeletVersion, strings.Join(nodes, ", ")))
+		case version.Minor() > target.Minor():
+			unsupported = append(unsupported, fmt.Sprintf("%s (nodes %s) is newer than the target release", kubeletVersion, strings.Join(nodes, ", ")))
+		case target.Minor()-version.Minor() > MaxKubeletMinorSkew:
+			unsupported = append(unsupported, fmt.Sprintf("%s (nodes %s) is more than %d minor releases older than the target release", kubeletVersion, strings.Join(nodes, ", "), MaxKubeletMinorSkew))
+		}
+	}
+
+	if len(unsupported) > 0 {
+		slices.Sort(unsupported)
+
+		return fmt.Errorf("unsupported kubelet versions: %s", strings.Join(unsupported, "; "))
+	}
+
+	return nil
+}
+
+// CheckWebhookAvailability fails if any admission webhook, failing closed and backed by a Service,
+// has no ready endpoints: the upgrade would be blocked by the requests it rejects.
+func CheckWebhookAvailability(ctx context.Context, clientSet kubernetes.Interface) error {
+	type webhook struct {
+		name          string
+		configuration string
+		failurePolicy *admissionregistrationv1.FailurePolicyType
+		service       *admissionregistrationv1.ServiceReference
+	}
+
+	var webhooks []webhook
+
+	validating, err := clientSet.AdmissionregistrationV1().ValidatingWebhookConfigurations().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return errors.Wrap(err, "cannot list the ValidatingWebhookConfigurations")
+	}
+
+	for _, configuration := range validating.Items {
+		for _, item := range configuration.Webhooks {
+			webhooks = append(webhooks, webhook{name: item.Name, configuration: "ValidatingWebhookConfiguration " + configuration.Name, failurePolicy: item.FailurePolicy, service: item.ClientConfig.Service})
+		}
+	}
+
+	mutating, err := clientSet.AdmissionregistrationV1().MutatingWebhookConfigurations().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return errors.Wrap(err, "cannot list the MutatingWebhookConfigurations")
+	}
+
+	for _, configuration := range mutating.Items {
+		for _, item := range configuration.Webhooks {
+			webhooks = append(webhooks, webhook{name: item.Name, configuration: "MutatingWebhookConfiguration " + configuration.Name, failurePolicy: item.FailurePolicy, service: item.ClientConfig.Service})
+		}
+	}
+
+	available := make(map[string]bool)
+
+	var unavailable []string
+
+	for _, item := range webhooks {
+		// Webhooks reached by URL cannot be verified.
+		if ptr.Deref(item.failurePolicy, admissionregistrationv1.Fail) != admissionregistrationv1.Fail || item.service == nil {
+			continue
+		}
+
+		key := item.service.Namespace + "/" + item.service.Name
+
+		if _, ok := available[key]; !ok {
+			if available[key], err = serviceAvailable(ctx, clientSet, item.service.Namespace, item.service.Name); err != nil {
+				return err
+			}
+		}
+
+		if !available[key] {
+			unavailable = append(unavailable, fmt.Sprintf("%s of the %s (Service %s)", item.name, item.configuration, key))
+		}
+	}
+
+	if len(unavailable) > 0 {
+		return fmt.Errorf("the webhooks have no ready endpoints: %s", strings.Join(unavailable, ", "))
+	}
+
+	return nil
+}
+
+func serviceAvailable(ctx context.Context, clientSet kubernetes.Interface, namespace, name string) (bool, error) {
+	service, err := clientSet.CoreV1().Services(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return false, nil
+		}
+
+		return false, errors.Wrapf(err, "cannot retrieve the Service %s/%s", namespace, name)
+	}
+
+	if service.Spec.Type == corev1.ServiceTypeExternalName {
+		return true, nil
+	}
+
+	endpointSlices, err := clientSet.DiscoveryV1().EndpointSlices(namespace).List(ctx, metav1.ListOptions{LabelSelector: discoveryv1.LabelServiceName + "=" + name})
+	if err != nil {
+		return false, errors.Wrapf(err, "cannot list the EndpointSlices of the Service %s/%s", namespace, name)
+	}
+
+	for _, slice := range endpointSlices.Items {
+		for _, endpoint := range slice.Endpoints {
+			if ptr.Deref(endpoint.Conditions.Ready, true) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,117 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package upgrade_test
+
+import (
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	versionutil "k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	"github.com/butlerdotdev/steward/internal/upgrade"
+)
+
+var _ = Describe("Upgrade preflights", func() {
+	target := versionutil.MustParseSemantic("v1.32.0")
+
+	It("should parse the skipped checks", func() {
+		Expect(upgrade.ParseSkippedPreflights("true")).To(Equal(sets.New(upgrade.PreflightChecks...)))
+		Expect(upgrade.ParseSkippedPreflights("DeprecatedAPIs, WebhookAvailability,Unknown")).To(Equal(sets.New(upgrade.PreflightDeprecatedAPIs, upgrade.PreflightWebhookAvailability)))
+		Expect(upgrade.ParseSkippedPreflights("")).To(BeEmpty())
+	})
+
+	Describe("removed APIs", func() {
+		const metrics = `# HELP apiserver_requested_deprecated_apis [STABLE] Gauge of deprecated APIs that have been requested, broken out by API group, version, resource, subresource, and removed_release.
+# TYPE apiserver_requested_deprecated_apis gauge
+apiserver_requested_deprecated_apis{group="flowcontrol.apiserver.k8s.io",removed_release="1.32",resource="flowschemas",subresource="",version="v1beta3"} 1
+apiserver_requested_deprecated_apis{group="autoscaling",removed_release="1.35",resource="horizontalpodautoscalers",subresource="",version="v2beta2"} 1
+apiserver_requested_deprecated_apis{group="",removed_release="",resource="componentstatuses",subresource="",version="v1"} 1
+`
+
+		It("should fail for the APIs removed by the target release", func() {
+			err := upgrade.CheckRemovedAPIs(strings.NewReader(metrics), target)
+			Expect(err).To(MatchError(ContainSubstring("flowcontrol.apiserver.k8s.io/v1beta3 flowschemas (removed in v1.32)")))
+			Expect(err).ToNot(MatchError(ContainSubstring("horizontalpodautoscalers")))
+		})
+
+		It("should pass for the APIs removed by a later release", func() {
+			Expect(upgrade.CheckRemovedAPIs(strings.NewReader(metrics), versionutil.MustParseSemantic("v1.31.4"))).To(Succeed())
+		})
+	})
+
+	Describe("kubelet version skew", func() {
+		It("should pass for the supported kubelet versions", func() {
+			Expect(upgrade.CheckKubeletVersionSkew(map[string][]string{"v1.29.10": {"node-0"}, "v1.32.0": {"node-1"}}, target)).To(Succeed())
+		})
+
+		It("should fail for the kubelet versions too old, or newer", func() {
+			err := upgrade.CheckKubeletVersionSkew(map[string][]string{"v1.28.15": {"node-0", "node-1"}, "v1.33.1": {"node-2"}}, target)
+			Expect(err).To(MatchError(ContainSubstring("v1.28.15 (nodes node-0, node-1) is more than 3 minor releases older")))
+			Expect(err).To(MatchError(ContainSubstring("v1.33.1 (nodes node-2) is newer")))
+		})
+	})
+
+	Describe("webhook availability", func() {
+		var ctx context.Context
+
+		webhooks := func(failurePolicy admissionregistrationv1.FailurePolicyType) *admissionregistrationv1.ValidatingWebhookConfiguration {
+			return &admissionregistrationv1.ValidatingWebhookConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "policies"},
+				Webhooks: []admissionregistrationv1.ValidatingWebhook{{
+					Name:          "validate.policies.io",
+					FailurePolicy: ptr.To(failurePolicy),
+					ClientConfig: admissionregistrationv1.WebhookClientConfig{
+						Service: &admissionregistrationv1.ServiceReference{Namespace: "policies", Name: "webhook"},
+					},
+				}},
+			}
+		}
+
+		service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "policies", Name: "webhook"}}
+
+		endpoints := func(ready bool) *discoveryv1.EndpointSlice {
+			return &discoveryv1.EndpointSlice{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "policies", Name: "webhook-abcde", Labels: map[string]string{discoveryv1.LabelServiceName: "webhook"}},
+				Endpoints:  []discoveryv1.Endpoint{{Addresses: []string{"10.0.0.1"}, Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(ready)}}},
+			}
+		}
+
+		BeforeEach(func() {
+			ctx = context.Background()
+		})
+
+		It("should pass when the webhook Service has ready endpoints", func() {
+			clientSet := fake.NewClientset(webhooks(admissionregistrationv1.Fail), service, endpoints(true))
+
+			Expect(upgrade.CheckWebhookAvailability(ctx, clientSet)).To(Succeed())
+		})
+
+		It("should fail when the webhook Service has no ready endpoints", func() {
+			clientSet := fake.NewClientset(webhooks(admissionregistrationv1.Fail), service, endpoints(false))
+
+			Expect(upgrade.CheckWebhookAvailability(ctx, clientSet)).To(MatchError(ContainSubstring("validate.policies.io of the ValidatingWebhookConfiguration policies")))
+		})
+
+		It("should fail when the webhook Service is missing", func() {
+			clientSet := fake.NewClientset(webhooks(admissionregistrationv1.Fail))
+
+			Expect(upgrade.CheckWebhookAvailability(ctx, clientSet)).ToNot(Succeed())
+		})
+
+		It("should ignore the webhooks failing open", func() {
+			clientSet := fake.NewClientset(webhooks(admissionregistrationv1.Ignore))
+
+			Expect(upgrade.CheckWebhookAvailability(ctx, clientSet)).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,66 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package upgrade
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	versionutil "k8s.io/apimachinery/pkg/util/version"
+)
+
+// SequentialPath returns the versions to upgrade through, one minor release at a time, ending with the target one:
+// the intermediate minor releases use the pinned versions, when provided, or their first patch release.
+// The pinned versions out of the upgrade path are ignored, the malformed or duplicated ones are rejected.
+func SequentialPath(running, target string, pinned []string) ([]string, error) {
+	runningVersion, err := versionutil.ParseSemantic(running)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to parse the running version %q", running)
+	}
+
+	targetVersion, err := versionutil.ParseSemantic(target)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to parse the target version %q", target)
+	}
+
+	if runningVersion.Major() != targetVersion.Major() {
+		return nil, fmt.Errorf("an upgrade across major releases is not supported")
+	}
+
+	intermediates := make(map[uint]string, len(pinned))
+	pinnedMinors := make(map[string]struct{}, len(pinned))
+
+	for _, item := range pinned {
+		pinnedVersion, parseErr := versionutil.ParseSemantic(item)
+		if parseErr != nil {
+			return nil, errors.Wrapf(parseErr, "unable to parse the intermediate version %q", item)
+		}
+
+		minor := fmt.Sprintf("v%d.%d", pinnedVersion.Major(), pinnedVersion.Minor())
+		if _, ok := pinnedMinors[minor]; ok {
+			return nil, fmt.Errorf("several intermediate versions for the minor release %s", minor)
+		}
+
+		pinnedMinors[minor] = struct{}{}
+		// The pins out of the path are ignored, such as the ones left from a completed upgrade, or already passed.
+		if pinnedVersion.Major() != targetVersion.Major() || pinnedVersion.Minor() <= runningVersion.Minor() || pinnedVersion.Minor() >= targetVersion.Minor() {
+			continue
+		}
+
+		intermediates[pinnedVersion.Minor()] = item
+	}
+
+	var path []string
+
+	for minor := runningVersion.Minor() + 1; minor < targetVersion.Minor(); minor++ {
+		version, ok := intermediates[minor]
+		if !ok {
+			version = fmt.Sprintf("v%d.%d.0", targetVersion.Major(), minor)
+		}
+
+		path = append(path, version)
+	}
+
+	return append(path, target), nil
+}
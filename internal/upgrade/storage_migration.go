@@ -0,0 +1,67 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package upgrade
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/discovery"
+)
+
+// StorageResource is a resource persisted by the tenant cluster API Server in the DataStore.
+type StorageResource struct {
+	schema.GroupVersionResource
+	// StorageVersionHash changes along with the version the resource is encoded with in the DataStore.
+	StorageVersionHash string
+}
+
+// DiscoverStorageResources returns the resources of the tenant cluster reporting their storage version hash,
+// keyed by resource.group: the ones of the API groups failing the discovery, such as an unavailable aggregated API, are omitted.
+func DiscoverStorageResources(client discovery.DiscoveryInterface) (map[string]StorageResource, error) {
+	lists, err := discovery.ServerPreferredResources(client)
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return nil, errors.Wrap(err, "cannot discover the tenant cluster resources")
+	}
+
+	resources := make(map[string]StorageResource)
+
+	for _, list := range lists {
+		groupVersion, gvErr := schema.ParseGroupVersion(list.GroupVersion)
+		if gvErr != nil {
+			return nil, errors.Wrapf(gvErr, "unable to parse the group version %q", list.GroupVersion)
+		}
+
+		for _, resource := range list.APIResources {
+			// The subresources are stored along with their parent resource.
+			if resource.StorageVersionHash == "" || strings.Contains(resource.Name, "/") || !sets.New(resource.Verbs...).HasAll("list", "update") {
+				continue
+			}
+
+			gvr := groupVersion.WithResource(resource.Name)
+			resources[gvr.GroupResource().String()] = StorageResource{GroupVersionResource: gvr, StorageVersionHash: resource.StorageVersionHash}
+		}
+	}
+
+	return resources, nil
+}
+
+// ChangedStorageVersions returns the sorted resources whose storage version hash differs from the recorded one:
+// the resources with no recorded hash, such as the ones of a new CustomResourceDefinition, are already stored with the current version.
+func ChangedStorageVersions(resources map[string]StorageResource, recorded map[string]string) []string {
+	var changed []string
+
+	for name, resource := range resources {
+		if hash, ok := recorded[name]; ok && hash != resource.StorageVersionHash {
+			changed = append(changed, name)
+		}
+	}
+
+	slices.Sort(changed)
+
+	return changed
+}
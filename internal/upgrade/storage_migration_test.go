@@ -0,0 +1,67 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package upgrade_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	discoveryfake "k8s.io/client-go/discovery/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/butlerdotdev/steward/internal/upgrade"
+)
+
+var _ = Describe("Storage version migration", func() {
+	It("should discover the resources reporting their storage version hash", func() {
+		discovery := &discoveryfake.FakeDiscovery{Fake: &clienttesting.Fake{}}
+		discovery.Resources = []*metav1.APIResourceList{
+			{
+				GroupVersion: "v1",
+				APIResources: []metav1.APIResource{
+					{Name: "secrets", Namespaced: true, Verbs: []string{"list", "update"}, StorageVersionHash: "S1"},
+					{Name: "pods/status", Namespaced: true, Verbs: []string{"get", "update"}, StorageVersionHash: "P1"},
+					{Name: "bindings", Namespaced: true, Verbs: []string{"create"}},
+				},
+			},
+			{
+				GroupVersion: "flowcontrol.apiserver.k8s.io/v1",
+				APIResources: []metav1.APIResource{
+					{Name: "flowschemas", Verbs: []string{"list", "update"}, StorageVersionHash: "F1"},
+				},
+			},
+		}
+
+		resources, err := upgrade.DiscoverStorageResources(discovery)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resources).To(Equal(map[string]upgrade.StorageResource{
+			"secrets": {
+				GroupVersionResource: schema.GroupVersionResource{Version: "v1", Resource: "secrets"},
+				StorageVersionHash:   "S1",
+			},
+			"flowschemas.flowcontrol.apiserver.k8s.io": {
+				GroupVersionResource: schema.GroupVersionResource{Group: "flowcontrol.apiserver.k8s.io", Version: "v1", Resource: "flowschemas"},
+				StorageVersionHash:   "F1",
+			},
+		}))
+	})
+
+	It("should return the resources whose storage version changed", func() {
+		resources := map[string]upgrade.StorageResource{
+			"secrets":                                  {StorageVersionHash: "S1"},
+			"horizontalpodautoscalers.autoscaling":     {StorageVersionHash: "H2"},
+			"flowschemas.flowcontrol.apiserver.k8s.io": {StorageVersionHash: "F2"},
+			"widgets.example.com":                      {StorageVersionHash: "W1"},
+		}
+		recorded := map[string]string{
+			"secrets":                                  "S1",
+			"horizontalpodautoscalers.autoscaling":     "H1",
+			"flowschemas.flowcontrol.apiserver.k8s.io": "F1",
+		}
+
+		Expect(upgrade.ChangedStorageVersions(resources, recorded)).To(Equal([]string{"flowschemas.flowcontrol.apiserver.k8s.io", "horizontalpodautoscalers.autoscaling"}))
+		Expect(upgrade.ChangedStorageVersions(resources, nil)).To(BeEmpty())
+	})
+})
@@ -0,0 +1,54 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package utilities
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+)
+
+// RewritePageSize is the amount of objects listed, and rewritten, at once.
+const RewritePageSize = 250
+
+// RewritePage performs an update with no changes of the page of objects referred by the continue token, which is replaced
+// with the one of the next page: the API Server stores them back encoded with the current storage version, and encryption key.
+// It returns the amount of rewritten objects, and true once all of them have been rewritten.
+func RewritePage(ctx context.Context, client dynamic.Interface, resource schema.GroupVersionResource, continueToken *string) (int, bool, error) {
+	list, err := client.Resource(resource).List(ctx, metav1.ListOptions{Limit: RewritePageSize, Continue: *continueToken})
+	if err != nil {
+		// The token expires upon the DataStore compaction: the rewrite starts over, the objects stored meanwhile are already up-to-date.
+		if k8serrors.IsResourceExpired(err) {
+			*continueToken = ""
+
+			return 0, false, nil
+		}
+
+		return 0, false, errors.Wrapf(err, "cannot list the tenant %s", resource.GroupResource())
+	}
+
+	for i := range list.Items {
+		item := list.Items[i]
+		// A conflict means the object has been written in the meanwhile, thus already up-to-date.
+		if _, uErr := client.Resource(resource).Namespace(item.GetNamespace()).Update(ctx, &item, metav1.UpdateOptions{}); uErr != nil && !k8serrors.IsNotFound(uErr) && !k8serrors.IsConflict(uErr) {
+			return 0, false, errors.Wrapf(uErr, "cannot rewrite the tenant %s %s", resource.GroupResource(), objectKey(item.GetNamespace(), item.GetName()))
+		}
+	}
+
+	*continueToken = list.GetContinue()
+
+	return len(list.Items), *continueToken == "", nil
+}
+
+func objectKey(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+
+	return namespace + "/" + name
+}
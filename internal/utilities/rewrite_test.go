@@ -0,0 +1,178 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package utilities
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+var widgets = schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}
+
+func widget(namespace, name string) *unstructured.Unstructured {
+	object := &unstructured.Unstructured{}
+	object.SetAPIVersion("example.com/v1")
+	object.SetKind("Widget")
+	object.SetNamespace(namespace)
+	object.SetName(name)
+
+	return object
+}
+
+// pagedClient serves the list of objects a page at a time, the continue token being the offset of the next page:
+// the fake dynamic client ignores the pagination options.
+type pagedClient struct {
+	dynamic.Interface
+
+	items   []unstructured.Unstructured
+	expired bool
+}
+
+func (c *pagedClient) Resource(resource schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	return &pagedResource{NamespaceableResourceInterface: c.Interface.Resource(resource), client: c}
+}
+
+type pagedResource struct {
+	dynamic.NamespaceableResourceInterface
+
+	client *pagedClient
+}
+
+func (r *pagedResource) List(_ context.Context, options metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	if r.client.expired && options.Continue != "" {
+		return nil, k8serrors.NewResourceExpired("the provided continue parameter is too old")
+	}
+
+	offset, _ := strconv.Atoi(options.Continue)
+	end := min(offset+int(options.Limit), len(r.client.items))
+
+	list := &unstructured.UnstructuredList{Items: r.client.items[offset:end]}
+	if end < len(r.client.items) {
+		list.SetContinue(strconv.Itoa(end))
+	}
+
+	return list, nil
+}
+
+// newPagedClient returns a client serving the given amount of widgets, and the names of the updated ones.
+func newPagedClient(count int) (*pagedClient, *[]string) {
+	items := make([]unstructured.Unstructured, 0, count)
+	for i := range count {
+		items = append(items, *widget("default", fmt.Sprintf("widget-%d", i)))
+	}
+
+	var updated []string
+
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{widgets: "WidgetList"})
+	client.PrependReactor("update", "widgets", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		object := action.(clienttesting.UpdateAction).GetObject().(*unstructured.Unstructured) //nolint:forcetypeassert
+		updated = append(updated, object.GetName())
+
+		return true, object, nil
+	})
+
+	return &pagedClient{Interface: client, items: items}, &updated
+}
+
+func TestRewritePage(t *testing.T) {
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{widgets: "WidgetList"},
+		widget("default", "first"),
+		widget("kube-system", "second"),
+	)
+
+	var continueToken string
+
+	count, done, err := RewritePage(context.Background(), client, widgets, &continueToken)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if count != 2 || !done {
+		t.Errorf("expected all the 2 objects to be rewritten, got %d, done: %t", count, done)
+	}
+
+	var updated []string
+
+	for _, action := range client.Actions() {
+		if update, ok := action.(clienttesting.UpdateAction); ok {
+			object := update.GetObject().(*unstructured.Unstructured) //nolint:forcetypeassert
+			updated = append(updated, object.GetNamespace()+"/"+object.GetName())
+		}
+	}
+
+	if len(updated) != 2 || updated[0] != "default/first" || updated[1] != "kube-system/second" {
+		t.Errorf("unexpected updated objects: %v", updated)
+	}
+}
+
+func TestRewritePagePaginated(t *testing.T) {
+	client, updated := newPagedClient(2*RewritePageSize + 10)
+
+	var continueToken string
+
+	for page, expected := range []struct {
+		count int
+		done  bool
+		token string
+	}{
+		{count: RewritePageSize, token: strconv.Itoa(RewritePageSize)},
+		{count: RewritePageSize, token: strconv.Itoa(2 * RewritePageSize)},
+		{count: 10, done: true},
+	} {
+		count, done, err := RewritePage(context.Background(), client, widgets, &continueToken)
+		if err != nil {
+			t.Fatalf("page %d: unexpected error: %v", page, err)
+		}
+
+		if count != expected.count || done != expected.done || continueToken != expected.token {
+			t.Errorf("page %d: expected %d objects, done: %t, token %q, got %d, done: %t, token %q", page, expected.count, expected.done, expected.token, count, done, continueToken)
+		}
+	}
+
+	if len(*updated) != 2*RewritePageSize+10 {
+		t.Errorf("expected all the objects to be rewritten once, got %d", len(*updated))
+	}
+}
+
+func TestRewritePageExpiredToken(t *testing.T) {
+	client, updated := newPagedClient(2 * RewritePageSize)
+
+	var continueToken string
+
+	if _, _, err := RewritePage(context.Background(), client, widgets, &continueToken); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	client.expired = true
+
+	count, done, err := RewritePage(context.Background(), client, widgets, &continueToken)
+	if err != nil {
+		t.Fatalf("an expired token must not fail the rewrite: %v", err)
+	}
+
+	if count != 0 || done || continueToken != "" {
+		t.Errorf("expected the rewrite to start over, got %d objects, done: %t, token %q", count, done, continueToken)
+	}
+
+	client.expired = false
+
+	if _, _, err = RewritePage(context.Background(), client, widgets, &continueToken); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if (*updated)[RewritePageSize] != "widget-0" {
+		t.Errorf("expected the rewrite to start from the first page, got %s", (*updated)[RewritePageSize])
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
 	clientset "k8s.io/client-go/kubernetes"
 	restclient "k8s.io/client-go/rest"
 	clientcmdapiv1 "k8s.io/client-go/tools/clientcmd/api/v1"
@@ -38,6 +39,15 @@ func GetTenantClientSet(ctx context.Context, client client.Client, tenantControl
 	return clientset.NewForConfig(config)
 }
 
+func GetTenantDynamicClient(ctx context.Context, client client.Client, tenantControlPlane *stewardv1alpha1.TenantControlPlane) (dynamic.Interface, error) {
+	config, err := GetRESTClientConfig(ctx, client, tenantControlPlane)
+	if err != nil {
+		return nil, err
+	}
+
+	return dynamic.NewForConfig(config)
+}
+
 func GetTenantKubeconfig(ctx context.Context, client client.Client, tenantControlPlane *stewardv1alpha1.TenantControlPlane) (*clientcmdapiv1.Config, error) {
 	secretKubeconfig := &corev1.Secret{}
 	if err := client.Get(ctx, k8stypes.NamespacedName{Namespace: tenantControlPlane.GetNamespace(), Name: tenantControlPlane.Status.KubeConfig.Admin.SecretName}, secretKubeconfig); err != nil {
@@ -28,6 +28,10 @@ func (d DataStoreValidation) OnCreate(object runtime.Object) AdmissionResponse {
 	return func(ctx context.Context, _ admission.Request) ([]jsonpatch.JsonPatchOperation, error) {
 		ds := object.(*stewardv1alpha1.DataStore) //nolint:forcetypeassert
 
+		if ds.Spec.Managed != nil && (len(ds.Spec.Endpoints) > 0 || ds.Spec.TLSConfig != nil) {
+			return nil, fmt.Errorf("endpoints, and TLS configuration, are filled in by Steward for the managed DataStores")
+		}
+
 		return nil, d.validate(ctx, *ds)
 	}
 }
@@ -62,6 +66,14 @@ func (d DataStoreValidation) OnUpdate(object runtime.Object, oldObj runtime.Obje
 }
 
 func (d DataStoreValidation) validate(ctx context.Context, ds stewardv1alpha1.DataStore) error {
+	if ds.Spec.Driver == stewardv1alpha1.KineSQLiteDriver {
+		if len(ds.Spec.Endpoints) > 0 || ds.Spec.BasicAuth != nil || ds.Spec.TLSConfig != nil {
+			return fmt.Errorf("endpoints, basic-auth, and TLS configuration are not supported by the %s driver, since the database is embedded in the Tenant Control Plane", ds.Spec.Driver)
+		}
+
+		return nil
+	}
+
 	if ds.Spec.BasicAuth != nil {
 		if err := d.validateBasicAuth(ctx, ds); err != nil {
 			return err
@@ -87,6 +99,10 @@ func (d DataStoreValidation) validateTLSConfig(ctx context.Context, ds stewardv1
 	if ds.Spec.TLSConfig == nil && ds.Spec.Driver != stewardv1alpha1.EtcdDriver {
 		return nil
 	}
+	// The TLS configuration of the managed DataStores is filled in by Steward, once the certificates are generated.
+	if ds.Spec.TLSConfig == nil && ds.Spec.Managed != nil {
+		return nil
+	}
 
 	if err := d.validateContentReference(ctx, ds.Spec.TLSConfig.CertificateAuthority.Certificate); err != nil {
 		return fmt.Errorf("CA certificate is not valid, %w", err)
@@ -0,0 +1,108 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/blang/semver"
+	"github.com/pkg/errors"
+	"gomodules.xyz/jsonpatch/v2"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/apis/apiserver"
+	apiserverv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
+	"k8s.io/apiserver/pkg/apis/apiserver/validation"
+	authenticationcel "k8s.io/apiserver/pkg/authentication/cel"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/internal/builders/controlplane"
+	"github.com/butlerdotdev/steward/internal/utilities"
+	"github.com/butlerdotdev/steward/internal/webhook/utils"
+)
+
+var authenticationConfigurationMinVersion = semver.MustParse("1.30.0")
+
+// TenantControlPlaneAuthentication validates the structured authentication settings,
+// a malformed AuthenticationConfiguration would prevent the API Server from starting.
+type TenantControlPlaneAuthentication struct{}
+
+func (t TenantControlPlaneAuthentication) handle(tcp *stewardv1alpha1.TenantControlPlane) error {
+	authentication := tcp.Spec.Kubernetes.Authentication
+	if authentication == nil {
+		return nil
+	}
+
+	version, err := semver.ParseTolerant(tcp.Spec.Kubernetes.Version)
+	if err != nil {
+		return errors.Wrap(err, "unable to parse the desired Kubernetes version")
+	}
+
+	if version.LT(authenticationConfigurationMinVersion) {
+		return fmt.Errorf("structured authentication requires Kubernetes v%d.%d, or greater", authenticationConfigurationMinVersion.Major, authenticationConfigurationMinVersion.Minor)
+	}
+
+	if extraArgs := tcp.Spec.ControlPlane.Deployment.ExtraArgs; extraArgs != nil {
+		for flag := range utilities.ArgsFromSliceToMap(extraArgs.APIServer) {
+			if strings.HasPrefix(flag, "--oidc-") || flag == "--authentication-config" {
+				return fmt.Errorf("the API Server extra argument %s conflicts with the structured authentication", flag)
+			}
+		}
+	}
+
+	configuration := &apiserverv1.AuthenticationConfiguration{}
+
+	for _, authenticator := range authentication.JWT {
+		if authenticator.CertificateAuthorityRef != nil && len(authenticator.Issuer.CertificateAuthority) > 0 {
+			return fmt.Errorf("the issuer %s certificate authority must be provided either inline or by reference, not both", authenticator.Issuer.URL)
+		}
+
+		configuration.JWT = append(configuration.JWT, authenticator.JWTAuthenticator)
+	}
+
+	internal := &apiserver.AuthenticationConfiguration{}
+	if err = apiserverv1.Convert_v1_AuthenticationConfiguration_To_apiserver_AuthenticationConfiguration(configuration, internal, nil); err != nil {
+		return errors.Wrap(err, "unable to convert the AuthenticationConfiguration")
+	}
+
+	if errs := validation.ValidateAuthenticationConfiguration(authenticationcel.NewDefaultCompiler(), internal, []string{controlplane.ServiceAccountIssuer}); len(errs) > 0 {
+		return fmt.Errorf("invalid authentication configuration: %s", errs.ToAggregate().Error())
+	}
+
+	return nil
+}
+
+func (t TenantControlPlaneAuthentication) OnCreate(object runtime.Object) AdmissionResponse {
+	return func(context.Context, admission.Request) ([]jsonpatch.JsonPatchOperation, error) {
+		tcp := object.(*stewardv1alpha1.TenantControlPlane) //nolint:forcetypeassert
+
+		if err := t.handle(tcp); err != nil {
+			return nil, err
+		}
+
+		return nil, nil
+	}
+}
+
+func (t TenantControlPlaneAuthentication) OnDelete(runtime.Object) AdmissionResponse {
+	return utils.NilOp()
+}
+
+func (t TenantControlPlaneAuthentication) OnUpdate(object runtime.Object, _ runtime.Object) AdmissionResponse {
+	return func(context.Context, admission.Request) ([]jsonpatch.JsonPatchOperation, error) {
+		tcp := object.(*stewardv1alpha1.TenantControlPlane) //nolint:forcetypeassert
+
+		if tcp.DeletionTimestamp != nil {
+			return nil, nil
+		}
+
+		if err := t.handle(tcp); err != nil {
+			return nil, err
+		}
+
+		return nil, nil
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiserverv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/internal/webhook/handlers"
+)
+
+var _ = Describe("TCP Authentication Webhook", func() {
+	var (
+		ctx context.Context
+		t   handlers.TenantControlPlaneAuthentication
+		tcp *stewardv1alpha1.TenantControlPlane
+	)
+
+	BeforeEach(func() {
+		t = handlers.TenantControlPlaneAuthentication{}
+		tcp = &stewardv1alpha1.TenantControlPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "tcp",
+				Namespace: "default",
+			},
+			Spec: stewardv1alpha1.TenantControlPlaneSpec{
+				Kubernetes: stewardv1alpha1.KubernetesSpec{
+					Version: "v1.33.0",
+					Authentication: &stewardv1alpha1.AuthenticationSpec{
+						JWT: []stewardv1alpha1.JWTAuthenticatorSpec{
+							{
+								JWTAuthenticator: apiserverv1.JWTAuthenticator{
+									Issuer: apiserverv1.Issuer{
+										URL:       "https://idp.example.com",
+										Audiences: []string{"steward"},
+									},
+									ClaimMappings: apiserverv1.ClaimMappings{
+										Username: apiserverv1.PrefixedClaimOrExpression{Claim: "sub", Prefix: ptr.To("idp:")},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		ctx = context.Background()
+	})
+
+	It("allows a valid JWT authenticator", func() {
+		_, err := t.OnCreate(tcp)(ctx, admission.Request{})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("denies Kubernetes versions not supporting the structured authentication", func() {
+		tcp.Spec.Kubernetes.Version = "v1.29.4"
+		_, err := t.OnCreate(tcp)(ctx, admission.Request{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("denies a non HTTPS issuer", func() {
+		tcp.Spec.Kubernetes.Authentication.JWT[0].Issuer.URL = "http://idp.example.com"
+		_, err := t.OnCreate(tcp)(ctx, admission.Request{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("denies duplicated issuers", func() {
+		tcp.Spec.Kubernetes.Authentication.JWT = append(tcp.Spec.Kubernetes.Authentication.JWT, tcp.Spec.Kubernetes.Authentication.JWT[0])
+		_, err := t.OnCreate(tcp)(ctx, admission.Request{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("denies the service account issuer", func() {
+		tcp.Spec.Kubernetes.Authentication.JWT[0].Issuer.URL = "https://kubernetes.default.svc.cluster.local"
+		_, err := t.OnCreate(tcp)(ctx, admission.Request{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("denies malformed CEL expressions", func() {
+		tcp.Spec.Kubernetes.Authentication.JWT[0].ClaimValidationRules = []apiserverv1.ClaimValidationRule{
+			{Expression: "claims.hd ==", Message: "invalid"},
+		}
+		_, err := t.OnCreate(tcp)(ctx, admission.Request{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("allows valid CEL expressions", func() {
+		tcp.Spec.Kubernetes.Authentication.JWT[0].ClaimValidationRules = []apiserverv1.ClaimValidationRule{
+			{Expression: "claims.hd == 'example.com'", Message: "the hosted domain must be example.com"},
+		}
+		_, err := t.OnCreate(tcp)(ctx, admission.Request{})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("denies both inline and referenced certificate authorities", func() {
+		tcp.Spec.Kubernetes.Authentication.JWT[0].Issuer.CertificateAuthority = "-----BEGIN CERTIFICATE-----"
+		tcp.Spec.Kubernetes.Authentication.JWT[0].CertificateAuthorityRef = &corev1.ConfigMapKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "idp-ca"},
+			Key:                  "ca.crt",
+		}
+		_, err := t.OnCreate(tcp)(ctx, admission.Request{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("denies the legacy OIDC flags", func() {
+		tcp.Spec.ControlPlane.Deployment.ExtraArgs = &stewardv1alpha1.ControlPlaneExtraArgs{
+			APIServer: []string{"--oidc-issuer-url=https://idp.example.com"},
+		}
+		_, err := t.OnUpdate(tcp, tcp)(ctx, admission.Request{})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,146 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/blang/semver"
+	"github.com/pkg/errors"
+	"gomodules.xyz/jsonpatch/v2"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/apis/apiserver"
+	apiserverv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
+	"k8s.io/apiserver/pkg/apis/apiserver/validation"
+	authorizationcel "k8s.io/apiserver/pkg/authorization/cel"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/internal/utilities"
+	"github.com/butlerdotdev/steward/internal/webhook/utils"
+)
+
+var (
+	authorizationConfigurationMinVersion      = semver.MustParse("1.30.0")
+	authorizationWebhookCachingMinVersion     = semver.MustParse("1.34.0")
+	authorizationConfigurationKnownTypes      = sets.New(string(stewardv1alpha1.AuthorizerTypeNode), string(stewardv1alpha1.AuthorizerTypeRBAC), string(stewardv1alpha1.AuthorizerTypeWebhook))
+	authorizationConfigurationRepeatableTypes = sets.New(string(stewardv1alpha1.AuthorizerTypeWebhook))
+)
+
+// TenantControlPlaneAuthorization validates the structured authorization settings,
+// refusing chains that would lock out the kubelets, or the RBAC subjects, unless explicitly allowed.
+type TenantControlPlaneAuthorization struct{}
+
+func (t TenantControlPlaneAuthorization) handle(tcp *stewardv1alpha1.TenantControlPlane) error {
+	authorization := tcp.Spec.Kubernetes.Authorization
+	if authorization == nil {
+		return nil
+	}
+
+	version, err := semver.ParseTolerant(tcp.Spec.Kubernetes.Version)
+	if err != nil {
+		return errors.Wrap(err, "unable to parse the desired Kubernetes version")
+	}
+
+	if version.LT(authorizationConfigurationMinVersion) {
+		return fmt.Errorf("structured authorization requires Kubernetes v%d.%d, or greater", authorizationConfigurationMinVersion.Major, authorizationConfigurationMinVersion.Minor)
+	}
+
+	if extraArgs := tcp.Spec.ControlPlane.Deployment.ExtraArgs; extraArgs != nil {
+		for flag := range utilities.ArgsFromSliceToMap(extraArgs.APIServer) {
+			if strings.HasPrefix(flag, "--authorization-") {
+				return fmt.Errorf("the API Server extra argument %s conflicts with the structured authorization", flag)
+			}
+		}
+	}
+
+	configuration := &apiserverv1.AuthorizationConfiguration{}
+	types := sets.New[stewardv1alpha1.AuthorizerType]()
+
+	for _, authorizer := range authorization.Authorizers {
+		types.Insert(authorizer.Type)
+
+		item := apiserverv1.AuthorizerConfiguration{
+			Type: string(authorizer.Type),
+			Name: authorizer.Name,
+		}
+
+		if webhook := authorizer.Webhook; webhook != nil {
+			if (webhook.CacheAuthorizedRequests != nil || webhook.CacheUnauthorizedRequests != nil) && version.LT(authorizationWebhookCachingMinVersion) {
+				return fmt.Errorf("the caching settings of the %s authorizer require Kubernetes v%d.%d, or greater", authorizer.Name, authorizationWebhookCachingMinVersion.Major, authorizationWebhookCachingMinVersion.Minor)
+			}
+
+			item.Webhook = &apiserverv1.WebhookConfiguration{
+				AuthorizedTTL:                            webhook.AuthorizedTTL,
+				CacheAuthorizedRequests:                  webhook.CacheAuthorizedRequests,
+				UnauthorizedTTL:                          webhook.UnauthorizedTTL,
+				CacheUnauthorizedRequests:                webhook.CacheUnauthorizedRequests,
+				Timeout:                                  webhook.Timeout,
+				SubjectAccessReviewVersion:               webhook.SubjectAccessReviewVersion,
+				MatchConditionSubjectAccessReviewVersion: webhook.MatchConditionSubjectAccessReviewVersion,
+				FailurePolicy:                            webhook.FailurePolicy,
+				// The kubeconfig file is provided by Steward, using the in-cluster connection
+				// to skip the file existence check performed by the upstream validation.
+				ConnectionInfo:  apiserverv1.WebhookConnectionInfo{Type: apiserverv1.AuthorizationWebhookConnectionInfoTypeInCluster},
+				MatchConditions: webhook.MatchConditions,
+			}
+		}
+
+		configuration.Authorizers = append(configuration.Authorizers, item)
+	}
+
+	if !authorization.AllowDefaultAuthorizersRemoval {
+		for _, required := range []stewardv1alpha1.AuthorizerType{stewardv1alpha1.AuthorizerTypeNode, stewardv1alpha1.AuthorizerTypeRBAC} {
+			if !types.Has(required) {
+				return fmt.Errorf("the %s authorizer is required, enable allowDefaultAuthorizersRemoval to drop it", required)
+			}
+		}
+	}
+
+	internal := &apiserver.AuthorizationConfiguration{}
+	if err = apiserverv1.Convert_v1_AuthorizationConfiguration_To_apiserver_AuthorizationConfiguration(configuration, internal, nil); err != nil {
+		return errors.Wrap(err, "unable to convert the AuthorizationConfiguration")
+	}
+
+	if errs := validation.ValidateAuthorizationConfiguration(authorizationcel.NewDefaultCompiler(), nil, internal, authorizationConfigurationKnownTypes, authorizationConfigurationRepeatableTypes); len(errs) > 0 {
+		return fmt.Errorf("invalid authorization configuration: %s", errs.ToAggregate().Error())
+	}
+
+	return nil
+}
+
+func (t TenantControlPlaneAuthorization) OnCreate(object runtime.Object) AdmissionResponse {
+	return func(context.Context, admission.Request) ([]jsonpatch.JsonPatchOperation, error) {
+		tcp := object.(*stewardv1alpha1.TenantControlPlane) //nolint:forcetypeassert
+
+		if err := t.handle(tcp); err != nil {
+			return nil, err
+		}
+
+		return nil, nil
+	}
+}
+
+func (t TenantControlPlaneAuthorization) OnDelete(runtime.Object) AdmissionResponse {
+	return utils.NilOp()
+}
+
+func (t TenantControlPlaneAuthorization) OnUpdate(object runtime.Object, _ runtime.Object) AdmissionResponse {
+	return func(context.Context, admission.Request) ([]jsonpatch.JsonPatchOperation, error) {
+		tcp := object.(*stewardv1alpha1.TenantControlPlane) //nolint:forcetypeassert
+
+		if tcp.DeletionTimestamp != nil {
+			return nil, nil
+		}
+
+		if err := t.handle(tcp); err != nil {
+			return nil, err
+		}
+
+		return nil, nil
+	}
+}
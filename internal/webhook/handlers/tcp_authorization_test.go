@@ -0,0 +1,120 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiserverv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/internal/webhook/handlers"
+)
+
+var _ = Describe("TCP Authorization Webhook", func() {
+	var (
+		ctx     context.Context
+		t       handlers.TenantControlPlaneAuthorization
+		tcp     *stewardv1alpha1.TenantControlPlane
+		webhook stewardv1alpha1.AuthorizerSpec
+	)
+
+	BeforeEach(func() {
+		t = handlers.TenantControlPlaneAuthorization{}
+		webhook = stewardv1alpha1.AuthorizerSpec{
+			Type: stewardv1alpha1.AuthorizerTypeWebhook,
+			Name: "opa",
+			Webhook: &stewardv1alpha1.WebhookAuthorizerSpec{
+				KubeconfigSecretRef: corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "opa"},
+					Key:                  "kubeconfig",
+				},
+				AuthorizedTTL:                            metav1.Duration{Duration: 5 * time.Minute},
+				UnauthorizedTTL:                          metav1.Duration{Duration: 30 * time.Second},
+				Timeout:                                  metav1.Duration{Duration: 3 * time.Second},
+				SubjectAccessReviewVersion:               "v1",
+				MatchConditionSubjectAccessReviewVersion: "v1",
+				FailurePolicy:                            "NoOpinion",
+			},
+		}
+		tcp = &stewardv1alpha1.TenantControlPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "tcp",
+				Namespace: "default",
+			},
+			Spec: stewardv1alpha1.TenantControlPlaneSpec{
+				Kubernetes: stewardv1alpha1.KubernetesSpec{
+					Version: "v1.33.0",
+					Authorization: &stewardv1alpha1.AuthorizationSpec{
+						Authorizers: []stewardv1alpha1.AuthorizerSpec{
+							{Type: stewardv1alpha1.AuthorizerTypeNode, Name: "node"},
+							webhook,
+							{Type: stewardv1alpha1.AuthorizerTypeRBAC, Name: "rbac"},
+						},
+					},
+				},
+			},
+		}
+		ctx = context.Background()
+	})
+
+	It("allows a valid chain of authorizers", func() {
+		_, err := t.OnCreate(tcp)(ctx, admission.Request{})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("denies a chain lacking the RBAC authorizer", func() {
+		tcp.Spec.Kubernetes.Authorization.Authorizers = tcp.Spec.Kubernetes.Authorization.Authorizers[:2]
+		_, err := t.OnCreate(tcp)(ctx, admission.Request{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("allows a chain lacking the Node authorizer when forced", func() {
+		tcp.Spec.Kubernetes.Authorization.Authorizers = tcp.Spec.Kubernetes.Authorization.Authorizers[1:]
+		tcp.Spec.Kubernetes.Authorization.AllowDefaultAuthorizersRemoval = true
+		_, err := t.OnCreate(tcp)(ctx, admission.Request{})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("denies duplicated authorizer names", func() {
+		tcp.Spec.Kubernetes.Authorization.Authorizers = append(tcp.Spec.Kubernetes.Authorization.Authorizers, webhook)
+		_, err := t.OnCreate(tcp)(ctx, admission.Request{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("denies malformed match conditions", func() {
+		tcp.Spec.Kubernetes.Authorization.Authorizers[1].Webhook.MatchConditions = []apiserverv1.WebhookMatchCondition{
+			{Expression: "request.resourceAttributes.namespace =="},
+		}
+		_, err := t.OnCreate(tcp)(ctx, admission.Request{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("denies a timeout greater than 30 seconds", func() {
+		tcp.Spec.Kubernetes.Authorization.Authorizers[1].Webhook.Timeout = metav1.Duration{Duration: time.Minute}
+		_, err := t.OnCreate(tcp)(ctx, admission.Request{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("denies the caching settings on Kubernetes versions not supporting them", func() {
+		tcp.Spec.Kubernetes.Authorization.Authorizers[1].Webhook.CacheAuthorizedRequests = ptr.To(false)
+		_, err := t.OnCreate(tcp)(ctx, admission.Request{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("denies the legacy authorization flags", func() {
+		tcp.Spec.ControlPlane.Deployment.ExtraArgs = &stewardv1alpha1.ControlPlaneExtraArgs{
+			APIServer: []string{"--authorization-mode=Node,RBAC,Webhook"},
+		}
+		_, err := t.OnUpdate(tcp, tcp)(ctx, admission.Request{})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -6,15 +6,18 @@ package handlers
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"gomodules.xyz/jsonpatch/v2"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	pointer "k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/internal/datastore"
 	"github.com/butlerdotdev/steward/internal/webhook/utils"
 )
 
@@ -25,9 +28,17 @@ type TenantControlPlaneDataStore struct {
 func (t TenantControlPlaneDataStore) OnCreate(object runtime.Object) AdmissionResponse {
 	return func(ctx context.Context, _ admission.Request) ([]jsonpatch.JsonPatchOperation, error) {
 		tcp := object.(*stewardv1alpha1.TenantControlPlane) //nolint:forcetypeassert
+		// The dedicated etcd cluster is provisioned along with the Tenant Control Plane.
+		if tcp.Spec.DataStoreMode == stewardv1alpha1.DataStoreModeDedicated {
+			return nil, nil
+		}
 
 		if tcp.Spec.DataStore != "" {
-			return nil, t.check(ctx, tcp.Spec.DataStore)
+			if err := t.check(ctx, tcp.Spec.DataStore); err != nil {
+				return nil, err
+			}
+
+			return nil, t.checkSQLite(ctx, tcp)
 		}
 
 		return nil, t.checkDataStoreOverrides(ctx, tcp)
@@ -38,15 +49,35 @@ func (t TenantControlPlaneDataStore) OnDelete(runtime.Object) AdmissionResponse
 	return utils.NilOp()
 }
 
-func (t TenantControlPlaneDataStore) OnUpdate(object runtime.Object, _ runtime.Object) AdmissionResponse {
+func (t TenantControlPlaneDataStore) OnUpdate(object runtime.Object, oldObject runtime.Object) AdmissionResponse {
 	return func(ctx context.Context, _ admission.Request) ([]jsonpatch.JsonPatchOperation, error) {
-		tcp := object.(*stewardv1alpha1.TenantControlPlane) //nolint:forcetypeassert
+		tcp, oldTCP := object.(*stewardv1alpha1.TenantControlPlane), oldObject.(*stewardv1alpha1.TenantControlPlane) //nolint:forcetypeassert
 
-		if tcp.Spec.DataStore != "" {
-			return nil, t.check(ctx, tcp.Spec.DataStore)
+		if tcp.Spec.DataStoreMode == stewardv1alpha1.DataStoreModeDedicated {
+			if oldTCP.Spec.DataStoreMode != stewardv1alpha1.DataStoreModeDedicated {
+				return nil, t.checkDriver(ctx, tcp)
+			}
+
+			return nil, nil
 		}
 
-		return nil, nil
+		if tcp.Spec.DataStore == "" {
+			return nil, nil
+		}
+		// The DataStore existence is checked only upon its change, the unrelated updates are allowed despite its removal.
+		if tcp.Spec.DataStore != oldTCP.Spec.DataStore {
+			if err := t.check(ctx, tcp.Spec.DataStore); err != nil {
+				return nil, err
+			}
+		}
+
+		if datastore.DesiredDataStoreName(*tcp) != datastore.DesiredDataStoreName(*oldTCP) {
+			if err := t.checkDriver(ctx, tcp); err != nil {
+				return nil, err
+			}
+		}
+
+		return nil, t.checkSQLite(ctx, tcp)
 	}
 }
 
@@ -62,6 +93,33 @@ func (t TenantControlPlaneDataStore) check(ctx context.Context, dataStoreName st
 	return nil
 }
 
+// checkDriver ensures the Tenant Control Plane is migrated to a DataStore backed by the same driver,
+// unless the operator allowed the migration across drivers: the dedicated etcd cluster is backed by the etcd driver.
+func (t TenantControlPlaneDataStore) checkDriver(ctx context.Context, tcp *stewardv1alpha1.TenantControlPlane) error {
+	if tcp.Status.Storage.Driver == "" {
+		return nil
+	}
+
+	ds, err := datastore.GetDataStore(ctx, t.Client, *tcp, datastore.DesiredDataStoreName(*tcp))
+	if err != nil {
+		return fmt.Errorf("an unexpected error occurred upon Tenant Control Plane DataStore check, %w", err)
+	}
+
+	if tcp.Status.Storage.Driver == string(stewardv1alpha1.KineSQLiteDriver) || ds.Spec.Driver == stewardv1alpha1.KineSQLiteDriver {
+		return fmt.Errorf("migration from, or to, a %s DataStore is not supported, since the database is embedded in the Tenant Control Plane", stewardv1alpha1.KineSQLiteDriver)
+	}
+
+	if string(ds.Spec.Driver) == tcp.Status.Storage.Driver {
+		return nil
+	}
+
+	if allowed, _ := strconv.ParseBool(tcp.GetAnnotations()[stewardv1alpha1.CrossDriverMigrationAnnotation]); !allowed {
+		return fmt.Errorf("migration from a %s DataStore to a %s one requires the %s annotation", tcp.Status.Storage.Driver, ds.Spec.Driver, stewardv1alpha1.CrossDriverMigrationAnnotation)
+	}
+
+	return datastore.CheckStreamDrivers(stewardv1alpha1.Driver(tcp.Status.Storage.Driver), ds.Spec.Driver)
+}
+
 func (t TenantControlPlaneDataStore) checkDataStoreOverrides(ctx context.Context, tcp *stewardv1alpha1.TenantControlPlane) error {
 	overrideCheck := make(map[string]struct{}, 0)
 	for _, ds := range tcp.Spec.DataStoreOverrides {
@@ -73,7 +131,44 @@ func (t TenantControlPlaneDataStore) checkDataStoreOverrides(ctx context.Context
 		if err := t.check(ctx, ds.DataStore); err != nil {
 			return err
 		}
+
+		sqlite, err := t.isSQLite(ctx, ds.DataStore)
+		if err != nil {
+			return err
+		}
+
+		if sqlite {
+			return fmt.Errorf("the %s DataStore cannot be used as override, since it's backed by the %s driver", ds.DataStore, stewardv1alpha1.KineSQLiteDriver)
+		}
 	}
 
 	return nil
 }
+
+// checkSQLite ensures a Tenant Control Plane backed by the SQLite driver runs a single replica:
+// the database is stored on a ReadWriteOnce volume, and cannot be shared among several Kine instances.
+func (t TenantControlPlaneDataStore) checkSQLite(ctx context.Context, tcp *stewardv1alpha1.TenantControlPlane) error {
+	sqlite, err := t.isSQLite(ctx, tcp.Spec.DataStore)
+	if err != nil || !sqlite {
+		return err
+	}
+
+	if replicas := pointer.Deref(tcp.Spec.ControlPlane.Deployment.Replicas, 1); replicas > 1 {
+		return fmt.Errorf("a Tenant Control Plane using the %s DataStore %s cannot run %d replicas, but one at most", stewardv1alpha1.KineSQLiteDriver, tcp.Spec.DataStore, replicas)
+	}
+
+	return nil
+}
+
+func (t TenantControlPlaneDataStore) isSQLite(ctx context.Context, dataStoreName string) (bool, error) {
+	var ds stewardv1alpha1.DataStore
+	if err := t.Client.Get(ctx, types.NamespacedName{Name: dataStoreName}, &ds); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("an unexpected error occurred upon Tenant Control Plane DataStore check, %w", err)
+	}
+
+	return ds.Spec.Driver == stewardv1alpha1.KineSQLiteDriver, nil
+}
@@ -0,0 +1,129 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/internal/datastore"
+)
+
+//+kubebuilder:rbac:groups=steward.butlerlabs.dev,resources=datastoreplacementpolicies,verbs=get;list;watch
+
+// placeDataStore assigns a DataStore to the Tenant Control Plane according to the DataStorePlacementPolicy selecting it, if any:
+// it returns the name of the applied policy, empty when no policy selects the Tenant Control Plane.
+func (t TenantControlPlaneDefaults) placeDataStore(ctx context.Context, tcp *stewardv1alpha1.TenantControlPlane) (string, error) {
+	policy, err := t.placementPolicy(ctx, tcp)
+	if err != nil || policy == nil {
+		return "", err
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(&policy.Spec.DataStoreSelector)
+	if err != nil {
+		return "", errors.Wrapf(err, "cannot parse the DataStore selector of the %s DataStorePlacementPolicy", policy.GetName())
+	}
+
+	var dsList stewardv1alpha1.DataStoreList
+	if err = t.Client.List(ctx, &dsList); err != nil {
+		return "", errors.Wrap(err, "cannot list the DataStores")
+	}
+
+	var tcpList stewardv1alpha1.TenantControlPlaneList
+	if err = t.Client.List(ctx, &tcpList); err != nil {
+		return "", errors.Wrap(err, "cannot list the Tenant Control Planes")
+	}
+	// The Tenant Control Planes are counted by their desired DataStore: the status is not yet reporting the ones created right before.
+	// The capacity is a soft limit, the concurrent creations are counted against the same cached list.
+	tenants := make(map[string]int32, len(dsList.Items))
+	for _, item := range tcpList.Items {
+		tenants[datastore.DesiredDataStoreName(item)]++
+	}
+
+	candidates := make([]stewardv1alpha1.DataStore, 0, len(dsList.Items))
+
+	for _, ds := range dsList.Items {
+		switch {
+		case ds.GetDeletionTimestamp() != nil:
+			continue
+		case !selector.Matches(labels.Set(ds.GetLabels())):
+			continue
+		case len(policy.Spec.Drivers) > 0 && !slices.Contains(policy.Spec.Drivers, ds.Spec.Driver):
+			continue
+		case policy.Spec.MaxTenantControlPlanes > 0 && tenants[ds.GetName()] >= policy.Spec.MaxTenantControlPlanes:
+			continue
+		case meta.IsStatusConditionFalse(ds.Status.Conditions, stewardv1alpha1.ReadyCondition):
+			continue
+		}
+
+		candidates = append(candidates, ds)
+	}
+
+	if len(candidates) == 0 {
+		return "", fmt.Errorf("the %s DataStorePlacementPolicy has no DataStore available for the Tenant Control Plane", policy.GetName())
+	}
+
+	driverPreference := func(ds stewardv1alpha1.DataStore) int {
+		return slices.Index(policy.Spec.Drivers, ds.Spec.Driver)
+	}
+
+	slices.SortFunc(candidates, func(a, b stewardv1alpha1.DataStore) int {
+		if diff := driverPreference(a) - driverPreference(b); diff != 0 {
+			return diff
+		}
+
+		diff := int(tenants[a.GetName()] - tenants[b.GetName()])
+		if policy.Spec.Strategy == stewardv1alpha1.DataStorePlacementStrategyPack {
+			diff = -diff
+		}
+
+		if diff != 0 {
+			return diff
+		}
+
+		return strings.Compare(a.GetName(), b.GetName())
+	})
+
+	tcp.Spec.DataStore = candidates[0].GetName()
+
+	return policy.GetName(), nil
+}
+
+// placementPolicy returns the DataStorePlacementPolicy with the highest priority among the ones selecting the Tenant Control Plane.
+func (t TenantControlPlaneDefaults) placementPolicy(ctx context.Context, tcp *stewardv1alpha1.TenantControlPlane) (*stewardv1alpha1.DataStorePlacementPolicy, error) {
+	var policyList stewardv1alpha1.DataStorePlacementPolicyList
+	if err := t.Client.List(ctx, &policyList); err != nil {
+		return nil, errors.Wrap(err, "cannot list the DataStorePlacementPolicies")
+	}
+
+	var policy *stewardv1alpha1.DataStorePlacementPolicy
+
+	for i := range policyList.Items {
+		item := &policyList.Items[i]
+
+		selector, err := metav1.LabelSelectorAsSelector(&item.Spec.TenantControlPlaneSelector)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot parse the Tenant Control Plane selector of the %s DataStorePlacementPolicy", item.GetName())
+		}
+
+		if !selector.Matches(labels.Set(tcp.GetLabels())) {
+			continue
+		}
+
+		if policy == nil || item.Spec.Priority > policy.Spec.Priority ||
+			(item.Spec.Priority == policy.Spec.Priority && item.GetName() < policy.GetName()) {
+			policy = item
+		}
+	}
+
+	return policy, nil
+}
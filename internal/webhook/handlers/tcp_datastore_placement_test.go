@@ -0,0 +1,210 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gomodules.xyz/jsonpatch/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/internal/webhook/handlers"
+)
+
+var _ = Describe("TCP DataStore placement", func() {
+	var (
+		ctx     context.Context
+		objects []client.Object
+		tcp     *stewardv1alpha1.TenantControlPlane
+	)
+
+	dataStore := func(name string, driver stewardv1alpha1.Driver, pool string) *stewardv1alpha1.DataStore {
+		return &stewardv1alpha1.DataStore{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{"pool": pool},
+			},
+			Spec: stewardv1alpha1.DataStoreSpec{
+				Driver: driver,
+			},
+		}
+	}
+
+	tenant := func(name, ds string) *stewardv1alpha1.TenantControlPlane {
+		return &stewardv1alpha1.TenantControlPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+			},
+			Spec: stewardv1alpha1.TenantControlPlaneSpec{
+				DataStore: ds,
+			},
+		}
+	}
+
+	policy := func(name string, spec stewardv1alpha1.DataStorePlacementPolicySpec) *stewardv1alpha1.DataStorePlacementPolicy {
+		return &stewardv1alpha1.DataStorePlacementPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: spec,
+		}
+	}
+
+	onCreate := func() ([]jsonpatch.JsonPatchOperation, error) {
+		scheme := runtime.NewScheme()
+		utilruntime.Must(stewardv1alpha1.AddToScheme(scheme))
+
+		t := handlers.TenantControlPlaneDefaults{
+			DefaultDatastore: "default",
+			Client:           fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build(),
+		}
+
+		return t.OnCreate(tcp)(ctx, admission.Request{})
+	}
+
+	assigned := func(name string) jsonpatch.Operation {
+		return jsonpatch.Operation{Operation: "add", Path: "/spec/dataStore", Value: name}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		objects = []client.Object{
+			dataStore("etcd-a", stewardv1alpha1.EtcdDriver, "etcd"),
+			dataStore("etcd-b", stewardv1alpha1.EtcdDriver, "etcd"),
+			dataStore("pg-a", stewardv1alpha1.KinePostgreSQLDriver, "sql"),
+			tenant("first", "etcd-a"),
+			tenant("second", "etcd-a"),
+			tenant("third", "etcd-b"),
+		}
+		tcp = &stewardv1alpha1.TenantControlPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "tcp",
+				Namespace: "default",
+				Labels:    map[string]string{"tier": "gold"},
+			},
+			Spec: stewardv1alpha1.TenantControlPlaneSpec{
+				NetworkProfile: stewardv1alpha1.NetworkProfileSpec{
+					ServiceCIDR:   "10.96.0.0/12",
+					DNSServiceIPs: []string{"10.96.0.10"},
+				},
+			},
+		}
+	})
+
+	It("should fall back to the default DataStore when no policy selects the Tenant Control Plane", func() {
+		objects = append(objects, policy("silver", stewardv1alpha1.DataStorePlacementPolicySpec{
+			TenantControlPlaneSelector: metav1.LabelSelector{MatchLabels: map[string]string{"tier": "silver"}},
+		}))
+
+		ops, err := onCreate()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ops).To(ContainElement(assigned("default")))
+	})
+
+	It("should spread the Tenant Control Planes across the pool", func() {
+		objects = append(objects, policy("etcd", stewardv1alpha1.DataStorePlacementPolicySpec{
+			DataStoreSelector: metav1.LabelSelector{MatchLabels: map[string]string{"pool": "etcd"}},
+			Strategy:          stewardv1alpha1.DataStorePlacementStrategySpread,
+		}))
+
+		ops, err := onCreate()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ops).To(ContainElement(assigned("etcd-b")))
+		Expect(ops).To(ContainElement(jsonpatch.Operation{
+			Operation: "add",
+			Path:      "/metadata/annotations",
+			Value:     map[string]any{stewardv1alpha1.DataStorePlacementPolicyAnnotation: "etcd"},
+		}))
+	})
+
+	It("should pack the Tenant Control Planes within the DataStore capacity", func() {
+		objects = append(objects, policy("etcd", stewardv1alpha1.DataStorePlacementPolicySpec{
+			DataStoreSelector: metav1.LabelSelector{MatchLabels: map[string]string{"pool": "etcd"}},
+			Strategy:          stewardv1alpha1.DataStorePlacementStrategyPack,
+		}))
+
+		ops, err := onCreate()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ops).To(ContainElement(assigned("etcd-a")))
+
+		objects[len(objects)-1].(*stewardv1alpha1.DataStorePlacementPolicy).Spec.MaxTenantControlPlanes = 2 //nolint:forcetypeassert
+
+		ops, err = onCreate()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ops).To(ContainElement(assigned("etcd-b")))
+	})
+
+	It("should prefer the DataStores according to the driver order", func() {
+		objects = append(objects, policy("drivers", stewardv1alpha1.DataStorePlacementPolicySpec{
+			Drivers: []stewardv1alpha1.Driver{stewardv1alpha1.KinePostgreSQLDriver, stewardv1alpha1.EtcdDriver},
+		}))
+
+		ops, err := onCreate()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ops).To(ContainElement(assigned("pg-a")))
+	})
+
+	It("should apply the policy with the highest priority", func() {
+		objects = append(objects,
+			policy("etcd", stewardv1alpha1.DataStorePlacementPolicySpec{
+				DataStoreSelector: metav1.LabelSelector{MatchLabels: map[string]string{"pool": "etcd"}},
+			}),
+			policy("gold", stewardv1alpha1.DataStorePlacementPolicySpec{
+				TenantControlPlaneSelector: metav1.LabelSelector{MatchLabels: map[string]string{"tier": "gold"}},
+				DataStoreSelector:          metav1.LabelSelector{MatchLabels: map[string]string{"pool": "sql"}},
+				Priority:                   10,
+			}),
+		)
+
+		ops, err := onCreate()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ops).To(ContainElement(assigned("pg-a")))
+	})
+
+	It("should skip the DataStores not ready", func() {
+		ds := objects[1].(*stewardv1alpha1.DataStore) //nolint:forcetypeassert
+		ds.Status.Conditions = []metav1.Condition{{
+			Type:   stewardv1alpha1.ReadyCondition,
+			Status: metav1.ConditionFalse,
+			Reason: stewardv1alpha1.DataStoreConnectionFailedReason,
+		}}
+		objects = append(objects, policy("etcd", stewardv1alpha1.DataStorePlacementPolicySpec{
+			DataStoreSelector: metav1.LabelSelector{MatchLabels: map[string]string{"pool": "etcd"}},
+		}))
+
+		ops, err := onCreate()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ops).To(ContainElement(assigned("etcd-a")))
+	})
+
+	It("should deny the Tenant Control Plane when the pool is full", func() {
+		objects = append(objects, policy("etcd", stewardv1alpha1.DataStorePlacementPolicySpec{
+			DataStoreSelector:      metav1.LabelSelector{MatchLabels: map[string]string{"pool": "etcd"}},
+			MaxTenantControlPlanes: 1,
+		}))
+
+		_, err := onCreate()
+		Expect(err).To(MatchError(ContainSubstring("has no DataStore available")))
+	})
+
+	It("should not override the DataStore set by the user", func() {
+		tcp.Spec.DataStore = "pg-a"
+		objects = append(objects, policy("etcd", stewardv1alpha1.DataStorePlacementPolicySpec{
+			DataStoreSelector: metav1.LabelSelector{MatchLabels: map[string]string{"pool": "etcd"}},
+		}))
+
+		ops, err := onCreate()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ops).ToNot(ContainElement(HaveField("Path", "/spec/dataStore")))
+	})
+})
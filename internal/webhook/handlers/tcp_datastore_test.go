@@ -11,7 +11,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/ptr"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
 )
@@ -91,4 +93,122 @@ var _ = Describe("TCP Datastore webhook", func() {
 			Expect(err).To(HaveOccurred())
 		})
 	})
+
+	Describe("validation of the DataStore upon update", func() {
+		var oldTCP *stewardv1alpha1.TenantControlPlane
+
+		BeforeEach(func() {
+			tcp.Spec.DataStore = "removed"
+			oldTCP = tcp.DeepCopy()
+		})
+
+		It("should allow the unrelated changes despite the DataStore removal", func() {
+			tcp.Spec.ControlPlane.Deployment.Replicas = ptr.To(int32(3))
+
+			_, err := t.OnUpdate(tcp, oldTCP)(ctx, admission.Request{})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should deny changing to a nonexistent DataStore", func() {
+			oldTCP.Spec.DataStore = "foo"
+
+			_, err := t.OnUpdate(tcp, oldTCP)(ctx, admission.Request{})
+			Expect(err).To(MatchError("removed DataStore does not exist"))
+		})
+	})
+
+	Describe("validation of the DataStore driver upon migration", func() {
+		BeforeEach(func() {
+			scheme := runtime.NewScheme()
+			utilruntime.Must(stewardv1alpha1.AddToScheme(scheme))
+
+			t = TenantControlPlaneDataStore{
+				Client: fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(
+					&stewardv1alpha1.DataStore{
+						ObjectMeta: metav1.ObjectMeta{Name: "etcd"},
+						Spec:       stewardv1alpha1.DataStoreSpec{Driver: stewardv1alpha1.EtcdDriver},
+					},
+					&stewardv1alpha1.DataStore{
+						ObjectMeta: metav1.ObjectMeta{Name: "nats"},
+						Spec:       stewardv1alpha1.DataStoreSpec{Driver: stewardv1alpha1.KineNatsDriver},
+					},
+				).Build(),
+			}
+			tcp.Status.Storage.Driver = string(stewardv1alpha1.KineMySQLDriver)
+		})
+
+		It("should deny migrating to a different driver without the annotation", func() {
+			tcp.Spec.DataStore = "etcd"
+			Expect(t.checkDriver(ctx, tcp)).ToNot(Succeed())
+		})
+
+		It("should allow migrating to a different driver with the annotation", func() {
+			tcp.Spec.DataStore = "etcd"
+			tcp.SetAnnotations(map[string]string{stewardv1alpha1.CrossDriverMigrationAnnotation: "true"})
+			Expect(t.checkDriver(ctx, tcp)).To(Succeed())
+		})
+
+		It("should deny migrating to a NATS DataStore regardless of the annotation", func() {
+			tcp.Spec.DataStore = "nats"
+			tcp.SetAnnotations(map[string]string{stewardv1alpha1.CrossDriverMigrationAnnotation: "true"})
+			Expect(t.checkDriver(ctx, tcp)).ToNot(Succeed())
+		})
+
+		It("should allow migrating from an etcd DataStore to the dedicated one", func() {
+			tcp.Status.Storage.Driver = string(stewardv1alpha1.EtcdDriver)
+			tcp.Spec.DataStoreMode = stewardv1alpha1.DataStoreModeDedicated
+			Expect(t.checkDriver(ctx, tcp)).To(Succeed())
+		})
+
+		It("should deny migrating to the dedicated DataStore from a different driver without the annotation", func() {
+			tcp.Spec.DataStoreMode = stewardv1alpha1.DataStoreModeDedicated
+			Expect(t.checkDriver(ctx, tcp)).ToNot(Succeed())
+		})
+	})
+
+	Describe("validation of the SQLite DataStore", func() {
+		BeforeEach(func() {
+			scheme := runtime.NewScheme()
+			utilruntime.Must(stewardv1alpha1.AddToScheme(scheme))
+
+			t = TenantControlPlaneDataStore{
+				Client: fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(
+					&stewardv1alpha1.DataStore{
+						ObjectMeta: metav1.ObjectMeta{Name: "sqlite"},
+						Spec:       stewardv1alpha1.DataStoreSpec{Driver: stewardv1alpha1.KineSQLiteDriver},
+					},
+					&stewardv1alpha1.DataStore{
+						ObjectMeta: metav1.ObjectMeta{Name: "other-sqlite"},
+						Spec:       stewardv1alpha1.DataStoreSpec{Driver: stewardv1alpha1.KineSQLiteDriver},
+					},
+				).Build(),
+			}
+			tcp.Spec.DataStore = "sqlite"
+		})
+
+		It("should allow a single replica", func() {
+			tcp.Spec.ControlPlane.Deployment.Replicas = ptr.To(int32(1))
+			Expect(t.checkSQLite(ctx, tcp)).To(Succeed())
+		})
+
+		It("should deny several replicas", func() {
+			tcp.Spec.ControlPlane.Deployment.Replicas = ptr.To(int32(3))
+			Expect(t.checkSQLite(ctx, tcp)).ToNot(Succeed())
+		})
+
+		It("should deny the migration to another SQLite DataStore", func() {
+			tcp.Status.Storage.Driver = string(stewardv1alpha1.KineSQLiteDriver)
+			tcp.Spec.DataStore = "other-sqlite"
+			tcp.SetAnnotations(map[string]string{stewardv1alpha1.CrossDriverMigrationAnnotation: "true"})
+			Expect(t.checkDriver(ctx, tcp)).ToNot(Succeed())
+		})
+
+		It("should deny a SQLite DataStore as override", func() {
+			tcp.Spec.DataStoreOverrides = []stewardv1alpha1.DataStoreOverride{{
+				Resource:  "/events",
+				DataStore: "other-sqlite",
+			}}
+			Expect(t.checkDataStoreOverrides(ctx, tcp)).ToNot(Succeed())
+		})
+	})
 })
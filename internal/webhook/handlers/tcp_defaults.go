@@ -9,8 +9,11 @@ import (
 
 	"github.com/pkg/errors"
 	"gomodules.xyz/jsonpatch/v2"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	pointer "k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
@@ -19,15 +22,39 @@ import (
 
 type TenantControlPlaneDefaults struct {
 	DefaultDatastore string
+	// Client is used to assign the DataStore according to the DataStorePlacementPolicy objects,
+	// and to default the replicas according to the DataStore driver:
+	// when nil, the DataStore is defaulted only to the DefaultDatastore.
+	Client client.Client
 }
 
 func (t TenantControlPlaneDefaults) OnCreate(object runtime.Object) AdmissionResponse {
-	return func(context.Context, admission.Request) ([]jsonpatch.JsonPatchOperation, error) {
+	return func(ctx context.Context, _ admission.Request) ([]jsonpatch.JsonPatchOperation, error) {
 		original := object.(*stewardv1alpha1.TenantControlPlane) //nolint:forcetypeassert
 
 		defaulted := original.DeepCopy()
+
+		if len(defaulted.Spec.DataStore) == 0 && defaulted.Spec.DataStoreMode != stewardv1alpha1.DataStoreModeDedicated && t.Client != nil {
+			policy, err := t.placeDataStore(ctx, defaulted)
+			if err != nil {
+				return nil, err
+			}
+
+			if policy != "" {
+				if defaulted.Annotations == nil {
+					defaulted.Annotations = map[string]string{}
+				}
+
+				defaulted.Annotations[stewardv1alpha1.DataStorePlacementPolicyAnnotation] = policy
+			}
+		}
+
 		t.defaultUnsetFields(defaulted)
 
+		if err := t.defaultSQLiteReplicas(ctx, defaulted); err != nil {
+			return nil, err
+		}
+
 		if len(defaulted.Spec.NetworkProfile.DNSServiceIPs) == 0 {
 			ip, _, err := net.ParseCIDR(defaulted.Spec.NetworkProfile.ServiceCIDR)
 			if err != nil {
@@ -48,7 +75,7 @@ func (t TenantControlPlaneDefaults) OnCreate(object runtime.Object) AdmissionRes
 			return nil, errors.Wrap(err, "cannot create patch responses upon Tenant Control Plane creation")
 		}
 
-		return operations, nil
+		return append(operations, dedicatedDataStorePatch(original)...), nil
 	}
 }
 
@@ -56,13 +83,26 @@ func (t TenantControlPlaneDefaults) OnDelete(runtime.Object) AdmissionResponse {
 	return utils.NilOp()
 }
 
-func (t TenantControlPlaneDefaults) OnUpdate(runtime.Object, runtime.Object) AdmissionResponse {
-	// all immutability requirements are handled trough CEL annotations on the TenantControlPlaneSpec type
-	return utils.NilOp()
+func (t TenantControlPlaneDefaults) OnUpdate(object runtime.Object, _ runtime.Object) AdmissionResponse {
+	// all immutability requirements are handled trough CEL annotations on the TenantControlPlaneSpec type:
+	// the dedicated etcd cluster is defaulted when switching to the Dedicated DataStore mode.
+	return func(context.Context, admission.Request) ([]jsonpatch.JsonPatchOperation, error) {
+		return dedicatedDataStorePatch(object.(*stewardv1alpha1.TenantControlPlane)), nil //nolint:forcetypeassert
+	}
+}
+
+// dedicatedDataStorePatch adds an empty etcd cluster specification with the Dedicated DataStore mode:
+// the API Server fills in its defaults, which would be otherwise overridden by the zero values of the typed struct.
+func dedicatedDataStorePatch(tcp *stewardv1alpha1.TenantControlPlane) []jsonpatch.JsonPatchOperation {
+	if tcp.Spec.DataStoreMode != stewardv1alpha1.DataStoreModeDedicated || tcp.Spec.DedicatedDataStore != nil {
+		return nil
+	}
+
+	return []jsonpatch.JsonPatchOperation{jsonpatch.NewOperation("add", "/spec/dedicatedDataStore", map[string]any{})}
 }
 
 func (t TenantControlPlaneDefaults) defaultUnsetFields(tcp *stewardv1alpha1.TenantControlPlane) {
-	if len(tcp.Spec.DataStore) == 0 && t.DefaultDatastore != "" {
+	if len(tcp.Spec.DataStore) == 0 && tcp.Spec.DataStoreMode != stewardv1alpha1.DataStoreModeDedicated && t.DefaultDatastore != "" {
 		tcp.Spec.DataStore = t.DefaultDatastore
 	}
 
@@ -78,3 +118,27 @@ func (t TenantControlPlaneDefaults) defaultUnsetFields(tcp *stewardv1alpha1.Tena
 		tcp.Spec.DataStoreUsername = tcp.GetDefaultDatastoreUsername()
 	}
 }
+
+// defaultSQLiteReplicas forces a single replica when the Tenant Control Plane is backed by the SQLite driver,
+// since the embedded database cannot be shared among several Kine instances.
+func (t TenantControlPlaneDefaults) defaultSQLiteReplicas(ctx context.Context, tcp *stewardv1alpha1.TenantControlPlane) error {
+	if t.Client == nil || len(tcp.Spec.DataStore) == 0 || tcp.Spec.DataStoreMode == stewardv1alpha1.DataStoreModeDedicated {
+		return nil
+	}
+
+	var ds stewardv1alpha1.DataStore
+	if err := t.Client.Get(ctx, types.NamespacedName{Name: tcp.Spec.DataStore}, &ds); err != nil {
+		// The missing DataStore is reported by the validating webhook.
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+
+		return errors.Wrap(err, "cannot retrieve the Tenant Control Plane DataStore")
+	}
+
+	if ds.Spec.Driver == stewardv1alpha1.KineSQLiteDriver && pointer.Deref(tcp.Spec.ControlPlane.Deployment.Replicas, 1) > 1 {
+		tcp.Spec.ControlPlane.Deployment.Replicas = pointer.To(int32(1))
+	}
+
+	return nil
+}
@@ -5,12 +5,16 @@ package handlers_test
 
 import (
 	"context"
+	"encoding/json"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"gomodules.xyz/jsonpatch/v2"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/utils/ptr"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
@@ -72,6 +76,29 @@ var _ = Describe("TCP Defaulting Webhook", func() {
 		})
 	})
 
+	Describe("dedicated DataStore mode", func() {
+		BeforeEach(func() {
+			tcp.Spec.DataStoreMode = stewardv1alpha1.DataStoreModeDedicated
+		})
+
+		It("should default the dedicated etcd cluster rather than the dataStore", func() {
+			ops, err := t.OnCreate(tcp)(ctx, admission.Request{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ops).To(ContainElement(
+				jsonpatch.Operation{Operation: "add", Path: "/spec/dedicatedDataStore", Value: map[string]any{}},
+			))
+			Expect(ops).ToNot(ContainElement(HaveField("Path", "/spec/dataStore")))
+		})
+
+		It("should default the dedicated etcd cluster when switching mode", func() {
+			ops, err := t.OnUpdate(tcp, tcp.DeepCopy())(ctx, admission.Request{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ops).To(ConsistOf(
+				jsonpatch.Operation{Operation: "add", Path: "/spec/dedicatedDataStore", Value: map[string]any{}},
+			))
+		})
+	})
+
 	Describe("fields are already set", func() {
 		BeforeEach(func() {
 			tcp.Spec.DataStore = "etcd"
@@ -85,5 +112,21 @@ var _ = Describe("TCP Defaulting Webhook", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(ops).To(BeEmpty())
 		})
+
+		It("should force a single replica with the SQLite driver", func() {
+			scheme := runtime.NewScheme()
+			utilruntime.Must(stewardv1alpha1.AddToScheme(scheme))
+
+			t.Client = fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(&stewardv1alpha1.DataStore{
+				ObjectMeta: metav1.ObjectMeta{Name: "etcd"},
+				Spec:       stewardv1alpha1.DataStoreSpec{Driver: stewardv1alpha1.KineSQLiteDriver},
+			}).Build()
+
+			ops, err := t.OnCreate(tcp)(ctx, admission.Request{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ops).To(ConsistOf(
+				jsonpatch.Operation{Operation: "replace", Path: "/spec/controlPlane/deployment/replicas", Value: json.Number("1")},
+			))
+		})
 	})
 })
@@ -18,6 +18,7 @@ import (
 
 	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
 	"github.com/butlerdotdev/steward/internal/builders/controlplane"
+	"github.com/butlerdotdev/steward/internal/datastore"
 	"github.com/butlerdotdev/steward/internal/webhook/utils"
 )
 
@@ -62,11 +63,11 @@ func (t TenantControlPlaneDeployment) OnUpdate(newObject runtime.Object, oldObje
 			return nil, nil
 		}
 
-		ds := stewardv1alpha1.DataStore{}
-		if err := t.Client.Get(ctx, types.NamespacedName{Name: tcp.Spec.DataStore}, &ds); err != nil {
+		ds, err := datastore.GetDataStore(ctx, t.Client, *tcp, datastore.DesiredDataStoreName(*tcp))
+		if err != nil {
 			return nil, err
 		}
-		t.DeploymentBuilder.DataStore = ds
+		t.DeploymentBuilder.DataStore = *ds
 
 		dataStoreOverrides := make([]controlplane.DataStoreOverrides, 0, len(tcp.Spec.DataStoreOverrides))
 
@@ -86,7 +87,7 @@ func (t TenantControlPlaneDeployment) OnUpdate(newObject runtime.Object, oldObje
 		deployment.Name = tcp.Name
 		deployment.Namespace = tcp.Namespace
 
-		err := t.Client.Get(ctx, types.NamespacedName{Name: tcp.Name, Namespace: tcp.Namespace}, &deployment)
+		err = t.Client.Get(ctx, types.NamespacedName{Name: tcp.Name, Namespace: tcp.Namespace}, &deployment)
 		if err != nil && !k8serrors.IsNotFound(err) {
 			return nil, nil
 		}
@@ -0,0 +1,70 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"gomodules.xyz/jsonpatch/v2"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/internal/hibernation"
+	"github.com/butlerdotdev/steward/internal/webhook/utils"
+)
+
+type TenantControlPlaneHibernation struct{}
+
+func (t TenantControlPlaneHibernation) handle(tcp *stewardv1alpha1.TenantControlPlane) error {
+	spec := tcp.Spec.Hibernation
+	if spec == nil {
+		return nil
+	}
+
+	for i, schedule := range spec.Schedules {
+		if _, err := hibernation.ParseSchedule(schedule); err != nil {
+			return fmt.Errorf("invalid hibernation schedule at index %d: %w", i, err)
+		}
+	}
+
+	if spec.Idle != nil && spec.Idle.After.Duration <= 0 {
+		return fmt.Errorf("the hibernation idle duration must be positive")
+	}
+
+	return nil
+}
+
+func (t TenantControlPlaneHibernation) OnCreate(object runtime.Object) AdmissionResponse {
+	return func(context.Context, admission.Request) ([]jsonpatch.JsonPatchOperation, error) {
+		tcp := object.(*stewardv1alpha1.TenantControlPlane) //nolint:forcetypeassert
+
+		if err := t.handle(tcp); err != nil {
+			return nil, err
+		}
+
+		return nil, nil
+	}
+}
+
+func (t TenantControlPlaneHibernation) OnDelete(runtime.Object) AdmissionResponse {
+	return utils.NilOp()
+}
+
+func (t TenantControlPlaneHibernation) OnUpdate(object runtime.Object, _ runtime.Object) AdmissionResponse {
+	return func(context.Context, admission.Request) ([]jsonpatch.JsonPatchOperation, error) {
+		tcp := object.(*stewardv1alpha1.TenantControlPlane) //nolint:forcetypeassert
+
+		if tcp.DeletionTimestamp != nil {
+			return nil, nil
+		}
+
+		if err := t.handle(tcp); err != nil {
+			return nil, err
+		}
+
+		return nil, nil
+	}
+}
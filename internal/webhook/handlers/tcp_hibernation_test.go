@@ -0,0 +1,66 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/internal/webhook/handlers"
+)
+
+var _ = Describe("TCP Hibernation Webhook", func() {
+	var (
+		ctx context.Context
+		t   handlers.TenantControlPlaneHibernation
+		tcp *stewardv1alpha1.TenantControlPlane
+	)
+
+	BeforeEach(func() {
+		t = handlers.TenantControlPlaneHibernation{}
+		tcp = &stewardv1alpha1.TenantControlPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "tcp",
+				Namespace: "default",
+			},
+			Spec: stewardv1alpha1.TenantControlPlaneSpec{
+				Hibernation: &stewardv1alpha1.HibernationSpec{
+					Schedules: []stewardv1alpha1.HibernationSchedule{
+						{Sleep: "0 20 * * 1-5", Wake: "0 8 * * 1-5", TimeZone: "Europe/Rome"},
+					},
+				},
+			},
+		}
+		ctx = context.Background()
+	})
+
+	It("allows valid schedules", func() {
+		_, err := t.OnCreate(tcp)(ctx, admission.Request{})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("denies invalid cron expressions", func() {
+		tcp.Spec.Hibernation.Schedules[0].Wake = "at 8 in the morning"
+		_, err := t.OnCreate(tcp)(ctx, admission.Request{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("denies unknown time zones", func() {
+		tcp.Spec.Hibernation.Schedules[0].TimeZone = "Europe/Atlantis"
+		_, err := t.OnUpdate(tcp, tcp)(ctx, admission.Request{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("denies a non positive idle duration", func() {
+		tcp.Spec.Hibernation.Idle = &stewardv1alpha1.HibernationIdleSpec{After: metav1.Duration{Duration: -time.Hour}}
+		_, err := t.OnCreate(tcp)(ctx, admission.Request{})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,91 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"gomodules.xyz/jsonpatch/v2"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/internal/builders/controlplane"
+	"github.com/butlerdotdev/steward/internal/webhook/utils"
+)
+
+type TenantControlPlaneKMS struct{}
+
+func (t TenantControlPlaneKMS) handle(tcp *stewardv1alpha1.TenantControlPlane) error {
+	plugin := tcp.Spec.ControlPlane.Deployment.KMSPlugin
+
+	if encryption := tcp.Spec.Kubernetes.EncryptionAtRest; encryption != nil && encryption.Provider == stewardv1alpha1.EncryptionProviderKMS && plugin == nil {
+		return fmt.Errorf("the kms encryption provider requires the KMS plugin to be declared in the Deployment spec")
+	}
+
+	if plugin == nil {
+		return nil
+	}
+
+	socketFolder := path.Dir(strings.TrimPrefix(controlplane.KMSPluginEndpoint, "unix://"))
+
+	for _, mount := range plugin.VolumeMounts {
+		if path.Clean(mount.MountPath) == socketFolder {
+			return fmt.Errorf("the KMS plugin volume mount %s cannot use the path %s, reserved to the plugin socket", mount.Name, socketFolder)
+		}
+	}
+
+	return nil
+}
+
+func (t TenantControlPlaneKMS) OnCreate(object runtime.Object) AdmissionResponse {
+	return func(context.Context, admission.Request) ([]jsonpatch.JsonPatchOperation, error) {
+		tcp := object.(*stewardv1alpha1.TenantControlPlane) //nolint:forcetypeassert
+
+		if err := t.handle(tcp); err != nil {
+			return nil, err
+		}
+
+		return nil, nil
+	}
+}
+
+func (t TenantControlPlaneKMS) OnDelete(runtime.Object) AdmissionResponse {
+	return utils.NilOp()
+}
+
+func (t TenantControlPlaneKMS) OnUpdate(object runtime.Object, oldObject runtime.Object) AdmissionResponse {
+	return func(context.Context, admission.Request) ([]jsonpatch.JsonPatchOperation, error) {
+		newTCP, oldTCP := object.(*stewardv1alpha1.TenantControlPlane), oldObject.(*stewardv1alpha1.TenantControlPlane) //nolint:forcetypeassert
+
+		if newTCP.DeletionTimestamp != nil {
+			return nil, nil
+		}
+
+		if err := t.handle(newTCP); err != nil {
+			return nil, err
+		}
+
+		oldPlugin, newPlugin := oldTCP.Spec.ControlPlane.Deployment.KMSPlugin, newTCP.Spec.ControlPlane.Deployment.KMSPlugin
+		if oldPlugin == nil {
+			return nil, nil
+		}
+		// The KMS plugin can be dropped or renamed only once no data is encrypted anymore with it:
+		// the provider name is part of the stored ciphertext, and the API Server would be unable to decrypt it.
+		status := oldTCP.Status.EncryptionAtRest
+		inUse := status.Provider == string(stewardv1alpha1.EncryptionProviderKMS) || status.KeyRotationPhase != ""
+
+		switch {
+		case newPlugin == nil && inUse:
+			return nil, fmt.Errorf("the KMS plugin cannot be removed while data is still encrypted with it, wait for the key rotation to complete")
+		case newPlugin != nil && newPlugin.Name != oldPlugin.Name && inUse:
+			return nil, fmt.Errorf("the KMS plugin name cannot be changed while data is still encrypted with it")
+		}
+
+		return nil, nil
+	}
+}
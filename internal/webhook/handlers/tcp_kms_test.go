@@ -0,0 +1,99 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/internal/webhook/handlers"
+)
+
+var _ = Describe("TCP KMS Webhook", func() {
+	var (
+		ctx context.Context
+		t   handlers.TenantControlPlaneKMS
+		tcp *stewardv1alpha1.TenantControlPlane
+	)
+
+	BeforeEach(func() {
+		t = handlers.TenantControlPlaneKMS{}
+		tcp = &stewardv1alpha1.TenantControlPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "tcp",
+				Namespace: "default",
+			},
+			Spec: stewardv1alpha1.TenantControlPlaneSpec{
+				Kubernetes: stewardv1alpha1.KubernetesSpec{
+					EncryptionAtRest: &stewardv1alpha1.EncryptionAtRestSpec{
+						Provider: stewardv1alpha1.EncryptionProviderKMS,
+					},
+				},
+			},
+		}
+		ctx = context.Background()
+	})
+
+	It("denies creation when the kms provider has no plugin", func() {
+		_, err := t.OnCreate(tcp)(ctx, admission.Request{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("allows creation when the kms provider has a plugin", func() {
+		tcp.Spec.ControlPlane.Deployment.KMSPlugin = &stewardv1alpha1.KMSPluginSpec{Name: "vault", Image: "kms-plugin:latest"}
+		_, err := t.OnCreate(tcp)(ctx, admission.Request{})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("denies creation when a plugin volume mount overlaps the socket folder", func() {
+		tcp.Spec.ControlPlane.Deployment.KMSPlugin = &stewardv1alpha1.KMSPluginSpec{
+			Name:         "vault",
+			Image:        "kms-plugin:latest",
+			VolumeMounts: []corev1.VolumeMount{{Name: "custom", MountPath: "/var/run/kmsplugin/"}},
+		}
+		_, err := t.OnCreate(tcp)(ctx, admission.Request{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("denies the plugin removal while data is encrypted with it", func() {
+		tcp.Spec.ControlPlane.Deployment.KMSPlugin = &stewardv1alpha1.KMSPluginSpec{Name: "vault", Image: "kms-plugin:latest"}
+		tcp.Status.EncryptionAtRest.Provider = string(stewardv1alpha1.EncryptionProviderKMS)
+
+		newTCP := tcp.DeepCopy()
+		newTCP.Spec.Kubernetes.EncryptionAtRest.Provider = stewardv1alpha1.EncryptionProviderAESCBC
+		newTCP.Spec.ControlPlane.Deployment.KMSPlugin = nil
+
+		_, err := t.OnUpdate(newTCP, tcp)(ctx, admission.Request{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("denies the plugin rename while data is encrypted with it", func() {
+		tcp.Spec.ControlPlane.Deployment.KMSPlugin = &stewardv1alpha1.KMSPluginSpec{Name: "vault", Image: "kms-plugin:latest"}
+		tcp.Status.EncryptionAtRest.Provider = string(stewardv1alpha1.EncryptionProviderKMS)
+
+		newTCP := tcp.DeepCopy()
+		newTCP.Spec.ControlPlane.Deployment.KMSPlugin.Name = "aws"
+
+		_, err := t.OnUpdate(newTCP, tcp)(ctx, admission.Request{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("allows the plugin removal once the data has been rotated to another provider", func() {
+		tcp.Spec.Kubernetes.EncryptionAtRest.Provider = stewardv1alpha1.EncryptionProviderAESCBC
+		tcp.Spec.ControlPlane.Deployment.KMSPlugin = &stewardv1alpha1.KMSPluginSpec{Name: "vault", Image: "kms-plugin:latest"}
+		tcp.Status.EncryptionAtRest.Provider = string(stewardv1alpha1.EncryptionProviderAESCBC)
+
+		newTCP := tcp.DeepCopy()
+		newTCP.Spec.ControlPlane.Deployment.KMSPlugin = nil
+
+		_, err := t.OnUpdate(newTCP, tcp)(ctx, admission.Request{})
+		Expect(err).ToNot(HaveOccurred())
+	})
+})
@@ -53,6 +53,18 @@ func (t TenantControlPlaneVersion) normalizeKubernetesVersion(input string) stri
 	return input
 }
 
+func (t TenantControlPlaneVersion) isSequential(tcp *stewardv1alpha1.TenantControlPlane) bool {
+	return tcp.Spec.Kubernetes.UpgradeStrategy != nil && tcp.Spec.Kubernetes.UpgradeStrategy.Type == stewardv1alpha1.UpgradeStrategySequential
+}
+
+// isRolledBackTo returns true when the desired version is the one the last upgrade has been rolled back to:
+// it's not a downgrade, since the control plane is already running it.
+func (t TenantControlPlaneVersion) isRolledBackTo(tcp *stewardv1alpha1.TenantControlPlane) bool {
+	rollback := tcp.Status.Kubernetes.Version.RolledBack
+
+	return rollback != nil && rollback.Version == tcp.Spec.Kubernetes.Version
+}
+
 func (t TenantControlPlaneVersion) OnDelete(runtime.Object) AdmissionResponse {
 	return utils.NilOp()
 }
@@ -87,12 +99,25 @@ func (t TenantControlPlaneVersion) OnUpdate(object runtime.Object, oldObject run
 		switch {
 		case newVer.GT(supportedVer):
 			return nil, fmt.Errorf("unable to upgrade to a version greater than the supported one (v%d.%d)", supportedVer.Major, supportedVer.Minor)
-		case newVer.LT(oldVer):
+		case newVer.LT(oldVer) && !t.isRolledBackTo(newTCP):
 			return nil, fmt.Errorf("unable to downgrade a TenantControlPlane from %s to %s", oldVer.String(), newVer.String())
-		case newVer.Minor-oldVer.Minor > 1:
+		case newVer.GT(oldVer) && newVer.Minor-oldVer.Minor > 1 && !t.isSequential(newTCP):
 			return nil, fmt.Errorf("unable to upgrade to a minor version in a non-sequential mode")
 		}
 
+		if !t.isSequential(newTCP) || newVer.EQ(oldVer) {
+			return nil, nil
+		}
+		// The upgrade path is computed from the running version, which could be behind the previously desired one.
+		running := newTCP.Status.Kubernetes.Version.Version
+		if running == "" {
+			running = oldTCP.Spec.Kubernetes.Version
+		}
+
+		if _, err := upgrade.SequentialPath(running, newTCP.Spec.Kubernetes.Version, newTCP.Spec.Kubernetes.UpgradeStrategy.IntermediateVersions); err != nil {
+			return nil, errors.Wrap(err, "unable to plan the sequential upgrade")
+		}
+
 		return nil, nil
 	}
 }
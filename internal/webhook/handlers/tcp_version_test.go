@@ -0,0 +1,117 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/butlerdotdev/steward/internal/webhook/handlers"
+)
+
+var _ = Describe("TCP Version Webhook", func() {
+	var (
+		ctx    context.Context
+		t      handlers.TenantControlPlaneVersion
+		oldTCP *stewardv1alpha1.TenantControlPlane
+		tcp    *stewardv1alpha1.TenantControlPlane
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		t = handlers.TenantControlPlaneVersion{}
+		oldTCP = &stewardv1alpha1.TenantControlPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "tcp",
+				Namespace: "default",
+			},
+			Spec: stewardv1alpha1.TenantControlPlaneSpec{
+				Kubernetes: stewardv1alpha1.KubernetesSpec{Version: "v1.31.4"},
+			},
+		}
+		tcp = oldTCP.DeepCopy()
+		tcp.Spec.Kubernetes.Version = "v1.34.1"
+	})
+
+	It("should deny a multi-minor upgrade with the Direct strategy", func() {
+		_, err := t.OnUpdate(tcp, oldTCP)(ctx, admission.Request{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should allow reverting to the version the upgrade has been rolled back to", func() {
+		oldTCP.Spec.Kubernetes.Version = "v1.32.0"
+		tcp.Spec.Kubernetes.Version = "v1.31.4"
+
+		_, err := t.OnUpdate(tcp, oldTCP)(ctx, admission.Request{})
+		Expect(err).To(HaveOccurred())
+
+		tcp.Status.Kubernetes.Version.RolledBack = &stewardv1alpha1.KubernetesRollbackStatus{FailedVersion: "v1.32.0", Version: "v1.31.4"}
+
+		_, err = t.OnUpdate(tcp, oldTCP)(ctx, admission.Request{})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("Sequential strategy", func() {
+		BeforeEach(func() {
+			tcp.Spec.Kubernetes.UpgradeStrategy = &stewardv1alpha1.UpgradeStrategy{Type: stewardv1alpha1.UpgradeStrategySequential}
+		})
+
+		It("should allow a multi-minor upgrade", func() {
+			_, err := t.OnUpdate(tcp, oldTCP)(ctx, admission.Request{})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should allow pinned intermediate versions", func() {
+			tcp.Spec.Kubernetes.UpgradeStrategy.IntermediateVersions = []string{"v1.32.9", "v1.33.5"}
+
+			_, err := t.OnUpdate(tcp, oldTCP)(ctx, admission.Request{})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should ignore pinned intermediate versions out of the upgrade path", func() {
+			tcp.Spec.Kubernetes.UpgradeStrategy.IntermediateVersions = []string{"v1.31.9", "v1.34.0"}
+
+			_, err := t.OnUpdate(tcp, oldTCP)(ctx, admission.Request{})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should allow bumping the target of a completed upgrade with a leftover pin", func() {
+			oldTCP.Spec.Kubernetes.Version = "v1.34.1"
+			oldTCP.Spec.Kubernetes.UpgradeStrategy = &stewardv1alpha1.UpgradeStrategy{Type: stewardv1alpha1.UpgradeStrategySequential, IntermediateVersions: []string{"v1.33.5"}}
+			oldTCP.Status.Kubernetes.Version.Version = "v1.34.1"
+
+			tcp = oldTCP.DeepCopy()
+			tcp.Spec.Kubernetes.Version = "v1.35.0"
+
+			_, err := t.OnUpdate(tcp, oldTCP)(ctx, admission.Request{})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should deny malformed pinned intermediate versions", func() {
+			tcp.Spec.Kubernetes.UpgradeStrategy.IntermediateVersions = []string{"v1.32"}
+
+			_, err := t.OnUpdate(tcp, oldTCP)(ctx, admission.Request{})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should deny several pinned versions for the same minor release", func() {
+			tcp.Spec.Kubernetes.UpgradeStrategy.IntermediateVersions = []string{"v1.32.9", "v1.32.10"}
+
+			_, err := t.OnUpdate(tcp, oldTCP)(ctx, admission.Request{})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should deny a downgrade", func() {
+			tcp.Spec.Kubernetes.Version = "v1.30.0"
+
+			_, err := t.OnUpdate(tcp, oldTCP)(ctx, admission.Request{})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
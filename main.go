@@ -6,14 +6,21 @@ package main
 import (
 	"os"
 
+	"github.com/spf13/cobra"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/butlerdotdev/steward/cmd"
+	"github.com/butlerdotdev/steward/cmd/activator"
+	"github.com/butlerdotdev/steward/cmd/backup"
 	kubeconfig_generator "github.com/butlerdotdev/steward/cmd/kubeconfig-generator"
 	"github.com/butlerdotdev/steward/cmd/manager"
 	"github.com/butlerdotdev/steward/cmd/migrate"
+	"github.com/butlerdotdev/steward/cmd/restore"
 )
 
+// testCommands are the commands meant for testing purposes only, registered by the files with the matching build tag.
+var testCommands []func(*runtime.Scheme) *cobra.Command
+
 func main() {
 	scheme := runtime.NewScheme()
 
@@ -21,6 +28,13 @@ func main() {
 	root.AddCommand(mgr)
 	root.AddCommand(migrator)
 	root.AddCommand(kubeconfigGenerator)
+	root.AddCommand(activator.NewCmd(scheme))
+	root.AddCommand(backup.NewCmd(scheme))
+	root.AddCommand(restore.NewCmd(scheme))
+
+	for _, newCmd := range testCommands {
+		root.AddCommand(newCmd(scheme))
+	}
 
 	if err := root.Execute(); err != nil {
 		os.Exit(1)
@@ -0,0 +1,15 @@
+// Copyright 2026 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build kmsmock
+
+package main
+
+import (
+	kmsmockplugin "github.com/butlerdotdev/steward/cmd/kms-mock-plugin"
+)
+
+// The mock KMS plugin is shipped only by the binaries built with the kmsmock tag, not by the production ones.
+func init() {
+	testCommands = append(testCommands, kmsmockplugin.NewCmd)
+}